@@ -1,6 +1,9 @@
 package app
 
 import (
+	"context"
+	"time"
+
 	"github.com/gofiber/fiber/v2"
 	"go.mongodb.org/mongo-driver/mongo"
 	"google.golang.org/grpc"
@@ -35,20 +38,53 @@ import (
 	roommemberUseCase "github.com/MingPV/ChatService/internal/room_member/usecase"
 	roommemberpb "github.com/MingPV/ChatService/proto/room_member"
 
+	GrpcAttachmentHandler "github.com/MingPV/ChatService/internal/attachment/handler/grpc"
+	attachmentRepository "github.com/MingPV/ChatService/internal/attachment/repository"
+	attachmentUseCase "github.com/MingPV/ChatService/internal/attachment/usecase"
+	attachmentpb "github.com/MingPV/ChatService/proto/attachment"
+
+	linkPreviewRepository "github.com/MingPV/ChatService/internal/link_preview/repository"
+	linkPreviewUseCase "github.com/MingPV/ChatService/internal/link_preview/usecase"
+
+	GrpcBookmarkHandler "github.com/MingPV/ChatService/internal/bookmark/handler/grpc"
+	bookmarkRepository "github.com/MingPV/ChatService/internal/bookmark/repository"
+	bookmarkUseCase "github.com/MingPV/ChatService/internal/bookmark/usecase"
+	bookmarkpb "github.com/MingPV/ChatService/proto/bookmark"
+
+	GrpcReportHandler "github.com/MingPV/ChatService/internal/report/handler/grpc"
+	reportRepository "github.com/MingPV/ChatService/internal/report/repository"
+	reportUseCase "github.com/MingPV/ChatService/internal/report/usecase"
+	reportpb "github.com/MingPV/ChatService/proto/report"
+
+	GrpcScheduledMessageHandler "github.com/MingPV/ChatService/internal/scheduled_message/handler/grpc"
+	scheduledMessageRepository "github.com/MingPV/ChatService/internal/scheduled_message/repository"
+	scheduledMessageUseCase "github.com/MingPV/ChatService/internal/scheduled_message/usecase"
+	scheduledmessagepb "github.com/MingPV/ChatService/proto/scheduled_message"
+
 	GrpcRoomInviteHandler "github.com/MingPV/ChatService/internal/room_invite/handler/grpc"
 	roominviteRepository "github.com/MingPV/ChatService/internal/room_invite/repository"
 	roominviteUseCase "github.com/MingPV/ChatService/internal/room_invite/usecase"
 	roominvitepb "github.com/MingPV/ChatService/proto/room_invite"
 
+	GrpcPollHandler "github.com/MingPV/ChatService/internal/poll/handler/grpc"
+	pollRepository "github.com/MingPV/ChatService/internal/poll/repository"
+	pollUseCase "github.com/MingPV/ChatService/internal/poll/usecase"
+	pollpb "github.com/MingPV/ChatService/proto/poll"
+
+	"github.com/MingPV/ChatService/pkg/blobstore"
 	"github.com/MingPV/ChatService/pkg/config"
 	"github.com/MingPV/ChatService/pkg/database"
 	"github.com/MingPV/ChatService/pkg/middleware"
+	"github.com/MingPV/ChatService/pkg/profile"
 	"github.com/MingPV/ChatService/pkg/routes"
 )
 
 // rest
 func SetupRestServer(db *mongo.Database, cfg *config.Config) (*fiber.App, error) {
-	app := fiber.New()
+	app := fiber.New(fiber.Config{
+		// leave headroom for the multipart envelope around attachment uploads
+		BodyLimit: int(cfg.AttachmentMaxSize) + 1<<20,
+	})
 	middleware.FiberMiddleware(app)
 	// comment out Swagger when testing
 	// routes.SwaggerRoute(app)
@@ -70,35 +106,143 @@ func SetupGrpcServer(db *mongo.Database, cfg *config.Config) (*grpc.Server, erro
 
 	
 	
+	chatroomRepo := chatroomRepository.NewMongoChatroomRepository(db)
+	if err := chatroomRepo.EnsureIndexes(); err != nil {
+		return nil, err
+	}
+	bookmarkRepo := bookmarkRepository.NewMongoBookmarkRepository(db)
+	if err := bookmarkRepo.EnsureIndexes(); err != nil {
+		return nil, err
+	}
 	roommemberRepo := roommemberRepository.NewMongoRoomMemberRepository(db)
-	roommemberService := roommemberUseCase.NewRoomMemberService(roommemberRepo)
-	roommemberHandler := GrpcRoomMemberHandler.NewGrpcRoomMemberHandler(roommemberService)
-	roommemberpb.RegisterRoomMemberServiceServer(s, roommemberHandler)
-	
-	roominviteRepo := roominviteRepository.NewMongoRoomInviteRepository(db)
-	roominviteService := roominviteUseCase.NewRoomInviteService(roominviteRepo, roommemberRepo)
-	roominviteHandler := GrpcRoomInviteHandler.NewGrpcRoomInviteHandler(roominviteService)
-	roominvitepb.RegisterRoomInviteServiceServer(s, roominviteHandler)
+	// Moderator bans and mutes are checked by messages, invites and joins
+	restrictionRepo := roommemberRepository.NewMongoRestrictionRepository(db)
+	if err := restrictionRepo.EnsureIndexes(); err != nil {
+		return nil, err
+	}
+	// Display names for direct rooms come from the configured profile source
+	profiles, err := profile.LoadStaticProvider(cfg.ProfileFile)
+	if err != nil {
+		return nil, err
+	}
 	
 	
 	lastvisitRepo := lastvisitRepository.NewMongoLastvisitRepository(db)
 	lastvisitService := lastvisitUseCase.NewLastvisitService(lastvisitRepo)
 	lastvisitHandler := GrpcLastvisitHandler.NewGrpcLastvisitHandler(lastvisitService)
 	lastvisitpb.RegisterLastvisitServiceServer(s, lastvisitHandler)
+	// Attachments stored on the local filesystem
+	attachmentRepo := attachmentRepository.NewMongoAttachmentRepository(db)
+	attachmentStore := blobstore.NewLocalBlobStore(cfg.AttachmentDir)
+	attachmentService := attachmentUseCase.NewAttachmentService(attachmentRepo, roommemberRepo, attachmentStore, attachmentUseCase.NewAttachmentPolicy(cfg))
+	attachmentHandler := GrpcAttachmentHandler.NewGrpcAttachmentHandler(attachmentService)
+	attachmentpb.RegisterAttachmentServiceServer(s, attachmentHandler)
+
 	// Message streaming service
 	msgRepo := messageRepository.NewMongoMessageRepository(db)
-	msgUseCase := messageUseCase.NewMessageService(msgRepo)
+	if err := msgRepo.EnsureIndexes(); err != nil {
+		return nil, err
+	}
+	// Blocks are shared by messages, invites and friends
+	blockRepo := friendRepository.NewMongoBlockRepository(db)
+	if err := blockRepo.EnsureIndexes(); err != nil {
+		return nil, err
+	}
+	msgUseCase := messageUseCase.NewMessageService(msgRepo, attachmentRepo, roommemberRepo, chatroomRepo, blockRepo, restrictionRepo, messageUseCase.NewMessagePolicy(cfg))
+
+	// Room members post moderation announcements as system messages
+	roommemberService := roommemberUseCase.NewRoomMemberService(roommemberRepo, restrictionRepo, chatroomRepo, bookmarkRepo, msgUseCase, profiles)
+	roommemberHandler := GrpcRoomMemberHandler.NewGrpcRoomMemberHandler(roommemberService)
+	roommemberpb.RegisterRoomMemberServiceServer(s, roommemberHandler)
+
+	// Disappearing messages are removed once their room's TTL runs out
+	reaper := messageUseCase.NewReaper(msgRepo, attachmentRepo, bookmarkRepo, attachmentStore, msgUseCase, time.Duration(cfg.MessageReaperInterval)*time.Second)
+	reaper.Start(context.Background())
+
+	// Thumbnails for image attachments are generated in the background
+	thumbnailWorker := attachmentUseCase.NewThumbnailWorker(attachmentRepo, msgRepo, msgUseCase, attachmentStore, cfg.ThumbnailSizes)
+	msgUseCase.RegisterProcessor(thumbnailWorker)
+	thumbnailWorker.Start(context.Background(), 2)
+
+	// Link previews are unfurled in the background through an SSRF-safe client
+	linkPreviewRepo := linkPreviewRepository.NewMongoLinkPreviewRepository(db)
+	linkPreviewFetcher := linkPreviewUseCase.NewHTTPFetcher(linkPreviewUseCase.NewSafeHTTPClient(5 * time.Second))
+	linkPreviewService := linkPreviewUseCase.NewLinkPreviewService(linkPreviewRepo, msgRepo, msgUseCase, linkPreviewFetcher, time.Duration(cfg.LinkPreviewCacheTTL)*time.Second)
+	msgUseCase.RegisterProcessor(linkPreviewService)
+	linkPreviewService.Start(context.Background(), 2)
 	msgHandler := GrpcMessageHandler.NewGrpcMessageHandler(msgUseCase)
 	messagepb.RegisterMessageServiceServer(s, msgHandler)
+
+	// Scheduled messages are delivered by a poller that resumes from MongoDB after restarts
+	scheduledMessageRepo := scheduledMessageRepository.NewMongoScheduledMessageRepository(db)
+	if err := scheduledMessageRepo.EnsureIndexes(); err != nil {
+		return nil, err
+	}
+	scheduledMessageService := scheduledMessageUseCase.NewScheduledMessageService(scheduledMessageRepo, roommemberRepo)
+	scheduler := scheduledMessageUseCase.NewScheduler(scheduledMessageRepo, roommemberRepo, msgRepo, msgUseCase, time.Duration(cfg.SchedulerPollInterval)*time.Second, time.Duration(cfg.SchedulerLease)*time.Second)
+	scheduler.Start(context.Background())
+	scheduledMessageHandler := GrpcScheduledMessageHandler.NewGrpcScheduledMessageHandler(scheduledMessageService)
+	scheduledmessagepb.RegisterScheduledMessageServiceServer(s, scheduledMessageHandler)
+
+	// Polls are posted as messages; vote changes are pushed over the room stream
+	pollRepo := pollRepository.NewMongoPollRepository(db)
+	if err := pollRepo.EnsureIndexes(); err != nil {
+		return nil, err
+	}
+	pollService := pollUseCase.NewPollService(pollRepo, roommemberRepo, chatroomRepo, msgUseCase)
+	pollHandler := GrpcPollHandler.NewGrpcPollHandler(pollService)
+	pollpb.RegisterPollServiceServer(s, pollHandler)
 	
-	chatroomRepo := chatroomRepository.NewMongoChatroomRepository(db)
-	chatroomService := chatroomUseCase.NewChatroomService(chatroomRepo, roommemberRepo, msgRepo)
+	// Room invites, invite links and join requests
+	roominviteRepo := roominviteRepository.NewMongoRoomInviteRepository(db)
+	joinRequestRepo := roominviteRepository.NewMongoJoinRequestRepository(db)
+	if err := joinRequestRepo.EnsureIndexes(); err != nil {
+		return nil, err
+	}
+	if err := roominviteRepo.EnsureIndexes(); err != nil {
+		return nil, err
+	}
+	roominviteService := roominviteUseCase.NewRoomInviteService(roominviteRepo, roommemberRepo, chatroomRepo, blockRepo, restrictionRepo, roominviteUseCase.NewInvitePolicy(cfg))
+	inviteExpirer := roominviteUseCase.NewInviteExpirer(roominviteRepo, time.Duration(cfg.InviteExpiryInterval)*time.Second)
+	inviteExpirer.Start(context.Background())
+	inviteLinkRepo := roominviteRepository.NewMongoInviteLinkRepository(db)
+	if err := inviteLinkRepo.EnsureIndexes(); err != nil {
+		return nil, err
+	}
+	inviteLinkService := roominviteUseCase.NewInviteLinkService(inviteLinkRepo, roommemberRepo, chatroomRepo, restrictionRepo)
+	// Join requests alert room moderators over their message streams
+	joinRequestService := roominviteUseCase.NewJoinRequestService(joinRequestRepo, roommemberRepo, chatroomRepo, restrictionRepo, msgUseCase)
+	roominviteHandler := GrpcRoomInviteHandler.NewGrpcRoomInviteHandler(roominviteService, inviteLinkService, joinRequestService)
+	roominvitepb.RegisterRoomInviteServiceServer(s, roominviteHandler)
+
+	chatroomService := chatroomUseCase.NewChatroomService(chatroomRepo, roommemberRepo, restrictionRepo, msgRepo, bookmarkRepo, joinRequestService, msgUseCase)
 	chatroomHandler := GrpcChatroomHandler.NewGrpcChatroomHandler(chatroomService)
 	chatroompb.RegisterChatroomServiceServer(s, chatroomHandler)
+
+	bookmarkService := bookmarkUseCase.NewBookmarkService(bookmarkRepo, msgRepo, roommemberRepo)
+	bookmarkHandler := GrpcBookmarkHandler.NewGrpcBookmarkHandler(bookmarkService)
+	bookmarkpb.RegisterBookmarkServiceServer(s, bookmarkHandler)
+
+	// Message reports are resolved by room moderators through the same kick/ban/mute rules
+	reportRepo := reportRepository.NewMongoReportRepository(db)
+	if err := reportRepo.EnsureIndexes(); err != nil {
+		return nil, err
+	}
+	reportService := reportUseCase.NewReportService(reportRepo, msgRepo, roommemberRepo, chatroomRepo, msgUseCase, roommemberService)
+	reportHandler := GrpcReportHandler.NewGrpcReportHandler(reportService)
+	reportpb.RegisterReportServiceServer(s, reportHandler)
 	
 	friendRepo := friendRepository.NewMongoFriendRepository(db)
-	friendService := friendUseCase.NewFriendService(friendRepo, chatroomRepo, roommemberRepo)
-	friendHandler := GrpcFriendHandler.NewGrpcFriendHandler(friendService)
+	if err := friendRepo.EnsureIndexes(); err != nil {
+		return nil, err
+	}
+	friendService := friendUseCase.NewFriendService(friendRepo, blockRepo, chatroomRepo, roommemberRepo, msgUseCase, profiles)
+	labelRepo := friendRepository.NewMongoLabelRepository(db)
+	if err := labelRepo.EnsureIndexes(); err != nil {
+		return nil, err
+	}
+	labelService := friendUseCase.NewFriendLabelService(labelRepo, friendRepo, roommemberRepo, chatroomService)
+	friendHandler := GrpcFriendHandler.NewGrpcFriendHandler(friendService, labelService)
 	friendpb.RegisterFriendServiceServer(s, friendHandler)
 
 	return s, nil
@@ -0,0 +1,26 @@
+package dto
+
+import "github.com/MingPV/ChatService/internal/entities"
+
+func ToAttachmentResponse(a *entities.Attachment) *AttachmentResponse {
+	thumbnails := make([]ThumbnailResponse, 0, len(a.Thumbnails))
+	for _, t := range a.Thumbnails {
+		thumbnails = append(thumbnails, ThumbnailResponse{Size: t.Size, Width: t.Width, Height: t.Height, MimeType: t.MimeType})
+	}
+	return &AttachmentResponse{
+		ID:        a.ID,
+		RoomId:    a.RoomId,
+		MessageId: a.MessageId,
+		Uploader:  a.Uploader.String(),
+		FileName:  a.FileName,
+		MimeType:  a.MimeType,
+		Size:      a.Size,
+		Checksum:  a.Checksum,
+		Width:     a.Width,
+		Height:    a.Height,
+		CreatedAt: a.CreatedAt,
+
+		Thumbnails:  thumbnails,
+		Placeholder: a.Placeholder,
+	}
+}
@@ -0,0 +1,32 @@
+package dto
+
+import "time"
+
+type AttachmentResponse struct {
+	ID        uint      `json:"id"`
+	RoomId    uint      `json:"room_id"`
+	MessageId uint      `json:"message_id"`
+	Uploader  string    `json:"uploader"`
+	FileName  string    `json:"file_name"`
+	MimeType  string    `json:"mime_type"`
+	Size      int64     `json:"size"`
+	Checksum  string    `json:"checksum"`
+	Width     int       `json:"width,omitempty"`
+	Height    int       `json:"height,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+
+	Thumbnails  []ThumbnailResponse `json:"thumbnails,omitempty"`
+	Placeholder string              `json:"placeholder,omitempty"`
+}
+
+type ThumbnailResponse struct {
+	Size     int    `json:"size"`
+	Width    int    `json:"width"`
+	Height   int    `json:"height"`
+	MimeType string `json:"mime_type"`
+}
+
+type DownloadURLResponse struct {
+	URL       string    `json:"url"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
@@ -0,0 +1,125 @@
+package grpc
+
+import (
+	"context"
+	"io"
+
+	"github.com/MingPV/ChatService/internal/attachment/usecase"
+	"github.com/MingPV/ChatService/internal/entities"
+	"github.com/MingPV/ChatService/pkg/apperror"
+	attachmentpb "github.com/MingPV/ChatService/proto/attachment"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type GrpcAttachmentHandler struct {
+	attachmentUseCase usecase.AttachmentUseCase
+	attachmentpb.UnimplementedAttachmentServiceServer
+}
+
+func NewGrpcAttachmentHandler(uc usecase.AttachmentUseCase) *GrpcAttachmentHandler {
+	return &GrpcAttachmentHandler{attachmentUseCase: uc}
+}
+
+func (h *GrpcAttachmentHandler) UploadAttachment(stream attachmentpb.AttachmentService_UploadAttachmentServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	info := first.GetInfo()
+	if info == nil {
+		return status.Error(codes.InvalidArgument, "first upload message must contain attachment info")
+	}
+	uploader, err := uuid.Parse(info.Uploader)
+	if err != nil {
+		return status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+
+	attachment := &entities.Attachment{
+		RoomId:   uint(info.RoomId),
+		Uploader: uploader,
+		FileName: info.FileName,
+		MimeType: info.MimeType,
+	}
+	if err := h.attachmentUseCase.UploadAttachment(attachment, &chunkReader{stream: stream}); err != nil {
+		return status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+
+	return stream.SendAndClose(&attachmentpb.UploadAttachmentResponse{Attachment: toProtoAttachment(attachment)})
+}
+
+func (h *GrpcAttachmentHandler) FindAttachmentByID(ctx context.Context, req *attachmentpb.FindAttachmentByIDRequest) (*attachmentpb.FindAttachmentByIDResponse, error) {
+	attachment, err := h.attachmentUseCase.FindAttachmentByID(int(req.Id))
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+	return &attachmentpb.FindAttachmentByIDResponse{Attachment: toProtoAttachment(attachment)}, nil
+}
+
+func (h *GrpcAttachmentHandler) GetDownloadURL(ctx context.Context, req *attachmentpb.GetDownloadURLRequest) (*attachmentpb.GetDownloadURLResponse, error) {
+	userUUID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+	url, expiresAt, err := h.attachmentUseCase.CreateDownloadURL(int(req.Id), userUUID, int(req.ThumbnailSize))
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+	return &attachmentpb.GetDownloadURLResponse{Url: url, ExpiresAt: timestamppb.New(expiresAt)}, nil
+}
+
+// ---- Helper functions ----
+
+// chunkReader exposes the chunks of an upload stream as an io.Reader.
+type chunkReader struct {
+	stream attachmentpb.AttachmentService_UploadAttachmentServer
+	buf    []byte
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		req, err := r.stream.Recv()
+		if err != nil {
+			return 0, err // io.EOF once the client closes the stream
+		}
+		r.buf = req.GetChunk()
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+var _ io.Reader = (*chunkReader)(nil)
+
+func toProtoAttachment(a *entities.Attachment) *attachmentpb.Attachment {
+	return &attachmentpb.Attachment{
+		Id:          int32(a.ID),
+		RoomId:      int32(a.RoomId),
+		MessageId:   int32(a.MessageId),
+		Uploader:    a.Uploader.String(),
+		FileName:    a.FileName,
+		MimeType:    a.MimeType,
+		Size:        a.Size,
+		Checksum:    a.Checksum,
+		Width:       int32(a.Width),
+		Height:      int32(a.Height),
+		CreatedAt:   timestamppb.New(a.CreatedAt),
+		Thumbnails:  toProtoThumbnails(a.Thumbnails),
+		Placeholder: a.Placeholder,
+	}
+}
+
+func toProtoThumbnails(thumbnails []entities.Thumbnail) []*attachmentpb.Thumbnail {
+	var out []*attachmentpb.Thumbnail
+	for _, t := range thumbnails {
+		out = append(out, &attachmentpb.Thumbnail{
+			Size:     int32(t.Size),
+			Width:    int32(t.Width),
+			Height:   int32(t.Height),
+			MimeType: t.MimeType,
+		})
+	}
+	return out
+}
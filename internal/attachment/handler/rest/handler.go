@@ -0,0 +1,122 @@
+package rest
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/MingPV/ChatService/internal/attachment/dto"
+	"github.com/MingPV/ChatService/internal/attachment/usecase"
+	"github.com/MingPV/ChatService/internal/entities"
+	"github.com/MingPV/ChatService/pkg/apperror"
+	"github.com/MingPV/ChatService/pkg/middleware"
+	responses "github.com/MingPV/ChatService/pkg/responses"
+	"github.com/gofiber/fiber/v2"
+)
+
+type HttpAttachmentHandler struct {
+	attachmentUseCase usecase.AttachmentUseCase
+}
+
+func NewHttpAttachmentHandler(useCase usecase.AttachmentUseCase) *HttpAttachmentHandler {
+	return &HttpAttachmentHandler{attachmentUseCase: useCase}
+}
+
+// UploadAttachment godoc
+// @Summary Upload a file to a chatroom
+// @Tags attachments
+// @Accept multipart/form-data
+// @Produce json
+// @Param room_id formData int true "Room ID"
+// @Param file formData file true "File content"
+// @Success 201 {object} dto.AttachmentResponse
+// @Router /attachments [post]
+func (h *HttpAttachmentHandler) UploadAttachment(c *fiber.Ctx) error {
+	roomID, err := strconv.Atoi(c.FormValue("room_id"))
+	if err != nil {
+		return responses.ErrorWithMessage(c, apperror.ErrInvalidID, "invalid room_id")
+	}
+	uploader, err := middleware.UserID(c)
+	if err != nil {
+		return responses.ErrorWithMessage(c, apperror.ErrUnauthorized, "invalid token")
+	}
+	fh, err := c.FormFile("file")
+	if err != nil {
+		return responses.ErrorWithMessage(c, apperror.ErrRequiredField, "file is required")
+	}
+	file, err := fh.Open()
+	if err != nil {
+		return responses.Error(c, err)
+	}
+	defer file.Close()
+
+	attachment := &entities.Attachment{
+		RoomId:   uint(roomID),
+		Uploader: uploader,
+		FileName: fh.Filename,
+		MimeType: fh.Header.Get("Content-Type"),
+	}
+	if err := h.attachmentUseCase.UploadAttachment(attachment, file); err != nil {
+		return responses.Error(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(dto.ToAttachmentResponse(attachment))
+}
+
+// GetDownloadURL godoc
+// @Summary Get a signed download URL for an attachment
+// @Tags attachments
+// @Produce json
+// @Param id path int true "Attachment ID"
+// @Param size query int false "Thumbnail size, omit for the original"
+// @Success 200 {object} dto.DownloadURLResponse
+// @Router /attachments/{id}/url [get]
+func (h *HttpAttachmentHandler) GetDownloadURL(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return responses.ErrorWithMessage(c, err, "invalid id")
+	}
+	userID, err := middleware.UserID(c)
+	if err != nil {
+		return responses.ErrorWithMessage(c, apperror.ErrUnauthorized, "invalid token")
+	}
+
+	url, expiresAt, err := h.attachmentUseCase.CreateDownloadURL(id, userID, c.QueryInt("size"))
+	if err != nil {
+		return responses.Error(c, err)
+	}
+	return c.JSON(dto.DownloadURLResponse{URL: url, ExpiresAt: expiresAt})
+}
+
+// DownloadAttachment godoc
+// @Summary Download an attachment through a signed URL
+// @Tags attachments
+// @Produce octet-stream
+// @Param id path int true "Attachment ID"
+// @Param size query int false "Thumbnail size"
+// @Param expires query int true "Expiry (unix seconds)"
+// @Param signature query string true "URL signature"
+// @Success 200 {file} binary
+// @Router /attachments/{id}/download [get]
+func (h *HttpAttachmentHandler) DownloadAttachment(c *fiber.Ctx) error {
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return responses.ErrorWithMessage(c, err, "invalid id")
+	}
+	userID, err := middleware.UserID(c)
+	if err != nil {
+		return responses.ErrorWithMessage(c, apperror.ErrUnauthorized, "invalid token")
+	}
+	expires, err := strconv.ParseInt(c.Query("expires"), 10, 64)
+	if err != nil {
+		return responses.ErrorWithMessage(c, apperror.ErrUnauthorized, "invalid expires")
+	}
+
+	content, err := h.attachmentUseCase.OpenAttachment(id, userID, c.QueryInt("size"), expires, c.Query("signature"))
+	if err != nil {
+		return responses.Error(c, err)
+	}
+
+	c.Set(fiber.HeaderContentType, content.MimeType)
+	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", content.FileName))
+	return c.SendStream(content.Body, int(content.Size))
+}
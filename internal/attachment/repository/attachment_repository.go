@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"github.com/MingPV/ChatService/internal/entities"
+)
+
+type AttachmentRepository interface {
+	Save(attachment *entities.Attachment) error
+	FindByID(id int) (*entities.Attachment, error)
+	FindAllByMessageID(messageId uint) ([]*entities.Attachment, error)
+	// AttachToMessage claims unattached uploads for messageId, all or none;
+	// it fails with ErrNoDocuments when any of them is already taken.
+	AttachToMessage(ids []uint, messageId uint) error
+	UpdatePreview(id uint, thumbnails []entities.Thumbnail, placeholder string) error
+	Delete(id int) error
+}
@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/MingPV/ChatService/internal/entities"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type MongoAttachmentRepository struct {
+	db   *mongo.Database
+	coll *mongo.Collection
+}
+
+func NewMongoAttachmentRepository(db *mongo.Database) AttachmentRepository {
+	return &MongoAttachmentRepository{
+		db:   db,
+		coll: db.Collection("attachments"),
+	}
+}
+
+type counterDoc struct {
+	ID  string `bson:"_id"`
+	Seq int    `bson:"seq"`
+}
+
+func (r *MongoAttachmentRepository) getNextSequence(ctx context.Context, name string) (int, error) {
+	counters := r.db.Collection("counters")
+	opts := options.FindOneAndUpdate().
+		SetUpsert(true).
+		SetReturnDocument(options.After)
+
+	var out counterDoc
+	err := counters.FindOneAndUpdate(
+		ctx,
+		bson.M{"_id": name},
+		bson.M{"$inc": bson.M{"seq": 1}},
+		opts,
+	).Decode(&out)
+
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		_, ierr := counters.InsertOne(ctx, counterDoc{ID: name, Seq: 1})
+		if ierr != nil {
+			return 0, ierr
+		}
+		return 1, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if out.Seq == 0 {
+		return 1, nil
+	}
+	return out.Seq, nil
+}
+
+func (r *MongoAttachmentRepository) Save(attachment *entities.Attachment) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	nextID, err := r.getNextSequence(ctx, "attachments")
+	if err != nil {
+		return err
+	}
+
+	attachment.ID = uint(nextID)
+	if _, err := r.coll.InsertOne(ctx, attachment); err != nil {
+		attachment.ID = 0
+		return err
+	}
+	return nil
+}
+
+func (r *MongoAttachmentRepository) FindByID(id int) (*entities.Attachment, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var attachment entities.Attachment
+	err := r.coll.FindOne(ctx, bson.M{"_id": id}).Decode(&attachment)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return &entities.Attachment{}, err
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &attachment, nil
+}
+
+func (r *MongoAttachmentRepository) FindAllByMessageID(messageId uint) ([]*entities.Attachment, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	cur, err := r.coll.Find(ctx, bson.M{"message_id": messageId})
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var results []*entities.Attachment
+	for cur.Next(ctx) {
+		var a entities.Attachment
+		if err := cur.Decode(&a); err != nil {
+			return nil, err
+		}
+		results = append(results, &a)
+	}
+	return results, cur.Err()
+}
+
+// AttachToMessage links uploaded attachments to the message that references them.
+// Only unclaimed attachments are linked; if any of ids already belongs to a message,
+// the ones claimed here are released again and ErrNoDocuments is returned.
+func (r *MongoAttachmentRepository) AttachToMessage(ids []uint, messageId uint) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	res, err := r.coll.UpdateMany(ctx,
+		bson.M{"_id": bson.M{"$in": ids}, "message_id": 0},
+		bson.M{"$set": bson.M{"message_id": messageId}},
+	)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == int64(len(ids)) {
+		return nil
+	}
+	if _, err := r.coll.UpdateMany(ctx,
+		bson.M{"_id": bson.M{"$in": ids}, "message_id": messageId},
+		bson.M{"$set": bson.M{"message_id": 0}},
+	); err != nil {
+		return err
+	}
+	return mongo.ErrNoDocuments
+}
+
+func (r *MongoAttachmentRepository) UpdatePreview(id uint, thumbnails []entities.Thumbnail, placeholder string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := r.coll.UpdateByID(ctx, id, bson.M{"$set": bson.M{
+		"thumbnails":  thumbnails,
+		"placeholder": placeholder,
+	}})
+	return err
+}
+
+func (r *MongoAttachmentRepository) Delete(id int) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := r.coll.DeleteOne(ctx, bson.M{"_id": id})
+	return err
+}
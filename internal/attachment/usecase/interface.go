@@ -0,0 +1,30 @@
+package usecase
+
+import (
+	"io"
+	"time"
+
+	"github.com/MingPV/ChatService/internal/entities"
+	"github.com/google/uuid"
+)
+
+type DownloadContent struct {
+	FileName string
+	MimeType string
+	Size     int64
+	Body     io.ReadCloser
+}
+
+type AttachmentUseCase interface {
+	// UploadAttachment validates and stores content, filling in size, checksum,
+	// MIME type and image dimensions on attachment.
+	UploadAttachment(attachment *entities.Attachment, content io.Reader) error
+	FindAttachmentByID(id int) (*entities.Attachment, error)
+
+	// CreateDownloadURL returns a signed, expiring download path for a room member.
+	// A non-zero size selects the thumbnail generated for that size.
+	CreateDownloadURL(id int, userId uuid.UUID, size int) (string, time.Time, error)
+	// OpenAttachment verifies a signed download request and opens the blob.
+	// The returned MIME type and length describe the opened original or thumbnail.
+	OpenAttachment(id int, userId uuid.UUID, size int, expires int64, signature string) (*DownloadContent, error)
+}
@@ -0,0 +1,153 @@
+package usecase
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestFitWithin(t *testing.T) {
+	tests := []struct {
+		name         string
+		w, h, box    int
+		wantW, wantH int
+	}{
+		{"already fits", 100, 50, 200, 100, 50},
+		{"landscape", 1000, 500, 200, 200, 100},
+		{"portrait", 500, 1000, 200, 100, 200},
+		{"square", 400, 400, 100, 100, 100},
+		{"thin strip keeps a pixel", 10000, 10, 100, 100, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, h := fitWithin(tt.w, tt.h, tt.box)
+			if w != tt.wantW || h != tt.wantH {
+				t.Fatalf("fitWithin(%d, %d, %d) = %d x %d, want %d x %d", tt.w, tt.h, tt.box, w, h, tt.wantW, tt.wantH)
+			}
+		})
+	}
+}
+
+func TestDownscaleAveragesPixels(t *testing.T) {
+	// left half black, right half white
+	src := image.NewRGBA(image.Rect(0, 0, 4, 2))
+	for y := 0; y < 2; y++ {
+		for x := 0; x < 4; x++ {
+			c := color.RGBA{A: 255}
+			if x >= 2 {
+				c = color.RGBA{R: 255, G: 255, B: 255, A: 255}
+			}
+			src.Set(x, y, c)
+		}
+	}
+
+	tests := []struct {
+		name  string
+		w, h  int
+		x     int
+		wantR uint16
+	}{
+		{"halves keep their colour", 2, 1, 0, 0},
+		{"white half", 2, 1, 1, 0xffff},
+		{"single pixel is the mean", 1, 1, 0, 0x7fff},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dst := downscale(src, tt.w, tt.h)
+			if b := dst.Bounds(); b.Dx() != tt.w || b.Dy() != tt.h {
+				t.Fatalf("downscale() bounds = %v, want %dx%d", b, tt.w, tt.h)
+			}
+			if r := dst.RGBA64At(tt.x, 0).R; r != tt.wantR {
+				t.Fatalf("pixel %d red = %#x, want %#x", tt.x, r, tt.wantR)
+			}
+		})
+	}
+}
+
+func TestBlurhash(t *testing.T) {
+	fill := func(c color.RGBA) image.Image {
+		img := image.NewRGBA(image.Rect(0, 0, 8, 8))
+		for x := 0; x < 8; x++ {
+			for y := 0; y < 8; y++ {
+				img.Set(x, y, c)
+			}
+		}
+		return img
+	}
+
+	tests := []struct {
+		name         string
+		img          image.Image
+		xComp, yComp int
+		wantDC       int // average colour as 0xRRGGBB
+	}{
+		{"white 4x3", fill(color.RGBA{R: 255, G: 255, B: 255, A: 255}), 4, 3, 0xffffff},
+		{"red 1x1", fill(color.RGBA{R: 255, A: 255}), 1, 1, 0xff0000},
+		{"grey 3x3", fill(color.RGBA{R: 128, G: 128, B: 128, A: 255}), 3, 3, 0x808080},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := blurhash(tt.img, tt.xComp, tt.yComp)
+			if wantLen := 4 + 2*tt.xComp*tt.yComp; len(got) != wantLen {
+				t.Fatalf("blurhash() = %q, length %d, want %d", got, len(got), wantLen)
+			}
+			if flag := decode83(got[:1]); flag != (tt.xComp-1)+(tt.yComp-1)*9 {
+				t.Fatalf("size flag = %d, want components %dx%d", flag, tt.xComp, tt.yComp)
+			}
+			if dc := decode83(got[2:6]); dc != tt.wantDC {
+				t.Fatalf("dc = %#06x, want %#06x", dc, tt.wantDC)
+			}
+		})
+	}
+}
+
+func decode83(s string) int {
+	v := 0
+	for _, c := range s {
+		v = v*83 + strings.IndexRune(base83Chars, c)
+	}
+	return v
+}
+
+func TestDecodeImageRejectsHugeDimensions(t *testing.T) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewGray(image.Rect(0, 0, 2, 2))); err != nil {
+		t.Fatal(err)
+	}
+	small := buf.Bytes()
+
+	tests := []struct {
+		name    string
+		data    []byte
+		wantErr bool
+	}{
+		{"small image", small, false},
+		{"declared 100000x100000", withPNGSize(small, 100000, 100000), true},
+		{"not an image", []byte("hello"), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			open := func() (io.ReadCloser, error) { return io.NopCloser(bytes.NewReader(tt.data)), nil }
+			_, _, err := decodeImage(open)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("decodeImage() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// withPNGSize rewrites the IHDR dimensions of a PNG and fixes up its checksum
+func withPNGSize(data []byte, w, h uint32) []byte {
+	out := append([]byte(nil), data...)
+	// signature (8) + length (4) + "IHDR" (4)
+	binary.BigEndian.PutUint32(out[16:], w)
+	binary.BigEndian.PutUint32(out[20:], h)
+	binary.BigEndian.PutUint32(out[29:], crc32.ChecksumIEEE(out[12:29]))
+	return out
+}
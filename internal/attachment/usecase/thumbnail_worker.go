@@ -0,0 +1,186 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"log"
+	"time"
+
+	attachmentRepo "github.com/MingPV/ChatService/internal/attachment/repository"
+	"github.com/MingPV/ChatService/internal/entities"
+	messageRepo "github.com/MingPV/ChatService/internal/message/repository"
+	messageUseCase "github.com/MingPV/ChatService/internal/message/usecase"
+	"github.com/MingPV/ChatService/pkg/blobstore"
+)
+
+// placeholderBox is the size images are shrunk to before computing the blurhash
+const placeholderBox = 32
+
+// maxImagePixels caps the dimensions an upload may declare before it is decoded,
+// so a tiny file claiming a huge canvas cannot exhaust the worker's memory
+const maxImagePixels = 40_000_000
+
+// ThumbnailWorker generates thumbnails and blurhash placeholders for image
+// attachments in the background and notifies room subscribers when done.
+// It implements messageUseCase.MessageProcessor.
+type ThumbnailWorker struct {
+	attachmentRepo attachmentRepo.AttachmentRepository
+	messageRepo    messageRepo.MessageRepository
+	messageUseCase messageUseCase.MessageUseCase
+	store          blobstore.BlobStore
+	sizes          []int
+	queue          chan *entities.Message
+}
+
+func NewThumbnailWorker(attachmentRepo attachmentRepo.AttachmentRepository, messageRepo messageRepo.MessageRepository, messageUseCase messageUseCase.MessageUseCase, store blobstore.BlobStore, sizes []int) *ThumbnailWorker {
+	return &ThumbnailWorker{
+		attachmentRepo: attachmentRepo,
+		messageRepo:    messageRepo,
+		messageUseCase: messageUseCase,
+		store:          store,
+		sizes:          sizes,
+		queue:          make(chan *entities.Message, 100),
+	}
+}
+
+// Process queues message if it carries image attachments.
+func (w *ThumbnailWorker) Process(message *entities.Message) {
+	for _, a := range message.Attachments {
+		if a.IsImage() {
+			select {
+			case w.queue <- message:
+			default:
+				log.Printf("thumbnail queue full, skipping message %d", message.ID)
+			}
+			return
+		}
+	}
+}
+
+// Start runs n workers until ctx is cancelled.
+func (w *ThumbnailWorker) Start(ctx context.Context, n int) {
+	for i := 0; i < n; i++ {
+		go func() {
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case message := <-w.queue:
+					if err := w.generate(ctx, message); err != nil {
+						log.Printf("thumbnail generation for message %d failed: %v", message.ID, err)
+					}
+				}
+			}
+		}()
+	}
+}
+
+func (w *ThumbnailWorker) generate(ctx context.Context, message *entities.Message) error {
+	// work on a copy, the original message is shared with room subscribers
+	attachments := make([]entities.Attachment, len(message.Attachments))
+	copy(attachments, message.Attachments)
+
+	changed := false
+	for i := range attachments {
+		a := &attachments[i]
+		if !a.IsImage() || len(a.Thumbnails) > 0 {
+			continue
+		}
+		thumbnails, placeholder, err := w.renderPreviews(ctx, a)
+		if err != nil {
+			log.Printf("skipping previews for attachment %d: %v", a.ID, err)
+			continue
+		}
+		if err := w.attachmentRepo.UpdatePreview(a.ID, thumbnails, placeholder); err != nil {
+			return err
+		}
+		a.Thumbnails, a.Placeholder = thumbnails, placeholder
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+
+	if err := w.messageRepo.UpdateAttachments(message.ID, attachments); err != nil {
+		return err
+	}
+	updated, err := w.messageRepo.FindByID(int(message.ID))
+	if err != nil {
+		return err
+	}
+	w.messageUseCase.PublishRoomEvent(&entities.RoomEvent{Type: entities.RoomEventMessageUpdated, RoomId: updated.RoomId, Message: updated})
+	return nil
+}
+
+// renderPreviews decodes the original image and stores one thumbnail per
+// configured size next to it. Sizes not smaller than the original are skipped.
+func (w *ThumbnailWorker) renderPreviews(ctx context.Context, a *entities.Attachment) ([]entities.Thumbnail, string, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+
+	src, format, err := decodeImage(func() (io.ReadCloser, error) { return w.store.Get(ctx, a.StorageKey) })
+	if err != nil {
+		return nil, "", err
+	}
+
+	b := src.Bounds()
+	var thumbnails []entities.Thumbnail
+	for _, size := range w.sizes {
+		if b.Dx() <= size && b.Dy() <= size {
+			continue
+		}
+		tw, th := fitWithin(b.Dx(), b.Dy(), size)
+		img := downscale(src, tw, th)
+
+		var buf bytes.Buffer
+		mimeType := "image/png"
+		if format == "jpeg" {
+			mimeType = "image/jpeg"
+			err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: 80})
+		} else {
+			err = png.Encode(&buf, img)
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		key := fmt.Sprintf("%s_%d", a.StorageKey, size)
+		if _, err := w.store.Put(ctx, key, &buf); err != nil {
+			return nil, "", err
+		}
+		thumbnails = append(thumbnails, entities.Thumbnail{Size: size, Width: tw, Height: th, MimeType: mimeType, StorageKey: key})
+	}
+
+	pw, ph := fitWithin(b.Dx(), b.Dy(), placeholderBox)
+	return thumbnails, blurhash(downscale(src, pw, ph), 4, 3), nil
+}
+
+// decodeImage reads the image header first and only decodes the pixels when the
+// declared size is within maxImagePixels. open is called once per pass.
+func decodeImage(open func() (io.ReadCloser, error)) (image.Image, string, error) {
+	rc, err := open()
+	if err != nil {
+		return nil, "", err
+	}
+	cfg, _, err := image.DecodeConfig(rc)
+	rc.Close()
+	if err != nil {
+		return nil, "", err
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || int64(cfg.Width)*int64(cfg.Height) > maxImagePixels {
+		return nil, "", errors.New("image dimensions out of range")
+	}
+
+	rc, err = open()
+	if err != nil {
+		return nil, "", err
+	}
+	defer rc.Close()
+	return image.Decode(rc)
+}
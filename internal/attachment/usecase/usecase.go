@@ -0,0 +1,255 @@
+package usecase
+
+import (
+	"bufio"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	attachmentRepo "github.com/MingPV/ChatService/internal/attachment/repository"
+	"github.com/MingPV/ChatService/internal/entities"
+	roommemberRepo "github.com/MingPV/ChatService/internal/room_member/repository"
+	"github.com/MingPV/ChatService/pkg/apperror"
+	"github.com/MingPV/ChatService/pkg/blobstore"
+	"github.com/MingPV/ChatService/pkg/config"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// AttachmentPolicy holds upload limits and download URL signing settings
+type AttachmentPolicy struct {
+	MaxSize      int64
+	AllowedTypes []string // exact types ("application/pdf") or wildcards ("image/*")
+	URLSecret    string
+	URLTTL       time.Duration
+}
+
+func NewAttachmentPolicy(cfg *config.Config) AttachmentPolicy {
+	return AttachmentPolicy{
+		MaxSize:      cfg.AttachmentMaxSize,
+		AllowedTypes: cfg.AttachmentAllowedTypes,
+		URLSecret:    cfg.JWTSecret,
+		URLTTL:       time.Duration(cfg.AttachmentURLTTL) * time.Second,
+	}
+}
+
+// AttachmentService implements AttachmentUseCase
+type AttachmentService struct {
+	attachmentRepo attachmentRepo.AttachmentRepository
+	roommemberRepo roommemberRepo.RoomMemberRepository
+	store          blobstore.BlobStore
+	policy         AttachmentPolicy
+}
+
+// Init AttachmentService
+func NewAttachmentService(attachmentRepo attachmentRepo.AttachmentRepository, roommemberRepo roommemberRepo.RoomMemberRepository, store blobstore.BlobStore, policy AttachmentPolicy) AttachmentUseCase {
+	return &AttachmentService{attachmentRepo: attachmentRepo, roommemberRepo: roommemberRepo, store: store, policy: policy}
+}
+
+func (s *AttachmentService) UploadAttachment(attachment *entities.Attachment, content io.Reader) error {
+	if attachment.RoomId == 0 || attachment.Uploader == uuid.Nil {
+		return apperror.ErrRequiredField
+	}
+	if err := s.checkMember(attachment.RoomId, attachment.Uploader); err != nil {
+		return err
+	}
+
+	// sniff the real content type instead of trusting the client
+	br := bufio.NewReaderSize(content, 512)
+	head, err := br.Peek(512)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, bufio.ErrBufferFull) {
+		return err
+	}
+	if len(head) == 0 {
+		return apperror.ErrInvalidData
+	}
+	mimeType := http.DetectContentType(head)
+	if mimeType == "application/octet-stream" && attachment.MimeType != "" {
+		mimeType = attachment.MimeType
+	}
+	if mt, _, err := mime.ParseMediaType(mimeType); err == nil {
+		mimeType = mt
+	}
+	if !s.isAllowedType(mimeType) {
+		return apperror.ErrInvalidFormat
+	}
+
+	attachment.MimeType = mimeType
+	attachment.FileName = path.Base(strings.ReplaceAll(attachment.FileName, "\\", "/"))
+	attachment.StorageKey = fmt.Sprintf("rooms/%d/%s", attachment.RoomId, uuid.NewString())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	hash := sha256.New()
+	limited := io.LimitReader(io.TeeReader(br, hash), s.policy.MaxSize+1)
+	size, err := s.store.Put(ctx, attachment.StorageKey, limited)
+	if err != nil {
+		_ = s.store.Delete(ctx, attachment.StorageKey)
+		return err
+	}
+	if size > s.policy.MaxSize {
+		_ = s.store.Delete(ctx, attachment.StorageKey)
+		return apperror.ErrLimitExceeded
+	}
+	attachment.Size = size
+	attachment.Checksum = hex.EncodeToString(hash.Sum(nil))
+
+	if attachment.IsImage() {
+		// formats without a registered decoder are stored without dimensions
+		if err := s.readDimensions(ctx, attachment); err != nil && !errors.Is(err, image.ErrFormat) {
+			_ = s.store.Delete(ctx, attachment.StorageKey)
+			return apperror.ErrInvalidData
+		}
+	}
+
+	attachment.MessageId = 0
+	attachment.CreatedAt = time.Now().UTC()
+	if err := s.attachmentRepo.Save(attachment); err != nil {
+		_ = s.store.Delete(ctx, attachment.StorageKey)
+		return err
+	}
+	return nil
+}
+
+func (s *AttachmentService) FindAttachmentByID(id int) (*entities.Attachment, error) {
+	attachment, err := s.attachmentRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+	return attachment, nil
+}
+
+func (s *AttachmentService) CreateDownloadURL(id int, userId uuid.UUID, size int) (string, time.Time, error) {
+	attachment, err := s.attachmentRepo.FindByID(id)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	if err := s.checkMember(attachment.RoomId, userId); err != nil {
+		return "", time.Time{}, err
+	}
+	if size != 0 && findThumbnail(attachment, size) == nil {
+		return "", time.Time{}, apperror.ErrRecordNotFound
+	}
+
+	expiresAt := time.Now().Add(s.policy.URLTTL).UTC()
+	// the user comes from the caller's token and is bound by the signature
+	q := url.Values{}
+	if size != 0 {
+		q.Set("size", fmt.Sprint(size))
+	}
+	q.Set("expires", fmt.Sprint(expiresAt.Unix()))
+	q.Set("signature", s.sign(attachment.ID, userId, size, expiresAt.Unix()))
+
+	return fmt.Sprintf("/api/v1/attachments/%d/download?%s", attachment.ID, q.Encode()), expiresAt, nil
+}
+
+func (s *AttachmentService) OpenAttachment(id int, userId uuid.UUID, size int, expires int64, signature string) (*DownloadContent, error) {
+	if time.Now().Unix() > expires {
+		return nil, apperror.ErrUnauthorized
+	}
+	if !hmac.Equal([]byte(signature), []byte(s.sign(uint(id), userId, size, expires))) {
+		return nil, apperror.ErrUnauthorized
+	}
+
+	attachment, err := s.attachmentRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+	// membership is checked again so users who left the room lose access
+	if err := s.checkMember(attachment.RoomId, userId); err != nil {
+		return nil, err
+	}
+
+	content := &DownloadContent{FileName: attachment.FileName, MimeType: attachment.MimeType, Size: attachment.Size}
+	key := attachment.StorageKey
+	if size != 0 {
+		thumbnail := findThumbnail(attachment, size)
+		if thumbnail == nil {
+			return nil, apperror.ErrRecordNotFound
+		}
+		key = thumbnail.StorageKey
+		content.MimeType = thumbnail.MimeType
+		content.Size = -1 // unknown, streamed until EOF
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	rc, err := s.store.Get(ctx, key)
+	if errors.Is(err, blobstore.ErrBlobNotFound) {
+		return nil, apperror.ErrRecordNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	content.Body = rc
+	return content, nil
+}
+
+// ---- Helper functions ----
+
+func (s *AttachmentService) checkMember(roomId uint, userId uuid.UUID) error {
+	_, err := s.roommemberRepo.FindAllByRoomIDAndUserID(roomId, userId)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return apperror.ErrForbidden
+	}
+	return err
+}
+
+func (s *AttachmentService) isAllowedType(mimeType string) bool {
+	for _, allowed := range s.policy.AllowedTypes {
+		if allowed == mimeType {
+			return true
+		}
+		if strings.HasSuffix(allowed, "/*") && strings.HasPrefix(mimeType, strings.TrimSuffix(allowed, "*")) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *AttachmentService) readDimensions(ctx context.Context, attachment *entities.Attachment) error {
+	rc, err := s.store.Get(ctx, attachment.StorageKey)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	cfg, _, err := image.DecodeConfig(rc)
+	if err != nil {
+		return err
+	}
+	attachment.Width = cfg.Width
+	attachment.Height = cfg.Height
+	return nil
+}
+
+func (s *AttachmentService) sign(id uint, userId uuid.UUID, size int, expires int64) string {
+	mac := hmac.New(sha256.New, []byte(s.policy.URLSecret))
+	fmt.Fprintf(mac, "%d:%s:%d:%d", id, userId, size, expires)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func findThumbnail(attachment *entities.Attachment, size int) *entities.Thumbnail {
+	for i := range attachment.Thumbnails {
+		if attachment.Thumbnails[i].Size == size {
+			return &attachment.Thumbnails[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,246 @@
+package usecase
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"image"
+	"image/png"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	attachmentRepo "github.com/MingPV/ChatService/internal/attachment/repository"
+	"github.com/MingPV/ChatService/internal/entities"
+	"github.com/MingPV/ChatService/internal/testsupport"
+	"github.com/MingPV/ChatService/pkg/apperror"
+	"github.com/MingPV/ChatService/pkg/blobstore"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type fakeAttachments struct {
+	attachmentRepo.AttachmentRepository
+	attachments map[uint]*entities.Attachment
+}
+
+func (f *fakeAttachments) Save(attachment *entities.Attachment) error {
+	attachment.ID = uint(len(f.attachments) + 1)
+	f.attachments[attachment.ID] = attachment
+	return nil
+}
+
+func (f *fakeAttachments) FindByID(id int) (*entities.Attachment, error) {
+	if a, ok := f.attachments[uint(id)]; ok {
+		return a, nil
+	}
+	return &entities.Attachment{}, mongo.ErrNoDocuments
+}
+
+// newAttachmentService serves room 1, where member belongs, from a blob store in a temp dir
+func newAttachmentService(t *testing.T, member uuid.UUID) (*AttachmentService, *fakeAttachments, *testsupport.Members, string) {
+	root := t.TempDir()
+	attachments := &fakeAttachments{attachments: make(map[uint]*entities.Attachment)}
+	members := testsupport.NewRoomMembers(1, map[uuid.UUID]entities.RoomRole{member: entities.RoomRoleMember})
+	policy := AttachmentPolicy{
+		MaxSize:      1 << 10,
+		AllowedTypes: []string{"image/*", "application/pdf"},
+		URLSecret:    "secret",
+		URLTTL:       time.Minute,
+	}
+	s := NewAttachmentService(attachments, members, blobstore.NewLocalBlobStore(root), policy).(*AttachmentService)
+	return s, attachments, members, root
+}
+
+// storedBlobs counts the files left in the blob store
+func storedBlobs(t *testing.T, root string) int {
+	count := 0
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err == nil && !info.IsDir() {
+			count++
+		}
+		return err
+	})
+	if err != nil {
+		t.Fatalf("walk blob store: %v", err)
+	}
+	return count
+}
+
+func encodePNG(t *testing.T, w, h int) []byte {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewGray(image.Rect(0, 0, w, h))); err != nil {
+		t.Fatalf("encode png: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestUploadAttachment(t *testing.T) {
+	member := uuid.New()
+	smallPNG := encodePNG(t, 3, 2)
+	pdf := []byte("%PDF-1.4\n%test document\n")
+
+	tests := []struct {
+		name         string
+		uploader     uuid.UUID
+		declaredType string
+		content      []byte
+		wantErr      error
+		wantType     string
+		wantW, wantH int
+	}{
+		{name: "image with dimensions", uploader: member, content: smallPNG, wantType: "image/png", wantW: 3, wantH: 2},
+		{name: "allowed document", uploader: member, content: pdf, wantType: "application/pdf"},
+		{name: "disallowed type", uploader: member, content: []byte("just some text"), wantErr: apperror.ErrInvalidFormat},
+		{name: "declared type is not trusted", uploader: member, declaredType: "image/png", content: []byte("just some text"), wantErr: apperror.ErrInvalidFormat},
+		{name: "oversize body", uploader: member, content: append(append([]byte{}, pdf...), bytes.Repeat([]byte("x"), 1<<10)...), wantErr: apperror.ErrLimitExceeded},
+		{name: "empty body", uploader: member, content: nil, wantErr: apperror.ErrInvalidData},
+		{name: "non-member", uploader: uuid.New(), content: smallPNG, wantErr: apperror.ErrForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, attachments, _, root := newAttachmentService(t, member)
+
+			attachment := &entities.Attachment{RoomId: 1, Uploader: tt.uploader, FileName: `C:\photos\cat.png`, MimeType: tt.declaredType}
+			err := s.UploadAttachment(attachment, bytes.NewReader(tt.content))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("UploadAttachment() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				if len(attachments.attachments) != 0 || storedBlobs(t, root) != 0 {
+					t.Fatalf("failed upload left %d records and %d blobs", len(attachments.attachments), storedBlobs(t, root))
+				}
+				return
+			}
+
+			sum := sha256.Sum256(tt.content)
+			if attachment.MimeType != tt.wantType || attachment.Size != int64(len(tt.content)) || attachment.Checksum != hex.EncodeToString(sum[:]) {
+				t.Fatalf("attachment = %+v, want %s of %d bytes", attachment, tt.wantType, len(tt.content))
+			}
+			if attachment.Width != tt.wantW || attachment.Height != tt.wantH {
+				t.Fatalf("dimensions = %d x %d, want %d x %d", attachment.Width, attachment.Height, tt.wantW, tt.wantH)
+			}
+			if attachment.FileName != "cat.png" {
+				t.Fatalf("file name = %q, want the path stripped", attachment.FileName)
+			}
+		})
+	}
+}
+
+func TestOpenAttachment(t *testing.T) {
+	member, other := uuid.New(), uuid.New()
+
+	tests := []struct {
+		name    string
+		tamper  func(s *AttachmentService, userId *uuid.UUID, size *int, expires *int64, signature *string)
+		leave   bool
+		wantErr error
+	}{
+		{name: "signed URL opens the file"},
+		{
+			name: "tampered signature",
+			tamper: func(s *AttachmentService, userId *uuid.UUID, size *int, expires *int64, signature *string) {
+				*signature = strings.Repeat("0", len(*signature))
+			},
+			wantErr: apperror.ErrUnauthorized,
+		},
+		{
+			name: "extended expiry",
+			tamper: func(s *AttachmentService, userId *uuid.UUID, size *int, expires *int64, signature *string) {
+				*expires += 3600
+			},
+			wantErr: apperror.ErrUnauthorized,
+		},
+		{
+			name: "another user's URL",
+			tamper: func(s *AttachmentService, userId *uuid.UUID, size *int, expires *int64, signature *string) {
+				*userId = other
+			},
+			wantErr: apperror.ErrUnauthorized,
+		},
+		{
+			name: "expired URL",
+			tamper: func(s *AttachmentService, userId *uuid.UUID, size *int, expires *int64, signature *string) {
+				*expires = time.Now().Add(-time.Second).Unix()
+				*signature = s.sign(1, *userId, *size, *expires)
+			},
+			wantErr: apperror.ErrUnauthorized,
+		},
+		{name: "member who left", leave: true, wantErr: apperror.ErrForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _, members, _ := newAttachmentService(t, member)
+			content := []byte("%PDF-1.4\n%test document\n")
+			if err := s.UploadAttachment(&entities.Attachment{RoomId: 1, Uploader: member, FileName: "doc.pdf"}, bytes.NewReader(content)); err != nil {
+				t.Fatalf("UploadAttachment() error = %v", err)
+			}
+
+			link, _, err := s.CreateDownloadURL(1, member, 0)
+			if err != nil {
+				t.Fatalf("CreateDownloadURL() error = %v", err)
+			}
+			u, err := url.Parse(link)
+			if err != nil {
+				t.Fatalf("parse %q: %v", link, err)
+			}
+			userId, size := member, 0
+			expires, _ := strconv.ParseInt(u.Query().Get("expires"), 10, 64)
+			signature := u.Query().Get("signature")
+			if tt.tamper != nil {
+				tt.tamper(s, &userId, &size, &expires, &signature)
+			}
+			if tt.leave {
+				members.DeleteByRoomIDAndUserID(1, member)
+			}
+
+			download, err := s.OpenAttachment(1, userId, size, expires, signature)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("OpenAttachment() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			defer download.Body.Close()
+			body, _ := io.ReadAll(download.Body)
+			if !bytes.Equal(body, content) || download.MimeType != "application/pdf" {
+				t.Fatalf("download = %q (%s), want the uploaded file", body, download.MimeType)
+			}
+		})
+	}
+}
+
+func TestCreateDownloadURLChecksAccess(t *testing.T) {
+	member := uuid.New()
+
+	tests := []struct {
+		name    string
+		userId  uuid.UUID
+		size    int
+		wantErr error
+	}{
+		{name: "member", userId: member},
+		{name: "non-member", userId: uuid.New(), wantErr: apperror.ErrForbidden},
+		{name: "missing thumbnail size", userId: member, size: 64, wantErr: apperror.ErrRecordNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, attachments, _, _ := newAttachmentService(t, member)
+			attachments.Save(&entities.Attachment{RoomId: 1, Uploader: member, StorageKey: "rooms/1/x"})
+
+			_, _, err := s.CreateDownloadURL(1, tt.userId, tt.size)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CreateDownloadURL() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
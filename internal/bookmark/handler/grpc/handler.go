@@ -0,0 +1,89 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/MingPV/ChatService/internal/bookmark/usecase"
+	"github.com/MingPV/ChatService/internal/entities"
+	"github.com/MingPV/ChatService/pkg/apperror"
+	bookmarkpb "github.com/MingPV/ChatService/proto/bookmark"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type GrpcBookmarkHandler struct {
+	bookmarkUseCase usecase.BookmarkUseCase
+	bookmarkpb.UnimplementedBookmarkServiceServer
+}
+
+func NewGrpcBookmarkHandler(uc usecase.BookmarkUseCase) *GrpcBookmarkHandler {
+	return &GrpcBookmarkHandler{bookmarkUseCase: uc}
+}
+
+func (h *GrpcBookmarkHandler) SaveMessage(ctx context.Context, req *bookmarkpb.SaveMessageRequest) (*bookmarkpb.SaveMessageResponse, error) {
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+	bookmark, err := h.bookmarkUseCase.SaveMessage(userId, uint(req.MessageId), req.Note, req.Labels)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+	return &bookmarkpb.SaveMessageResponse{Bookmark: toProtoBookmark(bookmark)}, nil
+}
+
+func (h *GrpcBookmarkHandler) UnsaveMessage(ctx context.Context, req *bookmarkpb.UnsaveMessageRequest) (*bookmarkpb.UnsaveMessageResponse, error) {
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+	if err := h.bookmarkUseCase.UnsaveMessage(userId, uint(req.MessageId)); err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+	return &bookmarkpb.UnsaveMessageResponse{Message: "bookmark removed"}, nil
+}
+
+func (h *GrpcBookmarkHandler) FindAllBookmarks(ctx context.Context, req *bookmarkpb.FindAllBookmarksRequest) (*bookmarkpb.FindAllBookmarksResponse, error) {
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+	bookmarks, total, err := h.bookmarkUseCase.FindAllByUserID(userId, req.Label, int(req.Page), int(req.PageSize))
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+
+	var protoBookmarks []*bookmarkpb.Bookmark
+	for _, b := range bookmarks {
+		protoBookmarks = append(protoBookmarks, toProtoBookmark(b))
+	}
+	return &bookmarkpb.FindAllBookmarksResponse{Bookmarks: protoBookmarks, Total: total}, nil
+}
+
+// ---- Helper functions ----
+
+func toProtoBookmark(b *entities.Bookmark) *bookmarkpb.Bookmark {
+	out := &bookmarkpb.Bookmark{
+		Id:        int32(b.ID),
+		UserId:    b.UserId.String(),
+		MessageId: int32(b.MessageId),
+		RoomId:    int32(b.RoomId),
+		Note:      b.Note,
+		Labels:    b.Labels,
+		Deleted:   b.Deleted,
+		CreatedAt: timestamppb.New(b.CreatedAt),
+		UpdatedAt: timestamppb.New(b.UpdatedAt),
+	}
+	if b.Message != nil && !b.Deleted {
+		out.Message = &bookmarkpb.Message{
+			Id:        int32(b.Message.ID),
+			RoomId:    int32(b.Message.RoomId),
+			Message:   b.Message.Message,
+			Sender:    b.Message.Sender.String(),
+			CreatedAt: timestamppb.New(b.Message.CreatedAt),
+			UpdatedAt: timestamppb.New(b.Message.UpdatedAt),
+		}
+	}
+	return out
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"github.com/MingPV/ChatService/internal/entities"
+	"github.com/google/uuid"
+)
+
+type BookmarkRepository interface {
+	Save(bookmark *entities.Bookmark) error
+	Update(bookmark *entities.Bookmark) error
+	FindByUserIDAndMessageID(userId uuid.UUID, messageId uint) (*entities.Bookmark, error)
+	FindAllByUserID(userId uuid.UUID, label string, offset, limit int) ([]*entities.Bookmark, int64, error)
+	DeleteByUserIDAndMessageID(userId uuid.UUID, messageId uint) error
+	DeleteAllByRoomIDAndUserID(roomId uint, userId uuid.UUID) error
+	TombstoneAllByRoomID(roomId uint) error
+	TombstoneAllByMessageIDs(messageIds []uint) error
+	EnsureIndexes() error
+}
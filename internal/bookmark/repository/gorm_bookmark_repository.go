@@ -0,0 +1,207 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/MingPV/ChatService/internal/entities"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type MongoBookmarkRepository struct {
+	db   *mongo.Database
+	coll *mongo.Collection
+}
+
+func NewMongoBookmarkRepository(db *mongo.Database) BookmarkRepository {
+	return &MongoBookmarkRepository{
+		db:   db,
+		coll: db.Collection("bookmarks"),
+	}
+}
+
+type counterDoc struct {
+	ID  string `bson:"_id"`
+	Seq int    `bson:"seq"`
+}
+
+// EnsureIndexes makes (user_id, message_id) unique so a message is saved at most once per user
+func (r *MongoBookmarkRepository) EnsureIndexes() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	_, err := r.coll.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "message_id", Value: 1}},
+		Options: options.Index().SetUnique(true).SetName("user_message_unique"),
+	})
+	return err
+}
+
+func (r *MongoBookmarkRepository) Save(bookmark *entities.Bookmark) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	nextID, err := r.getNextSequence(ctx, "bookmarks")
+	if err != nil {
+		return err
+	}
+	bookmark.ID = uint(nextID)
+
+	msg := bookmark.Message
+	bookmark.Message = nil // never persisted, joined in on read
+	_, err = r.coll.InsertOne(ctx, bookmark)
+	bookmark.Message = msg
+	return err
+}
+
+func (r *MongoBookmarkRepository) Update(bookmark *entities.Bookmark) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := r.coll.UpdateByID(ctx, bookmark.ID, bson.M{"$set": bson.M{
+		"note":       bookmark.Note,
+		"labels":     bookmark.Labels,
+		"updated_at": bookmark.UpdatedAt,
+	}})
+	return err
+}
+
+func (r *MongoBookmarkRepository) FindByUserIDAndMessageID(userId uuid.UUID, messageId uint) (*entities.Bookmark, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var b entities.Bookmark
+	err := r.coll.FindOne(ctx, bson.M{"user_id": userId, "message_id": messageId}).Decode(&b)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return &entities.Bookmark{}, err
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+// FindAllByUserID lists a user's bookmarks, newest first, with the saved message joined in.
+// An empty label matches every bookmark.
+func (r *MongoBookmarkRepository) FindAllByUserID(userId uuid.UUID, label string, offset, limit int) ([]*entities.Bookmark, int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	match := bson.M{"user_id": userId}
+	if label != "" {
+		match["labels"] = label
+	}
+
+	total, err := r.coll.CountDocuments(ctx, match)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$sort", Value: bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}}},
+		{{Key: "$skip", Value: offset}},
+		{{Key: "$limit", Value: limit}},
+		{{Key: "$lookup", Value: bson.M{
+			"from":         "messages",
+			"localField":   "message_id",
+			"foreignField": "_id",
+			"as":           "message",
+		}}},
+		{{Key: "$unwind", Value: bson.M{
+			"path":                       "$message",
+			"preserveNullAndEmptyArrays": true,
+		}}},
+	}
+	cur, err := r.coll.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer cur.Close(ctx)
+
+	var results []*entities.Bookmark
+	for cur.Next(ctx) {
+		var b entities.Bookmark
+		if err := cur.Decode(&b); err != nil {
+			return nil, 0, err
+		}
+		results = append(results, &b)
+	}
+	return results, total, cur.Err()
+}
+
+func (r *MongoBookmarkRepository) DeleteByUserIDAndMessageID(userId uuid.UUID, messageId uint) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	res, err := r.coll.DeleteOne(ctx, bson.M{"user_id": userId, "message_id": messageId})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// DeleteAllByRoomIDAndUserID drops a user's bookmarks in a room they no longer belong to
+func (r *MongoBookmarkRepository) DeleteAllByRoomIDAndUserID(roomId uint, userId uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := r.coll.DeleteMany(ctx, bson.M{"room_id": roomId, "user_id": userId})
+	return err
+}
+
+// TombstoneAllByRoomID keeps bookmarks (and their notes) but flags that the messages are gone
+func (r *MongoBookmarkRepository) TombstoneAllByRoomID(roomId uint) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := r.coll.UpdateMany(ctx, bson.M{"room_id": roomId}, bson.M{"$set": bson.M{"deleted": true, "updated_at": time.Now()}})
+	return err
+}
+
+func (r *MongoBookmarkRepository) TombstoneAllByMessageIDs(messageIds []uint) error {
+	if len(messageIds) == 0 {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := r.coll.UpdateMany(ctx, bson.M{"message_id": bson.M{"$in": messageIds}}, bson.M{"$set": bson.M{"deleted": true, "updated_at": time.Now()}})
+	return err
+}
+
+// getNextSequence generates auto-increment ID
+func (r *MongoBookmarkRepository) getNextSequence(ctx context.Context, name string) (int, error) {
+	counters := r.db.Collection("counters")
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+
+	var out counterDoc
+	err := counters.FindOneAndUpdate(
+		ctx,
+		bson.M{"_id": name},
+		bson.M{"$inc": bson.M{"seq": 1}},
+		opts,
+	).Decode(&out)
+
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		_, ierr := counters.InsertOne(ctx, counterDoc{ID: name, Seq: 1})
+		if ierr != nil {
+			return 0, ierr
+		}
+		return 1, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if out.Seq == 0 {
+		return 1, nil
+	}
+	return out.Seq, nil
+}
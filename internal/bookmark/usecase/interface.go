@@ -0,0 +1,13 @@
+package usecase
+
+import (
+	"github.com/MingPV/ChatService/internal/entities"
+	"github.com/google/uuid"
+)
+
+type BookmarkUseCase interface {
+	// SaveMessage bookmarks a message, or updates the note and labels if it is already saved.
+	SaveMessage(userId uuid.UUID, messageId uint, note string, labels []string) (*entities.Bookmark, error)
+	UnsaveMessage(userId uuid.UUID, messageId uint) error
+	FindAllByUserID(userId uuid.UUID, label string, page, pageSize int) ([]*entities.Bookmark, int64, error)
+}
@@ -0,0 +1,131 @@
+package usecase
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	bookmarkRepo "github.com/MingPV/ChatService/internal/bookmark/repository"
+	"github.com/MingPV/ChatService/internal/entities"
+	messageRepo "github.com/MingPV/ChatService/internal/message/repository"
+	roommemberRepo "github.com/MingPV/ChatService/internal/room_member/repository"
+	"github.com/MingPV/ChatService/pkg/apperror"
+	"github.com/MingPV/ChatService/pkg/pagination"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	maxNoteLength  = 1000
+	maxLabels      = 10
+	maxLabelLength = 32
+)
+
+// BookmarkService implements BookmarkUseCase
+type BookmarkService struct {
+	bookmarkRepo   bookmarkRepo.BookmarkRepository
+	messageRepo    messageRepo.MessageRepository
+	roommemberRepo roommemberRepo.RoomMemberRepository
+}
+
+func NewBookmarkService(bookmarkRepo bookmarkRepo.BookmarkRepository, messageRepo messageRepo.MessageRepository, roommemberRepo roommemberRepo.RoomMemberRepository) BookmarkUseCase {
+	return &BookmarkService{bookmarkRepo: bookmarkRepo, messageRepo: messageRepo, roommemberRepo: roommemberRepo}
+}
+
+func (s *BookmarkService) SaveMessage(userId uuid.UUID, messageId uint, note string, labels []string) (*entities.Bookmark, error) {
+	note = strings.TrimSpace(note)
+	if len([]rune(note)) > maxNoteLength {
+		return nil, apperror.ErrInvalidData
+	}
+	labels, err := normalizeLabels(labels)
+	if err != nil {
+		return nil, err
+	}
+
+	message, err := s.messageRepo.FindByID(int(messageId))
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.roommemberRepo.FindAllByRoomIDAndUserID(message.RoomId, userId); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, apperror.ErrForbidden
+		}
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	existing, err := s.bookmarkRepo.FindByUserIDAndMessageID(userId, messageId)
+	if err == nil {
+		existing.Note = note
+		existing.Labels = labels
+		existing.UpdatedAt = now
+		if err := s.bookmarkRepo.Update(existing); err != nil {
+			return nil, err
+		}
+		existing.Message = message
+		return existing, nil
+	}
+	if !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, err
+	}
+
+	bookmark := &entities.Bookmark{
+		UserId:    userId,
+		MessageId: messageId,
+		RoomId:    message.RoomId,
+		Note:      note,
+		Labels:    labels,
+		CreatedAt: now,
+		UpdatedAt: now,
+		Message:   message,
+	}
+	if err := s.bookmarkRepo.Save(bookmark); err != nil {
+		return nil, err
+	}
+	return bookmark, nil
+}
+
+func (s *BookmarkService) UnsaveMessage(userId uuid.UUID, messageId uint) error {
+	return s.bookmarkRepo.DeleteByUserIDAndMessageID(userId, messageId)
+}
+
+func (s *BookmarkService) FindAllByUserID(userId uuid.UUID, label string, page, pageSize int) ([]*entities.Bookmark, int64, error) {
+	offset, limit := pagination.OffsetLimit(page, pageSize)
+	bookmarks, total, err := s.bookmarkRepo.FindAllByUserID(userId, strings.ToLower(strings.TrimSpace(label)), offset, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+	now := time.Now()
+	for _, b := range bookmarks {
+		// disappearing messages are hidden as soon as they expire, even before the reaper runs
+		if b.Message != nil && b.Message.ExpiresAt != nil && !b.Message.ExpiresAt.After(now) {
+			b.Message = nil
+		}
+		// the message may have been removed without tombstoning
+		if b.Message == nil {
+			b.Deleted = true
+		}
+	}
+	return bookmarks, total, nil
+}
+
+// normalizeLabels lowercases, trims and de-duplicates labels, keeping their order
+func normalizeLabels(labels []string) ([]string, error) {
+	seen := make(map[string]bool)
+	var out []string
+	for _, l := range labels {
+		l = strings.ToLower(strings.TrimSpace(l))
+		if l == "" || seen[l] {
+			continue
+		}
+		if len([]rune(l)) > maxLabelLength {
+			return nil, apperror.ErrInvalidData
+		}
+		seen[l] = true
+		out = append(out, l)
+	}
+	if len(out) > maxLabels {
+		return nil, apperror.ErrLimitExceeded
+	}
+	return out, nil
+}
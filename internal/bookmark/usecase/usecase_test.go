@@ -0,0 +1,132 @@
+package usecase
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	bookmarkRepo "github.com/MingPV/ChatService/internal/bookmark/repository"
+	"github.com/MingPV/ChatService/internal/entities"
+	messageRepo "github.com/MingPV/ChatService/internal/message/repository"
+	"github.com/MingPV/ChatService/internal/testsupport"
+	"github.com/MingPV/ChatService/pkg/apperror"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type fakeMessages struct {
+	messageRepo.MessageRepository
+	messages map[int]*entities.Message
+}
+
+func (f *fakeMessages) FindByID(id int) (*entities.Message, error) {
+	if m, ok := f.messages[id]; ok {
+		return m, nil
+	}
+	return &entities.Message{}, mongo.ErrNoDocuments
+}
+
+type fakeBookmarks struct {
+	bookmarkRepo.BookmarkRepository
+	bookmarks []*entities.Bookmark
+	saves     int
+	updates   int
+}
+
+func (f *fakeBookmarks) FindByUserIDAndMessageID(userId uuid.UUID, messageId uint) (*entities.Bookmark, error) {
+	for _, b := range f.bookmarks {
+		if b.UserId == userId && b.MessageId == messageId {
+			copied := *b
+			return &copied, nil
+		}
+	}
+	return &entities.Bookmark{}, mongo.ErrNoDocuments
+}
+
+func (f *fakeBookmarks) Save(bookmark *entities.Bookmark) error {
+	f.saves++
+	f.bookmarks = append(f.bookmarks, bookmark)
+	return nil
+}
+
+func (f *fakeBookmarks) Update(bookmark *entities.Bookmark) error {
+	f.updates++
+	return nil
+}
+
+func TestNormalizeLabels(t *testing.T) {
+	tooMany := make([]string, maxLabels+1)
+	for i := range tooMany {
+		tooMany[i] = string(rune('a' + i))
+	}
+
+	tests := []struct {
+		name    string
+		labels  []string
+		want    []string
+		wantErr error
+	}{
+		{"trims, lowercases and dedupes", []string{" Work ", "work", "", "Later"}, []string{"work", "later"}, nil},
+		{"empty", nil, nil, nil},
+		{"label too long", []string{strings.Repeat("x", maxLabelLength+1)}, nil, apperror.ErrInvalidData},
+		{"too many labels", tooMany, nil, apperror.ErrLimitExceeded},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := normalizeLabels(tt.labels)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Fatalf("labels = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSaveMessage(t *testing.T) {
+	member := uuid.New()
+	saver := uuid.New()
+	stranger := uuid.New()
+
+	tests := []struct {
+		name        string
+		userId      uuid.UUID
+		note        string
+		wantErr     error
+		wantSaves   int
+		wantUpdates int
+	}{
+		{"new bookmark", member, "read later", nil, 1, 0},
+		{"saving again updates the note", saver, "new note", nil, 0, 1},
+		{"non-member", stranger, "", apperror.ErrForbidden, 0, 0},
+		{"note too long", member, strings.Repeat("x", maxNoteLength+1), apperror.ErrInvalidData, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bookmarks := &fakeBookmarks{bookmarks: []*entities.Bookmark{
+				{ID: 1, UserId: saver, MessageId: 7, RoomId: 3, Note: "old note", CreatedAt: time.Now()},
+			}}
+			s := NewBookmarkService(
+				bookmarks,
+				&fakeMessages{messages: map[int]*entities.Message{7: {ID: 7, RoomId: 3}}},
+				testsupport.NewRoomMembers(3, map[uuid.UUID]entities.RoomRole{member: entities.RoomRoleMember, saver: entities.RoomRoleMember}),
+			)
+
+			got, err := s.SaveMessage(tt.userId, 7, tt.note, []string{"Todo"})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if bookmarks.saves != tt.wantSaves || bookmarks.updates != tt.wantUpdates {
+				t.Fatalf("saves = %d, updates = %d, want %d, %d", bookmarks.saves, bookmarks.updates, tt.wantSaves, tt.wantUpdates)
+			}
+			if err != nil {
+				return
+			}
+			if got.Note != tt.note || got.RoomId != 3 || got.Message == nil {
+				t.Fatalf("bookmark = %+v, want note %q in room 3 with the message", got, tt.note)
+			}
+		})
+	}
+}
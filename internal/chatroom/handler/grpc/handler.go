@@ -30,6 +30,13 @@ func (h *GrpcChatroomHandler) CreateChatroom(ctx context.Context, req *chatroomp
 		RoomName: req.RoomName,
 		IsGroup: req.IsGroup,
 		Owner: ownerUUID,
+		MessageTTL: int(req.MessageTtl),
+		Description: req.Description,
+		Topic: req.Topic,
+		AvatarURL: req.AvatarUrl,
+		Visibility: entities.RoomVisibility(req.Visibility),
+		JoinPolicy: entities.JoinPolicy(req.JoinPolicy),
+		PostingPolicy: entities.PostingPolicy(req.PostingPolicy),
 	}
 
 	if err := h.chatroomUseCase.CreateChatroom(chatroom); err != nil {
@@ -47,16 +54,11 @@ func (h *GrpcChatroomHandler) FindChatroomByID(ctx context.Context, req *chatroo
 }
 
 func (h *GrpcChatroomHandler) PatchChatroom(ctx context.Context, req *chatroompb.PatchChatroomRequest) (*chatroompb.PatchChatroomResponse, error){
-	ownerUUID, err := uuid.Parse(req.Owner)
+	userUUID, err := uuid.Parse(req.Owner)
 	if err != nil {
-		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
-	}
-	chatroom := &entities.Chatroom{
-		RoomName: req.RoomName,
-		IsGroup: req.IsGroup,
-		Owner: ownerUUID,
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
 	}
-	updatedChatroom, err := h.chatroomUseCase.PatchChatroom(int(req.Id), chatroom)
+	updatedChatroom, err := h.chatroomUseCase.PatchChatroom(int(req.Id), userUUID, req.RoomName)
 	if err != nil {
 		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
 	}
@@ -70,6 +72,70 @@ func (h *GrpcChatroomHandler) DeleteChatroom(ctx context.Context, req *chatroomp
 	return &chatroompb.DeleteChatroomResponse{Message: "chatroom deleted"}, nil
 }
 
+func (h *GrpcChatroomHandler) SetMessageTTL(ctx context.Context, req *chatroompb.SetMessageTTLRequest) (*chatroompb.SetMessageTTLResponse, error) {
+	userUUID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+	chatroom, err := h.chatroomUseCase.SetMessageTTL(int(req.Id), userUUID, int(req.MessageTtl))
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+	return &chatroompb.SetMessageTTLResponse{Chatroom: toProtoChatroom(chatroom)}, nil
+}
+
+func (h *GrpcChatroomHandler) UpdateChatroomSettings(ctx context.Context, req *chatroompb.UpdateChatroomSettingsRequest) (*chatroompb.UpdateChatroomSettingsResponse, error) {
+	userUUID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+	settings := &entities.Chatroom{}
+	if s := req.Settings; s != nil {
+		settings = &entities.Chatroom{
+			RoomName: s.RoomName,
+			Description: s.Description,
+			Topic: s.Topic,
+			AvatarURL: s.AvatarUrl,
+			Visibility: entities.RoomVisibility(s.Visibility),
+			JoinPolicy: entities.JoinPolicy(s.JoinPolicy),
+			PostingPolicy: entities.PostingPolicy(s.PostingPolicy),
+		}
+	}
+	chatroom, err := h.chatroomUseCase.UpdateChatroomSettings(int(req.Id), userUUID, settings, req.GetUpdateMask().GetPaths())
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+	return &chatroompb.UpdateChatroomSettingsResponse{Chatroom: toProtoChatroom(chatroom)}, nil
+}
+
+func (h *GrpcChatroomHandler) FindPublicChatrooms(ctx context.Context, req *chatroompb.FindPublicChatroomsRequest) (*chatroompb.FindPublicChatroomsResponse, error) {
+	chatrooms, total, err := h.chatroomUseCase.FindPublicChatrooms(req.Query, int(req.Page), int(req.PageSize))
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+
+	var protoChatrooms []*chatroompb.Chatroom
+	for _, c := range chatrooms {
+		protoChatrooms = append(protoChatrooms, toProtoChatroom(c))
+	}
+	return &chatroompb.FindPublicChatroomsResponse{Chatrooms: protoChatrooms, Total: total}, nil
+}
+
+func (h *GrpcChatroomHandler) JoinChatroom(ctx context.Context, req *chatroompb.JoinChatroomRequest) (*chatroompb.JoinChatroomResponse, error) {
+	userUUID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+	member, request, err := h.chatroomUseCase.JoinChatroom(int(req.Id), userUUID)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+	if request != nil {
+		return &chatroompb.JoinChatroomResponse{Status: "requested", JoinRequestId: int32(request.ID)}, nil
+	}
+	return &chatroompb.JoinChatroomResponse{Status: "joined", MemberId: int32(member.ID)}, nil
+}
+
 func toProtoChatroom(ch *entities.Chatroom) *chatroompb.Chatroom {
 
 
@@ -80,5 +146,14 @@ func toProtoChatroom(ch *entities.Chatroom) *chatroompb.Chatroom {
 		Owner: ch.Owner.String(),
 		CreatedAt: timestamppb.New(ch.CreatedAt),
 		UpdatedAt: timestamppb.New(ch.UpdatedAt),
+		MessageTtl: int32(ch.MessageTTL),
+		Description: ch.Description,
+		Topic: ch.Topic,
+		AvatarUrl: ch.AvatarURL,
+		Visibility: string(ch.Visibility),
+		JoinPolicy: string(ch.JoinPolicy),
+		PostingPolicy: string(ch.PostingPolicy),
+		MemberCount: ch.MemberCount,
+		Type: string(ch.Type()),
 	}
 }
\ No newline at end of file
@@ -1,12 +1,24 @@
 package repository
 
 import (
+	"time"
+
 	"github.com/MingPV/ChatService/internal/entities"
+	"github.com/google/uuid"
 )
 
 type ChatroomRepository interface {
 	Save(chatroom *entities.Chatroom) error 
-	Patch(id int, chatroom *entities.Chatroom) error
 	FindByID(id int) (*entities.Chatroom, error)
+	// FindDirect returns the 1:1 room between a and b, including rooms created before DirectKey existed.
+	FindDirect(a, b uuid.UUID) (*entities.Chatroom, error)
+	// FindAllPublic searches the public directory by the words of query, most populated rooms first.
+	FindAllPublic(query string, offset, limit int) ([]*entities.Chatroom, int64, error)
+	UpdateMessageTTL(id int, ttl int) error
+	// TouchActivity keeps LastActivityAt and LastMessageId pointing at the newest message.
+	TouchActivity(id int, messageId uint, at time.Time) error
+	// UpdateSettings writes only the listed setting fields of chatroom.
+	UpdateSettings(id int, chatroom *entities.Chatroom, fields []string) error
 	Delete(id int) error
+	EnsureIndexes() error
 }
\ No newline at end of file
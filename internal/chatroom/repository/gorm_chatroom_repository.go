@@ -8,6 +8,7 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/MingPV/ChatService/internal/entities"
+	"github.com/MingPV/ChatService/pkg/apperror"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
@@ -30,10 +31,69 @@ type chatroomDoc struct {
 	RoomName	string		`bson:"room_name"`
 	IsGroup		bool 		`bson:"is_group"`
 	Owner 		uuid.UUID  `bson:"owner" json:"owner"`
+	MessageTTL	int			`bson:"message_ttl,omitempty"`
+	Description	string		`bson:"description,omitempty"`
+	Topic		string		`bson:"topic,omitempty"`
+	AvatarURL	string		`bson:"avatar_url,omitempty"`
+	Visibility	entities.RoomVisibility	`bson:"visibility,omitempty"`
+	JoinPolicy	entities.JoinPolicy		`bson:"join_policy,omitempty"`
+	PostingPolicy	entities.PostingPolicy	`bson:"posting_policy,omitempty"`
+	DirectKey	string		`bson:"direct_key,omitempty"`
+	LastActivityAt	*time.Time	`bson:"last_activity_at,omitempty"`
+	LastMessageId	uint		`bson:"last_message_id,omitempty"`
+	MemberCount	int64		`bson:"member_count"` // kept in step by the room member repository
 	CreatedAt 	time.Time 	`bson:"created_at"`
     UpdatedAt 	time.Time 	`bson:"updated_at"`
 }
 
+// EnsureIndexes allows a single 1:1 room per pair of users, indexes the public
+// directory and fills member_count on rooms created before it was stored
+func (r *MongoChatroomRepository) EnsureIndexes() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	_, err := r.coll.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "direct_key", Value: 1}},
+			Options: options.Index().
+				SetName("direct_key").
+				SetUnique(true).
+				SetPartialFilterExpression(bson.M{"direct_key": bson.M{"$exists": true}}),
+		},
+		{
+			Keys:    bson.D{{Key: "is_group", Value: 1}, {Key: "visibility", Value: 1}, {Key: "member_count", Value: -1}, {Key: "_id", Value: 1}},
+			Options: options.Index().SetName("directory"),
+		},
+		{
+			Keys:    bson.D{{Key: "room_name", Value: "text"}, {Key: "topic", Value: "text"}},
+			Options: options.Index().SetName("room_text"),
+		},
+	})
+	if err != nil {
+		return err
+	}
+	return r.backfillMemberCounts(ctx)
+}
+
+// backfillMemberCounts counts the members of rooms that have no member_count yet
+func (r *MongoChatroomRepository) backfillMemberCounts(ctx context.Context) error {
+	cur, err := r.coll.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"member_count": bson.M{"$exists": false}}}},
+		{{Key: "$lookup", Value: bson.M{
+			"from":         "room_members",
+			"localField":   "_id",
+			"foreignField": "room_id",
+			"as":           "members",
+		}}},
+		{{Key: "$project", Value: bson.M{"member_count": bson.M{"$size": "$members"}}}},
+		{{Key: "$merge", Value: bson.M{"into": "chatrooms", "on": "_id", "whenMatched": "merge", "whenNotMatched": "discard"}}},
+	})
+	if err != nil {
+		return err
+	}
+	return cur.Close(ctx)
+}
+
 type counterDoc struct {
 	ID  string `bson:"_id"`
 	Seq int    `bson:"seq"`
@@ -83,6 +143,14 @@ func (r *MongoChatroomRepository)	Save(chatroom *entities.Chatroom) error {
 		RoomName: chatroom.RoomName,
 		IsGroup: chatroom.IsGroup,
 		Owner: chatroom.Owner,
+		MessageTTL: chatroom.MessageTTL,
+		Description: chatroom.Description,
+		Topic: chatroom.Topic,
+		AvatarURL: chatroom.AvatarURL,
+		Visibility: chatroom.Visibility,
+		JoinPolicy: chatroom.JoinPolicy,
+		PostingPolicy: chatroom.PostingPolicy,
+		DirectKey: chatroom.DirectKey,
 		CreatedAt: chatroom.CreatedAt,
 		UpdatedAt: chatroom.UpdatedAt,
 	})
@@ -95,19 +163,60 @@ func (r *MongoChatroomRepository)	Save(chatroom *entities.Chatroom) error {
 	return nil
 }
 
-func (r *MongoChatroomRepository)	Patch(id int, chatroom *entities.Chatroom) error {
+func (r *MongoChatroomRepository)	UpdateMessageTTL(id int, ttl int) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	update := bson.M{}
-	update["room_name"] = chatroom.RoomName
-	update["owner"] = chatroom.Owner
-	update["is_group"] = chatroom.IsGroup
+	_, err := r.coll.UpdateByID(ctx, id, bson.M{"$set": bson.M{"message_ttl": ttl, "updated_at": time.Now()}})
+	return err
+}
+
+// TouchActivity records messageId as the room's newest message unless a newer one is already recorded
+func (r *MongoChatroomRepository)	TouchActivity(id int, messageId uint, at time.Time) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
 
-	_, err := r.coll.UpdateByID(ctx, id, bson.M{"$set": update})
+	_, err := r.coll.UpdateOne(ctx,
+		bson.M{"_id": id, "last_activity_at": bson.M{"$not": bson.M{"$gt": at}}},
+		bson.M{"$set": bson.M{"last_activity_at": at, "last_message_id": messageId}},
+	)
 	return err
 }
 
+// settingFields maps update-mask paths to the values stored for them
+var settingFields = map[string]func(c *entities.Chatroom) interface{}{
+	entities.ChatroomFieldRoomName:      func(c *entities.Chatroom) interface{} { return c.RoomName },
+	entities.ChatroomFieldDescription:   func(c *entities.Chatroom) interface{} { return c.Description },
+	entities.ChatroomFieldTopic:         func(c *entities.Chatroom) interface{} { return c.Topic },
+	entities.ChatroomFieldAvatarURL:     func(c *entities.Chatroom) interface{} { return c.AvatarURL },
+	entities.ChatroomFieldVisibility:    func(c *entities.Chatroom) interface{} { return c.Visibility },
+	entities.ChatroomFieldJoinPolicy:    func(c *entities.Chatroom) interface{} { return c.JoinPolicy },
+	entities.ChatroomFieldPostingPolicy: func(c *entities.Chatroom) interface{} { return c.PostingPolicy },
+}
+
+func (r *MongoChatroomRepository)	UpdateSettings(id int, chatroom *entities.Chatroom, fields []string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	update := bson.M{"updated_at": time.Now()}
+	for _, f := range fields {
+		value, ok := settingFields[f]
+		if !ok {
+			return apperror.ErrInvalidField
+		}
+		update[f] = value(chatroom)
+	}
+
+	res, err := r.coll.UpdateByID(ctx, id, bson.M{"$set": update})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
 func (r *MongoChatroomRepository)	FindByID(id int) (*entities.Chatroom, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -120,14 +229,90 @@ func (r *MongoChatroomRepository)	FindByID(id int) (*entities.Chatroom, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &entities.Chatroom{
+	return r.toEntity(ch), nil
+}
+
+func (r *MongoChatroomRepository)	FindDirect(a, b uuid.UUID) (*entities.Chatroom, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	filter := bson.M{"$or": []bson.M{
+		{"direct_key": entities.DirectRoomKey(a, b)},
+		// rooms made by friend requests before direct_key was stored
+		{"is_group": false, "room_name": bson.M{"$in": []string{entities.DirectRoomName(a, b), entities.DirectRoomName(b, a)}}},
+	}}
+	opts := options.FindOne().SetSort(bson.D{{Key: "_id", Value: 1}})
+
+	var ch chatroomDoc
+	err := r.coll.FindOne(ctx, filter, opts).Decode(&ch)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return &entities.Chatroom{}, err
+	}
+	if err != nil {
+		return nil, err
+	}
+	return r.toEntity(ch), nil
+}
+
+// FindAllPublic lists discoverable rooms whose name or topic matches the words
+// of query, most populated first, together with the total number of matches
+func (r *MongoChatroomRepository)	FindAllPublic(query string, offset, limit int) ([]*entities.Chatroom, int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	filter := bson.M{"is_group": true, "visibility": entities.RoomVisibilityPublic}
+	if query != "" {
+		filter["$text"] = bson.M{"$search": query}
+	}
+
+	total, err := r.coll.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "member_count", Value: -1}, {Key: "_id", Value: 1}}).
+		SetSkip(int64(offset)).
+		SetLimit(int64(limit))
+	cur, err := r.coll.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer cur.Close(ctx)
+
+	var results []*entities.Chatroom
+	for cur.Next(ctx) {
+		var d chatroomDoc
+		if err := cur.Decode(&d); err != nil {
+			return nil, 0, err
+		}
+		results = append(results, r.toEntity(d))
+	}
+	return results, total, cur.Err()
+}
+
+func (r *MongoChatroomRepository) toEntity(ch chatroomDoc) *entities.Chatroom {
+	chatroom := &entities.Chatroom{
 		ID:    uint(ch.ID),
 		RoomName: ch.RoomName,
 		IsGroup: ch.IsGroup,
 		Owner: ch.Owner,
+		MessageTTL: ch.MessageTTL,
+		Description: ch.Description,
+		Topic: ch.Topic,
+		AvatarURL: ch.AvatarURL,
+		Visibility: ch.Visibility,
+		JoinPolicy: ch.JoinPolicy,
+		PostingPolicy: ch.PostingPolicy,
+		DirectKey: ch.DirectKey,
+		LastActivityAt: ch.LastActivityAt,
+		LastMessageId: ch.LastMessageId,
+		MemberCount: ch.MemberCount,
 		CreatedAt: ch.CreatedAt,
 		UpdatedAt: ch.UpdatedAt,
-	}, nil
+	}
+	chatroom.ApplyDefaults()
+	return chatroom
 }
 
 func (r *MongoChatroomRepository)	Delete(id int) error {
@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/MingPV/ChatService/internal/entities"
+	roominviteUseCase "github.com/MingPV/ChatService/internal/room_invite/usecase"
+	"github.com/MingPV/ChatService/internal/testsupport"
+	"github.com/MingPV/ChatService/pkg/apperror"
+	"github.com/google/uuid"
+)
+
+type fakeJoinRequests struct {
+	roominviteUseCase.JoinRequestUseCase
+	requested []uuid.UUID
+}
+
+func (f *fakeJoinRequests) RequestToJoin(roomId uint, userId uuid.UUID, message string) (*entities.JoinRequest, error) {
+	f.requested = append(f.requested, userId)
+	return &entities.JoinRequest{RoomId: roomId, UserId: userId}, nil
+}
+
+func TestJoinChatroom(t *testing.T) {
+	member := uuid.New()
+	banned := uuid.New()
+	newcomer := uuid.New()
+	public := func(policy entities.JoinPolicy) *entities.Chatroom {
+		return &entities.Chatroom{ID: 1, IsGroup: true, Visibility: entities.RoomVisibilityPublic, JoinPolicy: policy}
+	}
+
+	tests := []struct {
+		name        string
+		room        *entities.Chatroom
+		userId      uuid.UUID
+		wantErr     error
+		wantMember  bool
+		wantRequest bool
+	}{
+		{"open room", public(entities.JoinPolicyOpen), newcomer, nil, true, false},
+		{"approval room files a request", public(entities.JoinPolicyApproval), newcomer, nil, false, true},
+		{"invite-only room", public(entities.JoinPolicyInvite), newcomer, apperror.ErrOperationDenied, false, false},
+		{"private room looks missing", &entities.Chatroom{ID: 1, IsGroup: true, Visibility: entities.RoomVisibilityPrivate, JoinPolicy: entities.JoinPolicyOpen}, newcomer, apperror.ErrRecordNotFound, false, false},
+		{"direct room looks missing", &entities.Chatroom{ID: 1, Visibility: entities.RoomVisibilityPublic, JoinPolicy: entities.JoinPolicyOpen}, newcomer, apperror.ErrRecordNotFound, false, false},
+		{"already a member", public(entities.JoinPolicyOpen), member, apperror.ErrAlreadyExists, false, false},
+		{"banned user", public(entities.JoinPolicyOpen), banned, apperror.ErrForbidden, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			members := testsupport.NewRoomMembers(1, map[uuid.UUID]entities.RoomRole{member: entities.RoomRoleMember})
+			joinRequests := &fakeJoinRequests{}
+			s := NewChatroomService(testsupport.NewChatrooms(tt.room), members, testsupport.NewRestrictions(1, entities.RestrictionBan, banned), nil, nil, joinRequests, nil)
+
+			gotMember, gotRequest, err := s.JoinChatroom(1, tt.userId)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("JoinChatroom = %v, want %v", err, tt.wantErr)
+			}
+			if (gotMember != nil) != tt.wantMember || (gotRequest != nil) != tt.wantRequest {
+				t.Fatalf("member = %v, request = %v, want member %v, request %v", gotMember, gotRequest, tt.wantMember, tt.wantRequest)
+			}
+			if joined := members.Find(1, tt.userId) != nil; joined != (tt.wantMember || tt.userId == member) {
+				t.Fatalf("membership stored = %v", joined)
+			}
+			if (len(joinRequests.requested) == 1) != tt.wantRequest {
+				t.Fatalf("join requests = %v", joinRequests.requested)
+			}
+		})
+	}
+}
@@ -2,11 +2,20 @@ package usecase
 
 import (
 	"github.com/MingPV/ChatService/internal/entities"
+	"github.com/google/uuid"
 )
 
 type ChatroomUseCase interface {
 	CreateChatroom(chatroom *entities.Chatroom) error 
 	FindChatroomByID(id int) (*entities.Chatroom, error)
-	PatchChatroom(id int, chatroom *entities.Chatroom) (*entities.Chatroom, error)
+	PatchChatroom(id int, userId uuid.UUID, roomName string) (*entities.Chatroom, error)
 	DeleteChatroom(id int) error
+	// SetMessageTTL turns disappearing messages on (ttl seconds) or off (0) for new messages.
+	SetMessageTTL(id int, userId uuid.UUID, ttl int) (*entities.Chatroom, error)
+	// UpdateChatroomSettings applies the settings named in fields (an update mask) and notifies members.
+	UpdateChatroomSettings(id int, userId uuid.UUID, settings *entities.Chatroom, fields []string) (*entities.Chatroom, error)
+	// FindPublicChatrooms searches public group rooms by name and topic.
+	FindPublicChatrooms(query string, page, pageSize int) ([]*entities.Chatroom, int64, error)
+	// JoinChatroom adds userId to an open public room, or files a join request when the room needs approval.
+	JoinChatroom(id int, userId uuid.UUID) (*entities.RoomMember, *entities.JoinRequest, error)
 }
\ No newline at end of file
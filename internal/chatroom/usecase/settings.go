@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/MingPV/ChatService/internal/entities"
+	"github.com/MingPV/ChatService/pkg/apperror"
+)
+
+const (
+	maxRoomNameLength    = 100
+	maxDescriptionLength = 1000
+	maxTopicLength       = 250
+	maxAvatarURLLength   = 2048
+)
+
+// validateSettings normalizes the masked fields of settings in place and returns
+// the mask without duplicates. Fields outside the mask are not looked at.
+func validateSettings(room *entities.Chatroom, settings *entities.Chatroom, fields []string) ([]string, error) {
+	if len(fields) == 0 {
+		return nil, apperror.ErrRequiredField
+	}
+
+	seen := make(map[string]bool, len(fields))
+	var mask []string
+	for _, f := range fields {
+		f = strings.TrimSpace(f)
+		if seen[f] {
+			continue
+		}
+		seen[f] = true
+		mask = append(mask, f)
+
+		switch f {
+		case entities.ChatroomFieldRoomName:
+			settings.RoomName = strings.TrimSpace(settings.RoomName)
+			if settings.RoomName == "" {
+				return nil, apperror.ErrRequiredField
+			}
+			if len([]rune(settings.RoomName)) > maxRoomNameLength {
+				return nil, apperror.ErrOutOfRange
+			}
+		case entities.ChatroomFieldDescription:
+			settings.Description = strings.TrimSpace(settings.Description)
+			if len([]rune(settings.Description)) > maxDescriptionLength {
+				return nil, apperror.ErrOutOfRange
+			}
+		case entities.ChatroomFieldTopic:
+			settings.Topic = strings.TrimSpace(settings.Topic)
+			if len([]rune(settings.Topic)) > maxTopicLength {
+				return nil, apperror.ErrOutOfRange
+			}
+		case entities.ChatroomFieldAvatarURL:
+			settings.AvatarURL = strings.TrimSpace(settings.AvatarURL)
+			if err := validateAvatarURL(settings.AvatarURL); err != nil {
+				return nil, err
+			}
+		case entities.ChatroomFieldVisibility:
+			if !settings.Visibility.IsValid() {
+				return nil, apperror.ErrInvalidData
+			}
+			// direct chats stay private
+			if !room.IsGroup && settings.Visibility != entities.RoomVisibilityPrivate {
+				return nil, apperror.ErrOperationDenied
+			}
+		case entities.ChatroomFieldJoinPolicy:
+			if !settings.JoinPolicy.IsValid() {
+				return nil, apperror.ErrInvalidData
+			}
+			if !room.IsGroup && settings.JoinPolicy != entities.JoinPolicyInvite {
+				return nil, apperror.ErrOperationDenied
+			}
+		case entities.ChatroomFieldPostingPolicy:
+			if !settings.PostingPolicy.IsValid() {
+				return nil, apperror.ErrInvalidData
+			}
+		default:
+			return nil, apperror.ErrInvalidField
+		}
+	}
+	return mask, nil
+}
+
+// validateAvatarURL accepts an empty value (no avatar) or an absolute http(s) URL
+func validateAvatarURL(raw string) error {
+	if raw == "" {
+		return nil
+	}
+	if len(raw) > maxAvatarURLLength {
+		return apperror.ErrOutOfRange
+	}
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return apperror.ErrInvalidFormat
+	}
+	return nil
+}
+
+// allSettingFields lists every setting a new room is validated against
+var allSettingFields = []string{
+	entities.ChatroomFieldRoomName,
+	entities.ChatroomFieldDescription,
+	entities.ChatroomFieldTopic,
+	entities.ChatroomFieldAvatarURL,
+	entities.ChatroomFieldVisibility,
+	entities.ChatroomFieldJoinPolicy,
+	entities.ChatroomFieldPostingPolicy,
+}
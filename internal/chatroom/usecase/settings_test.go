@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/MingPV/ChatService/internal/entities"
+	"github.com/MingPV/ChatService/pkg/apperror"
+)
+
+func TestValidateSettingsOnlyChecksMaskedFields(t *testing.T) {
+	room := &entities.Chatroom{IsGroup: true}
+	settings := &entities.Chatroom{Topic: "  launch week  ", Visibility: "bogus"}
+
+	mask, err := validateSettings(room, settings, []string{"topic", "topic"})
+	if err != nil {
+		t.Fatalf("validateSettings() error = %v", err)
+	}
+	if len(mask) != 1 || settings.Topic != "launch week" {
+		t.Fatalf("validateSettings() = %v, topic %q", mask, settings.Topic)
+	}
+}
+
+func TestValidateSettingsRejects(t *testing.T) {
+	group := &entities.Chatroom{IsGroup: true}
+	direct := &entities.Chatroom{}
+	tests := []struct {
+		name     string
+		room     *entities.Chatroom
+		settings entities.Chatroom
+		fields   []string
+		want     error
+	}{
+		{"empty mask", group, entities.Chatroom{}, nil, apperror.ErrRequiredField},
+		{"unknown field", group, entities.Chatroom{}, []string{"owner"}, apperror.ErrInvalidField},
+		{"blank name", group, entities.Chatroom{RoomName: " "}, []string{"room_name"}, apperror.ErrRequiredField},
+		{"bad avatar", group, entities.Chatroom{AvatarURL: "javascript:alert(1)"}, []string{"avatar_url"}, apperror.ErrInvalidFormat},
+		{"bad policy", group, entities.Chatroom{PostingPolicy: "nobody"}, []string{"posting_policy"}, apperror.ErrInvalidData},
+		{"public direct chat", direct, entities.Chatroom{Visibility: entities.RoomVisibilityPublic}, []string{"visibility"}, apperror.ErrOperationDenied},
+	}
+	for _, tt := range tests {
+		if _, err := validateSettings(tt.room, &tt.settings, tt.fields); !errors.Is(err, tt.want) {
+			t.Errorf("%s: validateSettings() error = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+}
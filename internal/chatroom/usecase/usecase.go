@@ -1,23 +1,57 @@
 package usecase
 
 import (
+	"errors"
+	"strings"
+
+	bookmarkRepo "github.com/MingPV/ChatService/internal/bookmark/repository"
 	chatroomRepo "github.com/MingPV/ChatService/internal/chatroom/repository"
 	"github.com/MingPV/ChatService/internal/entities"
 	messageRepo "github.com/MingPV/ChatService/internal/message/repository"
+	messageUseCase "github.com/MingPV/ChatService/internal/message/usecase"
+	roominviteUseCase "github.com/MingPV/ChatService/internal/room_invite/usecase"
 	roommemberRepo "github.com/MingPV/ChatService/internal/room_member/repository"
+	"github.com/MingPV/ChatService/pkg/apperror"
+	"github.com/MingPV/ChatService/pkg/pagination"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/mongo"
 )
 
+// maxMessageTTL is the longest disappearing-message timer a room can use (one year)
+const maxMessageTTL = 365 * 24 * 60 * 60
+
 type ChatroomService struct {
 	chatroomRepository chatroomRepo.ChatroomRepository
 	roommemberRepository roommemberRepo.RoomMemberRepository
+	restrictionRepository roommemberRepo.RestrictionRepository
 	messageRepository messageRepo.MessageRepository
+	bookmarkRepository bookmarkRepo.BookmarkRepository
+	joinRequestUseCase roominviteUseCase.JoinRequestUseCase
+	messageUseCase messageUseCase.MessageUseCase
 }
 
-func NewChatroomService(chatroomRepository chatroomRepo.ChatroomRepository, roommemberRepository roommemberRepo.RoomMemberRepository, messageRepository messageRepo.MessageRepository) ChatroomUseCase {
-	return &ChatroomService{chatroomRepository: chatroomRepository, roommemberRepository: roommemberRepository, messageRepository: messageRepository}
+func NewChatroomService(chatroomRepository chatroomRepo.ChatroomRepository, roommemberRepository roommemberRepo.RoomMemberRepository, restrictionRepository roommemberRepo.RestrictionRepository, messageRepository messageRepo.MessageRepository, bookmarkRepository bookmarkRepo.BookmarkRepository, joinRequestUseCase roominviteUseCase.JoinRequestUseCase, messageUseCase messageUseCase.MessageUseCase) ChatroomUseCase {
+	return &ChatroomService{chatroomRepository: chatroomRepository, roommemberRepository: roommemberRepository, restrictionRepository: restrictionRepository, messageRepository: messageRepository, bookmarkRepository: bookmarkRepository, joinRequestUseCase: joinRequestUseCase, messageUseCase: messageUseCase}
 }
 
 func (s *ChatroomService) CreateChatroom(chatroom *entities.Chatroom) error {
+	if chatroom.MessageTTL < 0 || chatroom.MessageTTL > maxMessageTTL {
+		return apperror.ErrOutOfRange
+	}
+	chatroom.ApplyDefaults()
+	fields := allSettingFields
+	if strings.TrimSpace(chatroom.RoomName) == "" {
+		// direct chats may be created without a name
+		fields = make([]string, 0, len(allSettingFields))
+		for _, f := range allSettingFields {
+			if f != entities.ChatroomFieldRoomName {
+				fields = append(fields, f)
+			}
+		}
+	}
+	if _, err := validateSettings(chatroom, chatroom, fields); err != nil {
+		return err
+	}
 	if err := s.chatroomRepository.Save(chatroom); err != nil {
 		return err
 	}
@@ -31,13 +65,9 @@ func (s *ChatroomService) FindChatroomByID(id int) (*entities.Chatroom, error) {
 	}
 	return chatroom, nil
 }
-func (s *ChatroomService) PatchChatroom(id int, chatroom *entities.Chatroom) (*entities.Chatroom, error) {
-	if err := s.chatroomRepository.Patch(id, chatroom); err != nil {
-		return nil, err
-	}
-	updatedChatroom, _ := s.chatroomRepository.FindByID(id)
-
-	return updatedChatroom, nil
+// PatchChatroom renames the room; it is UpdateChatroomSettings restricted to room_name
+func (s *ChatroomService) PatchChatroom(id int, userId uuid.UUID, roomName string) (*entities.Chatroom, error) {
+	return s.UpdateChatroomSettings(id, userId, &entities.Chatroom{RoomName: roomName}, []string{entities.ChatroomFieldRoomName})
 }
 
 func (s *ChatroomService) DeleteChatroom(id int) error {
@@ -49,8 +79,111 @@ func (s *ChatroomService) DeleteChatroom(id int) error {
 		return err
 	}
 
+	// keep saved items and their notes, but mark the messages as gone
+	if err := s.bookmarkRepository.TombstoneAllByRoomID(uint(id)); err != nil {
+		return err
+	}
+
 	if err := s.roommemberRepository.DeleteAllByRoomID(id); err != nil {
 		return err
 	}
 	return nil
-}
\ No newline at end of file
+}
+
+func (s *ChatroomService) SetMessageTTL(id int, userId uuid.UUID, ttl int) (*entities.Chatroom, error) {
+	if ttl < 0 || ttl > maxMessageTTL {
+		return nil, apperror.ErrOutOfRange
+	}
+	chatroom, err := s.chatroomRepository.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := roommemberRepo.CheckCanModerate(s.roommemberRepository, chatroom, userId); err != nil {
+		return nil, err
+	}
+
+	if err := s.chatroomRepository.UpdateMessageTTL(id, ttl); err != nil {
+		return nil, err
+	}
+	return s.publishUpdate(id, []string{"message_ttl"})
+}
+
+func (s *ChatroomService) UpdateChatroomSettings(id int, userId uuid.UUID, settings *entities.Chatroom, fields []string) (*entities.Chatroom, error) {
+	chatroom, err := s.chatroomRepository.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := roommemberRepo.CheckCanModerate(s.roommemberRepository, chatroom, userId); err != nil {
+		return nil, err
+	}
+
+	mask, err := validateSettings(chatroom, settings, fields)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.chatroomRepository.UpdateSettings(id, settings, mask); err != nil {
+		return nil, err
+	}
+	return s.publishUpdate(id, mask)
+}
+
+func (s *ChatroomService) FindPublicChatrooms(query string, page, pageSize int) ([]*entities.Chatroom, int64, error) {
+	offset, limit := pagination.OffsetLimit(page, pageSize)
+	return s.chatroomRepository.FindAllPublic(strings.TrimSpace(query), offset, limit)
+}
+
+func (s *ChatroomService) JoinChatroom(id int, userId uuid.UUID) (*entities.RoomMember, *entities.JoinRequest, error) {
+	chatroom, err := s.chatroomRepository.FindByID(id)
+	if err != nil {
+		return nil, nil, err
+	}
+	// rooms outside the directory are reported as missing
+	if !chatroom.IsDiscoverable() {
+		return nil, nil, apperror.ErrRecordNotFound
+	}
+
+	_, err = s.roommemberRepository.FindAllByRoomIDAndUserID(chatroom.ID, userId)
+	if err == nil {
+		return nil, nil, apperror.ErrAlreadyExists
+	}
+	if !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil, err
+	}
+
+	switch chatroom.JoinPolicy {
+	case entities.JoinPolicyOpen:
+		banned, err := s.restrictionRepository.ExistsActive(chatroom.ID, userId, entities.RestrictionBan)
+		if err != nil {
+			return nil, nil, err
+		}
+		if banned {
+			return nil, nil, apperror.ErrForbidden
+		}
+		if err := s.roommemberRepository.Save(chatroom.ID, []uuid.UUID{userId}); err != nil {
+			return nil, nil, err
+		}
+		member, err := s.roommemberRepository.FindAllByRoomIDAndUserID(chatroom.ID, userId)
+		if err != nil {
+			return nil, nil, err
+		}
+		return member, nil, nil
+	case entities.JoinPolicyApproval:
+		request, err := s.joinRequestUseCase.RequestToJoin(chatroom.ID, userId, "")
+		if err != nil {
+			return nil, nil, err
+		}
+		return nil, request, nil
+	default:
+		return nil, nil, apperror.ErrOperationDenied
+	}
+}
+
+// publishUpdate reloads the room and tells its subscribers which fields changed
+func (s *ChatroomService) publishUpdate(id int, fields []string) (*entities.Chatroom, error) {
+	chatroom, err := s.chatroomRepository.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+	s.messageUseCase.PublishRoomEvent(&entities.RoomEvent{Type: entities.RoomEventChatroomUpdated, RoomId: chatroom.ID, Chatroom: chatroom, Fields: fields})
+	return chatroom, nil
+}
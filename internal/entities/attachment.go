@@ -0,0 +1,40 @@
+package entities
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Attachment struct {
+	ID         uint      `json:"id" bson:"_id,omitempty"`
+	RoomId     uint      `json:"room_id" bson:"room_id"`
+	MessageId  uint      `json:"message_id" bson:"message_id"`
+	Uploader   uuid.UUID `json:"uploader" bson:"uploader"`
+	FileName   string    `json:"file_name" bson:"file_name"`
+	MimeType   string    `json:"mime_type" bson:"mime_type"`
+	Size       int64     `json:"size" bson:"size"`
+	Checksum   string    `json:"checksum" bson:"checksum"` // sha256, hex encoded
+	Width      int       `json:"width,omitempty" bson:"width,omitempty"`
+	Height     int       `json:"height,omitempty" bson:"height,omitempty"`
+	StorageKey string    `json:"-" bson:"storage_key"`
+	CreatedAt  time.Time `json:"created_at" bson:"created_at"`
+
+	// filled in by the thumbnail worker for images
+	Thumbnails  []Thumbnail `json:"thumbnails,omitempty" bson:"thumbnails,omitempty"`
+	Placeholder string      `json:"placeholder,omitempty" bson:"placeholder,omitempty"` // blurhash
+}
+
+type Thumbnail struct {
+	Size       int    `json:"size" bson:"size"` // bounding box edge in pixels
+	Width      int    `json:"width" bson:"width"`
+	Height     int    `json:"height" bson:"height"`
+	MimeType   string `json:"mime_type" bson:"mime_type"`
+	StorageKey string `json:"-" bson:"storage_key"`
+}
+
+// IsImage reports whether the attachment can be decoded as an image.
+func (a *Attachment) IsImage() bool {
+	return strings.HasPrefix(a.MimeType, "image/")
+}
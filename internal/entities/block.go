@@ -0,0 +1,17 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Block records that UserID no longer wants contact from BlockedID.
+// A block in either direction cuts off friend requests, invites,
+// direct messages and presence between the two users.
+type Block struct {
+	ID    	  	uint    	`json:"id" bson:"_id,omitempty"`
+	UserID		uuid.UUID	`json:"user_id" bson:"user_id"`
+	BlockedID	uuid.UUID	`json:"blocked_id" bson:"blocked_id"`
+	CreatedAt 	time.Time 	`json:"created_at" bson:"created_at"`
+}
@@ -0,0 +1,22 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Bookmark is a message a user saved for later
+type Bookmark struct {
+	ID			uint		`json:"id" bson:"_id,omitempty"`
+	UserId		uuid.UUID	`json:"user_id" bson:"user_id"`
+	MessageId	uint		`json:"message_id" bson:"message_id"`
+	RoomId		uint		`json:"room_id" bson:"room_id"`
+	Note		string		`json:"note,omitempty" bson:"note,omitempty"`
+	Labels		[]string	`json:"labels,omitempty" bson:"labels,omitempty"`
+	Deleted		bool		`json:"deleted,omitempty" bson:"deleted,omitempty"` // tombstone: the message is gone
+	CreatedAt	time.Time	`json:"created_at" bson:"created_at"`
+	UpdatedAt	time.Time	`json:"updated_at" bson:"updated_at"`
+
+	Message		*Message	`json:"message,omitempty" bson:"message,omitempty"` // filled when listing
+}
@@ -1,6 +1,8 @@
 package entities
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -11,6 +13,134 @@ type Chatroom struct {
     RoomName    string 	    `bson:"room_name" json:"room_name"`
     IsGroup  	bool      	`bson:"is_group" json:"is_group"`
     Owner       uuid.UUID   `bson:"owner" json:"owner"`
+    MessageTTL  int         `bson:"message_ttl,omitempty" json:"message_ttl"` // seconds until messages disappear, 0 keeps them
+    Description string      `bson:"description,omitempty" json:"description"`
+    Topic       string      `bson:"topic,omitempty" json:"topic"`
+    AvatarURL   string      `bson:"avatar_url,omitempty" json:"avatar_url"`
+    Visibility  RoomVisibility `bson:"visibility,omitempty" json:"visibility"`
+    JoinPolicy  JoinPolicy     `bson:"join_policy,omitempty" json:"join_policy"`
+    PostingPolicy PostingPolicy `bson:"posting_policy,omitempty" json:"posting_policy"`
+    DirectKey   string      `bson:"direct_key,omitempty" json:"direct_key,omitempty"` // set on 1:1 rooms, see DirectRoomKey
+    LastActivityAt *time.Time `bson:"last_activity_at,omitempty" json:"last_activity_at,omitempty"` // time of the newest message
+    LastMessageId  uint       `bson:"last_message_id,omitempty" json:"last_message_id,omitempty"`
     CreatedAt 	time.Time 	`bson:"created_at" json:"created_at"`
     UpdatedAt 	time.Time 	`bson:"updated_at" json:"updated_at"`
-}
\ No newline at end of file
+
+    MemberCount int64       `bson:"-" json:"member_count,omitempty"` // stored on the room, kept in step by room member writes
+    DisplayName string      `bson:"-" json:"display_name,omitempty"` // filled per viewer by room listings
+    DisplayAvatarURL string `bson:"-" json:"display_avatar_url,omitempty"`
+}
+
+type RoomType string
+
+const (
+	RoomTypeGroup  RoomType = "group"
+	RoomTypeDirect RoomType = "direct" // a 1:1 room between two users
+)
+
+func (c *Chatroom) Type() RoomType {
+	if c.IsGroup {
+		return RoomTypeGroup
+	}
+	return RoomTypeDirect
+}
+
+// DirectPeer returns the other participant of a 1:1 room as seen by viewer.
+// It reports false for group rooms and for 1:1 rooms stored before DirectKey existed.
+func (c *Chatroom) DirectPeer(viewer uuid.UUID) (uuid.UUID, bool) {
+	if c.IsGroup {
+		return uuid.Nil, false
+	}
+	a, b, ok := strings.Cut(c.DirectKey, ":")
+	if !ok {
+		return uuid.Nil, false
+	}
+	peer := a
+	if a == viewer.String() {
+		peer = b
+	}
+	id, err := uuid.Parse(peer)
+	if err != nil {
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// DirectRoomKey identifies the 1:1 room between two users regardless of order
+func DirectRoomKey(a, b uuid.UUID) string {
+	if a.String() > b.String() {
+		a, b = b, a
+	}
+	return a.String() + ":" + b.String()
+}
+
+// DirectRoomName is the name 1:1 rooms were given before they had a DirectKey;
+// it is only used to find those older rooms
+func DirectRoomName(a, b uuid.UUID) string {
+	return fmt.Sprintf("room_%s_%s", a, b)
+}
+
+// IsDiscoverable reports whether the room is listed in the public directory
+func (c *Chatroom) IsDiscoverable() bool {
+	return c.IsGroup && c.Visibility == RoomVisibilityPublic
+}
+
+type RoomVisibility string
+
+const (
+	RoomVisibilityPrivate RoomVisibility = "private"
+	RoomVisibilityPublic  RoomVisibility = "public"
+)
+
+func (v RoomVisibility) IsValid() bool {
+	return v == RoomVisibilityPrivate || v == RoomVisibilityPublic
+}
+
+// JoinPolicy decides how non-members get into a room
+type JoinPolicy string
+
+const (
+	JoinPolicyInvite   JoinPolicy = "invite"   // only through an invitation
+	JoinPolicyApproval JoinPolicy = "approval" // a moderator approves a join request
+	JoinPolicyOpen     JoinPolicy = "open"     // anyone who can see the room may join
+)
+
+func (p JoinPolicy) IsValid() bool {
+	return p == JoinPolicyInvite || p == JoinPolicyApproval || p == JoinPolicyOpen
+}
+
+// PostingPolicy decides who may send messages in a room
+type PostingPolicy string
+
+const (
+	PostingPolicyEveryone   PostingPolicy = "everyone"
+	PostingPolicyModerators PostingPolicy = "moderators" // owner and admins only
+)
+
+func (p PostingPolicy) IsValid() bool {
+	return p == PostingPolicyEveryone || p == PostingPolicyModerators
+}
+
+// ApplyDefaults fills settings left empty, including on rooms stored before they existed
+func (c *Chatroom) ApplyDefaults() {
+	if c.Visibility == "" {
+		c.Visibility = RoomVisibilityPrivate
+	}
+	if c.JoinPolicy == "" {
+		c.JoinPolicy = JoinPolicyInvite
+	}
+	if c.PostingPolicy == "" {
+		c.PostingPolicy = PostingPolicyEveryone
+	}
+}
+
+// Chatroom setting paths accepted in an update mask
+const (
+	ChatroomFieldRoomName      = "room_name"
+	ChatroomFieldDescription   = "description"
+	ChatroomFieldTopic         = "topic"
+	ChatroomFieldAvatarURL     = "avatar_url"
+	ChatroomFieldVisibility    = "visibility"
+	ChatroomFieldJoinPolicy    = "join_policy"
+	ChatroomFieldPostingPolicy = "posting_policy"
+)
@@ -8,9 +8,37 @@ import (
 
 type Friend struct {
 	ID    	  uint    `json:"id" bson:"_id,omitempty"`
-    UserID    uuid.UUID `bson:"user_id" json:"user_id"`
-    FriendID  uuid.UUID `bson:"friend_id" json:"friend_id"`
-    Status    string    `bson:"status" json:"status"`
-    CreatedAt time.Time `bson:"created_at" json:"created_at"`
-    UpdatedAt time.Time `bson:"updated_at" json:"updated_at"`
+    UserID    uuid.UUID    `bson:"user_id" json:"user_id"`     // who sent the request
+    FriendID  uuid.UUID    `bson:"friend_id" json:"friend_id"` // who received it
+    Status    FriendStatus `bson:"status" json:"status"`
+    CreatedAt time.Time    `bson:"created_at" json:"created_at"`
+    UpdatedAt time.Time    `bson:"updated_at" json:"updated_at"`
+    Online    bool         `bson:"-" json:"online"` // hidden whenever either side has blocked the other
+}
+
+type FriendStatus string
+
+const (
+	FriendStatusPending   FriendStatus = "pending"
+	FriendStatusAccepted  FriendStatus = "friend"
+	FriendStatusRejected  FriendStatus = "rejected"  // declined by the recipient
+	FriendStatusCancelled FriendStatus = "cancelled" // withdrawn by the sender
+
+	// Statuses below are never stored; IsMyfriend reports them from the viewer's side
+	FriendStatusAsked   FriendStatus = "asked" // a pending request addressed to the viewer
+	FriendStatusNone    FriendStatus = "not friend"
+	FriendStatusBlocked FriendStatus = "blocked"
+)
+
+// IsActive reports whether the record still ties the pair together.
+// At most one active record exists per unordered pair of users.
+func (s FriendStatus) IsActive() bool {
+	return s == FriendStatusPending || s == FriendStatusAccepted
+}
+
+// FriendSuggestion is a user who is not yet a friend, ranked by what they share with the viewer
+type FriendSuggestion struct {
+	UserID        uuid.UUID `json:"user_id" bson:"_id"`
+	MutualFriends int       `json:"mutual_friends" bson:"mutual_friends"`
+	SharedRooms   int       `json:"shared_rooms" bson:"shared_rooms"`
 }
@@ -0,0 +1,17 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FriendLabel is a user's private grouping of friends, such as "Family" or "Team A"
+type FriendLabel struct {
+	ID    	  	uint    	`json:"id" bson:"_id,omitempty"`
+	UserID		uuid.UUID	`json:"user_id" bson:"user_id"`
+	Name		string		`json:"name" bson:"name"`
+	FriendIDs	[]uuid.UUID	`json:"friend_ids" bson:"friend_ids"`
+	CreatedAt 	time.Time 	`json:"created_at" bson:"created_at"`
+	UpdatedAt 	time.Time 	`json:"updated_at" bson:"updated_at"`
+}
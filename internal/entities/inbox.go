@@ -0,0 +1,9 @@
+package entities
+
+// InboxEntry is one row of a user's room list: the membership with its room,
+// the room's newest message and how many messages the user has not read yet
+type InboxEntry struct {
+	RoomMember	`bson:",inline"`
+	LastMessage	*Message	`json:"last_message,omitempty" bson:"last_message,omitempty"`
+	Unread		int64		`json:"unread" bson:"unread"`
+}
@@ -0,0 +1,42 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// InviteLink lets anyone holding Token join RoomId with Role
+type InviteLink struct {
+	ID    	  	uint    	`json:"id" bson:"_id,omitempty"`
+	RoomId	  	uint 		`json:"room_id" bson:"room_id"`
+	Token		string		`json:"token" bson:"token"`
+	CreatedBy	uuid.UUID	`json:"created_by" bson:"created_by"`
+	Role		RoomRole	`json:"role" bson:"role"`
+	MaxUses		int			`json:"max_uses" bson:"max_uses"` // 0 means unlimited
+	Uses		int			`json:"uses" bson:"uses"`
+	ExpiresAt	*time.Time	`json:"expires_at,omitempty" bson:"expires_at,omitempty"`
+	RevokedAt	*time.Time	`json:"revoked_at,omitempty" bson:"revoked_at,omitempty"`
+	CreatedAt 	time.Time 	`json:"created_at" bson:"created_at"`
+	UpdatedAt 	time.Time 	`json:"updated_at" bson:"updated_at"`
+}
+
+// IsActive reports whether the link can still be redeemed at now
+func (l *InviteLink) IsActive(now time.Time) bool {
+	if l.RevokedAt != nil {
+		return false
+	}
+	if l.ExpiresAt != nil && !now.Before(*l.ExpiresAt) {
+		return false
+	}
+	return l.MaxUses == 0 || l.Uses < l.MaxUses
+}
+
+// InviteLinkRedemption records who joined a room through which link
+type InviteLinkRedemption struct {
+	ID    	  	uint    	`json:"id" bson:"_id,omitempty"`
+	LinkId		uint		`json:"link_id" bson:"link_id"`
+	RoomId	  	uint 		`json:"room_id" bson:"room_id"`
+	UserId		uuid.UUID	`json:"user_id" bson:"user_id"`
+	CreatedAt 	time.Time 	`json:"created_at" bson:"created_at"`
+}
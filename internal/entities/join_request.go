@@ -0,0 +1,29 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// JoinRequest is a user asking to be let into an approval-gated room
+type JoinRequest struct {
+	ID    	  	uint    			`json:"id" bson:"_id,omitempty"`
+	RoomId	  	uint 				`json:"room_id" bson:"room_id"`
+	UserId		uuid.UUID			`json:"user_id" bson:"user_id"`
+	Message		string				`json:"message,omitempty" bson:"message,omitempty"` // optional note for the admins
+	Status		JoinRequestStatus	`json:"status" bson:"status"`
+	DecidedBy	*uuid.UUID			`json:"decided_by,omitempty" bson:"decided_by,omitempty"`
+	DecidedAt	*time.Time			`json:"decided_at,omitempty" bson:"decided_at,omitempty"`
+	CreatedAt 	time.Time 			`json:"created_at" bson:"created_at"`
+	UpdatedAt 	time.Time 			`json:"updated_at" bson:"updated_at"`
+}
+
+type JoinRequestStatus string
+
+const (
+	JoinRequestPending   JoinRequestStatus = "pending"
+	JoinRequestApproved  JoinRequestStatus = "approved"
+	JoinRequestRejected  JoinRequestStatus = "rejected"
+	JoinRequestCancelled JoinRequestStatus = "cancelled" // withdrawn by the requester
+)
@@ -0,0 +1,12 @@
+package entities
+
+import "time"
+
+type LinkPreview struct {
+	URL         string    `json:"url" bson:"_id"`
+	Title       string    `json:"title,omitempty" bson:"title,omitempty"`
+	Description string    `json:"description,omitempty" bson:"description,omitempty"`
+	ImageURL    string    `json:"image_url,omitempty" bson:"image_url,omitempty"`
+	SiteName    string    `json:"site_name,omitempty" bson:"site_name,omitempty"`
+	FetchedAt   time.Time `json:"fetched_at" bson:"fetched_at"`
+}
@@ -11,6 +11,42 @@ type Message struct {
 	RoomId		uint 		`json:"room_id" bson:"room_id"`
 	Message  	string		`json:"message" bson:"message"`
 	Sender		uuid.UUID	`json:"sender" bson:"sender"`
+	Attachments	[]Attachment	`json:"attachments,omitempty" bson:"attachments,omitempty"`
+	LinkPreviews	[]LinkPreview	`json:"link_previews,omitempty" bson:"link_previews,omitempty"`
+	Mentions	[]uuid.UUID	`json:"mentions,omitempty" bson:"mentions,omitempty"`
+	MentionRoom	bool		`json:"mention_room,omitempty" bson:"mention_room,omitempty"` // @room
+	MentionHere	bool		`json:"mention_here,omitempty" bson:"mention_here,omitempty"` // @here
+	HereMentions	[]uuid.UUID	`json:"-" bson:"here_mentions,omitempty"` // members online when @here was sent
+	Pin		*MessagePin	`json:"pin,omitempty" bson:"pin,omitempty"`
+	ExpiresAt	*time.Time	`json:"expires_at,omitempty" bson:"expires_at,omitempty"` // set in rooms with a message TTL
+	PollId		uint		`json:"poll_id,omitempty" bson:"poll_id,omitempty"` // set on poll messages
+	System		*SystemEvent	`json:"system,omitempty" bson:"system,omitempty"` // set on messages posted by the service itself
+	ScheduledId	uint		`json:"scheduled_id,omitempty" bson:"scheduled_id,omitempty"` // set on messages delivered by the scheduler
 	CreatedAt time.Time 	`json:"created_at" bson:"created_at"`
     UpdatedAt time.Time 	`json:"updated_at" bson:"updated_at"`
-}
\ No newline at end of file
+}
+
+// MessagePin records who pinned a message and when; nil means unpinned
+type MessagePin struct {
+	PinnedBy	uuid.UUID	`json:"pinned_by" bson:"pinned_by"`
+	PinnedAt	time.Time	`json:"pinned_at" bson:"pinned_at"`
+}
+
+type SystemEventType string
+
+const (
+	SystemEventMemberKicked   SystemEventType = "member_kicked"
+	SystemEventMemberBanned   SystemEventType = "member_banned"
+	SystemEventMemberUnbanned SystemEventType = "member_unbanned"
+	SystemEventMemberMuted    SystemEventType = "member_muted"
+	SystemEventMemberUnmuted  SystemEventType = "member_unmuted"
+)
+
+// SystemEvent describes what a system message announces, so clients can
+// render it in their own words instead of relying on the message text
+type SystemEvent struct {
+	Type		SystemEventType	`json:"type" bson:"type"`
+	Actor		uuid.UUID	`json:"actor" bson:"actor"`
+	Target		uuid.UUID	`json:"target,omitempty" bson:"target,omitempty"`
+	Until		*time.Time	`json:"until,omitempty" bson:"until,omitempty"`
+}
@@ -0,0 +1,51 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ReportStatus string
+
+const (
+	ReportOpen      ReportStatus = "open"
+	ReportDismissed ReportStatus = "dismissed" // reviewed, no action taken
+	ReportActioned  ReportStatus = "actioned"
+)
+
+// ReportAction is how a moderator resolves a report
+type ReportAction string
+
+const (
+	ReportActionDismiss       ReportAction = "dismiss"
+	ReportActionDeleteMessage ReportAction = "delete_message"
+	ReportActionMuteSender    ReportAction = "mute_sender"
+	ReportActionBanSender     ReportAction = "ban_sender"
+)
+
+func (a ReportAction) IsValid() bool {
+	switch a {
+	case ReportActionDismiss, ReportActionDeleteMessage, ReportActionMuteSender, ReportActionBanSender:
+		return true
+	}
+	return false
+}
+
+// MessageReport is a member's complaint about a message, reviewed by the room's moderators
+type MessageReport struct {
+	ID    	  	uint    	`json:"id" bson:"_id,omitempty"`
+	MessageId	uint		`json:"message_id" bson:"message_id"`
+	RoomId		uint 		`json:"room_id" bson:"room_id"`
+	ReporterId	uuid.UUID	`json:"reporter_id" bson:"reporter_id"`
+	SenderId	uuid.UUID	`json:"sender_id" bson:"sender_id"`
+	Reason		string		`json:"reason" bson:"reason"`
+	Status		ReportStatus	`json:"status" bson:"status"`
+	Action		ReportAction	`json:"action,omitempty" bson:"action,omitempty"`
+	ResolvedBy	*uuid.UUID	`json:"resolved_by,omitempty" bson:"resolved_by,omitempty"`
+	ResolvedAt	*time.Time	`json:"resolved_at,omitempty" bson:"resolved_at,omitempty"`
+	CreatedAt 	time.Time 	`json:"created_at" bson:"created_at"`
+    UpdatedAt 	time.Time 	`json:"updated_at" bson:"updated_at"`
+
+	Message		*Message	`json:"message,omitempty" bson:"message,omitempty"` // filled in the moderation queue
+}
@@ -0,0 +1,34 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type MessageSearchSort string
+
+const (
+	MessageSearchByRelevance MessageSearchSort = "relevance"
+	MessageSearchByRecent    MessageSearchSort = "recent"
+)
+
+// MessageSearchQuery describes a full-text search over the caller's rooms.
+// Zero values leave the matching filter off.
+type MessageSearchQuery struct {
+	Text			string
+	RoomId			uint
+	Sender			uuid.UUID
+	From			time.Time
+	To				time.Time
+	HasAttachment	*bool
+	Sort			MessageSearchSort
+	Page			int
+	PageSize		int
+}
+
+type MessageSearchHit struct {
+	Message	*Message	`json:"message"`
+	Score	float64		`json:"score"`
+	Snippet	string		`json:"snippet"` // matched terms wrapped in <mark></mark>
+}
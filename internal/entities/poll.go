@@ -0,0 +1,47 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Poll is posted into a room as a message carrying its PollId
+type Poll struct {
+	ID				uint			`json:"id" bson:"_id,omitempty"`
+	RoomId			uint			`json:"room_id" bson:"room_id"`
+	MessageId		uint			`json:"message_id" bson:"message_id"`
+	Creator			uuid.UUID		`json:"creator" bson:"creator"`
+	Question		string			`json:"question" bson:"question"`
+	Options			[]PollOption	`json:"options" bson:"options"`
+	MultipleChoice	bool			`json:"multiple_choice" bson:"multiple_choice"`
+	Anonymous		bool			`json:"anonymous" bson:"anonymous"`
+	ClosesAt		*time.Time		`json:"closes_at,omitempty" bson:"closes_at,omitempty"`
+	ClosedAt		*time.Time		`json:"closed_at,omitempty" bson:"closed_at,omitempty"`
+	CreatedAt		time.Time		`json:"created_at" bson:"created_at"`
+	UpdatedAt		time.Time		`json:"updated_at" bson:"updated_at"`
+
+	TotalVoters		int64			`json:"total_voters" bson:"-"` // filled with results
+}
+
+type PollOption struct {
+	ID			int				`json:"id" bson:"id"`
+	Text		string			`json:"text" bson:"text"`
+	VoteCount	int64			`json:"vote_count" bson:"-"`
+	Voters		[]uuid.UUID		`json:"voters,omitempty" bson:"-"` // left empty for anonymous polls
+}
+
+// IsOpen reports whether votes are still accepted at now
+func (p *Poll) IsOpen(now time.Time) bool {
+	if p.ClosedAt != nil {
+		return false
+	}
+	return p.ClosesAt == nil || now.Before(*p.ClosesAt)
+}
+
+type PollVote struct {
+	PollId		uint		`json:"poll_id" bson:"poll_id"`
+	OptionId	int			`json:"option_id" bson:"option_id"`
+	UserId		uuid.UUID	`json:"user_id" bson:"user_id"`
+	CreatedAt	time.Time	`json:"created_at" bson:"created_at"`
+}
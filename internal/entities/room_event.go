@@ -0,0 +1,30 @@
+package entities
+
+type RoomEventType string
+
+const (
+	RoomEventMessageCreated RoomEventType = "message_created"
+	RoomEventMessageUpdated RoomEventType = "message_updated"
+	RoomEventMessagePinned   RoomEventType = "message_pinned"
+	RoomEventMessageUnpinned RoomEventType = "message_unpinned"
+	RoomEventMessageExpired  RoomEventType = "message_expired" // Message only carries ID and RoomId
+	RoomEventMessageDeleted  RoomEventType = "message_deleted" // removed by a moderator; Message only carries ID and RoomId
+	RoomEventPollUpdated     RoomEventType = "poll_updated"    // carries Poll with fresh counts
+	RoomEventChatroomUpdated RoomEventType = "chatroom_updated" // carries Chatroom and the changed Fields
+
+	// sent to a single user's stream rather than the whole room
+	RoomEventMentioned          RoomEventType = "mentioned"
+	RoomEventJoinRequested      RoomEventType = "join_requested"       // to room moderators, carries JoinRequest
+	RoomEventJoinRequestDecided RoomEventType = "join_request_decided" // to the requester, carries JoinRequest
+)
+
+// RoomEvent is pushed to every subscriber of a room.
+type RoomEvent struct {
+	Type    RoomEventType `json:"type"`
+	RoomId  uint          `json:"room_id"`
+	Message *Message      `json:"message,omitempty"`
+	Poll    *Poll         `json:"poll,omitempty"`
+	Chatroom *Chatroom    `json:"chatroom,omitempty"`
+	Fields  []string      `json:"fields,omitempty"`
+	JoinRequest *JoinRequest `json:"join_request,omitempty"`
+}
@@ -11,8 +11,39 @@ type RoomInvite struct {
 	RoomId	  	uint 		`json:"room_id" bson:"room_id"`
 	Sender		uuid.UUID	`json:"sender" bson:"sender"`
 	InviteTo	uuid.UUID	`json:"invite_to" bson:"invite_to"`
-	IsAccepted	bool		`json:"is_accepted" bson:"is_accepted"`
+	Status		InviteStatus	`json:"status" bson:"status"`
+	IsAccepted	bool		`json:"is_accepted" bson:"is_accepted"` // mirrors Status for older clients
 	IsDenied	bool		`json:"is_denied" bson:"is_denied"`
+	ExpiresAt	*time.Time	`json:"expires_at,omitempty" bson:"expires_at,omitempty"`
+	RespondedAt	*time.Time	`json:"responded_at,omitempty" bson:"responded_at,omitempty"` // when the invite left pending
 	CreatedAt 	time.Time 	`json:"created_at" bson:"created_at"`
     UpdatedAt 	time.Time 	`json:"updated_at" bson:"updated_at"`
-}
\ No newline at end of file
+}
+
+type InviteStatus string
+
+const (
+	InviteStatusPending  InviteStatus = "pending"
+	InviteStatusAccepted InviteStatus = "accepted"
+	InviteStatusDenied   InviteStatus = "denied"
+	InviteStatusExpired  InviteStatus = "expired"
+	InviteStatusRevoked  InviteStatus = "revoked"
+)
+
+// CanTransitionTo reports whether an invite may move from s to next.
+// Only pending invites change state; every other state is final.
+func (s InviteStatus) CanTransitionTo(next InviteStatus) bool {
+	if s != InviteStatusPending {
+		return false
+	}
+	switch next {
+	case InviteStatusAccepted, InviteStatusDenied, InviteStatusExpired, InviteStatusRevoked:
+		return true
+	}
+	return false
+}
+
+// IsExpired reports whether a pending invite has run past ExpiresAt at now
+func (i *RoomInvite) IsExpired(now time.Time) bool {
+	return i.Status == InviteStatusPending && i.ExpiresAt != nil && !now.Before(*i.ExpiresAt)
+}
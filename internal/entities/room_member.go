@@ -10,8 +10,56 @@ type RoomMember struct {
 	ID    	  	uint    	`json:"id" bson:"_id,omitempty"`
 	RoomId		uint 		`json:"room_id" bson:"room_id"`
 	UserId 		uuid.UUID	`json:"user_id" bson:"user_id"`
+	Role		RoomRole	`json:"role" bson:"role,omitempty"`
+	Muted		bool		`json:"muted" bson:"muted,omitempty"`
+	MutedUntil	*time.Time	`json:"muted_until,omitempty" bson:"muted_until,omitempty"` // nil keeps the room muted until unmuted
+	Archived	bool		`json:"archived" bson:"archived,omitempty"`
+	PinnedAt	*time.Time	`json:"pinned_at,omitempty" bson:"pinned_at,omitempty"`
 	CreatedAt 	time.Time 	`json:"created_at" bson:"created_at"`
     UpdatedAt 	time.Time 	`json:"updated_at" bson:"updated_at"`
 
 	Chatroom 	Chatroom `json:"room" bson:"room,omitempty"`
-}
\ No newline at end of file
+	LastActivity time.Time `json:"last_activity" bson:"last_activity,omitempty"` // filled by room lists
+}
+
+// IsMuted reports whether notifications from the room are silenced at now
+func (m *RoomMember) IsMuted(now time.Time) bool {
+	return m.Muted && (m.MutedUntil == nil || now.Before(*m.MutedUntil))
+}
+
+type RoomRole string
+
+const (
+	RoomRoleOwner  RoomRole = "owner"
+	RoomRoleAdmin  RoomRole = "admin"
+	RoomRoleMember RoomRole = "member"
+)
+
+func (r RoomRole) IsValid() bool {
+	return r == RoomRoleOwner || r == RoomRoleAdmin || r == RoomRoleMember
+}
+
+// CanModerate reports whether the role may manage other members' content
+func (r RoomRole) CanModerate() bool {
+	return r == RoomRoleOwner || r == RoomRoleAdmin
+}
+
+var roleRank = map[RoomRole]int{RoomRoleMember: 0, RoomRoleAdmin: 1, RoomRoleOwner: 2}
+
+// Outranks reports whether r may moderate a member holding other:
+// owners act on admins and members, admins on members only
+func (r RoomRole) Outranks(other RoomRole) bool {
+	return roleRank[r] > roleRank[other]
+}
+
+// EffectiveRole resolves the member's role in room. The chatroom owner is
+// always an owner; members stored before roles existed count as members.
+func (m *RoomMember) EffectiveRole(room *Chatroom) RoomRole {
+	if room != nil && room.Owner == m.UserId {
+		return RoomRoleOwner
+	}
+	if m.Role == "" {
+		return RoomRoleMember
+	}
+	return m.Role
+}
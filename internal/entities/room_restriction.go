@@ -0,0 +1,35 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type RestrictionKind string
+
+const (
+	RestrictionBan  RestrictionKind = "ban"  // removed from the room and kept from rejoining or being invited
+	RestrictionMute RestrictionKind = "mute" // stays a member but cannot post
+)
+
+func (k RestrictionKind) IsValid() bool {
+	return k == RestrictionBan || k == RestrictionMute
+}
+
+// RoomRestriction is a moderator's ban or mute of a user in a room.
+// A nil ExpiresAt keeps it in place until it is lifted.
+type RoomRestriction struct {
+	ID    	  	uint    	`json:"id" bson:"_id,omitempty"`
+	RoomId		uint 		`json:"room_id" bson:"room_id"`
+	UserId 		uuid.UUID	`json:"user_id" bson:"user_id"`
+	Kind		RestrictionKind	`json:"kind" bson:"kind"`
+	Reason		string		`json:"reason,omitempty" bson:"reason,omitempty"`
+	CreatedBy	uuid.UUID	`json:"created_by" bson:"created_by"`
+	ExpiresAt	*time.Time	`json:"expires_at,omitempty" bson:"expires_at,omitempty"`
+	CreatedAt 	time.Time 	`json:"created_at" bson:"created_at"`
+}
+
+func (r *RoomRestriction) IsActive(now time.Time) bool {
+	return r.ExpiresAt == nil || now.Before(*r.ExpiresAt)
+}
@@ -0,0 +1,33 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ScheduledMessageStatus string
+
+const (
+	ScheduledMessagePending   ScheduledMessageStatus = "pending"
+	ScheduledMessageSending   ScheduledMessageStatus = "sending" // claimed by the scheduler
+	ScheduledMessageSent      ScheduledMessageStatus = "sent"
+	ScheduledMessageCancelled ScheduledMessageStatus = "cancelled"
+	ScheduledMessageFailed    ScheduledMessageStatus = "failed"
+)
+
+// ScheduledMessage is a message composed now and delivered at SendAt
+type ScheduledMessage struct {
+	ID				uint					`json:"id" bson:"_id,omitempty"`
+	RoomId			uint					`json:"room_id" bson:"room_id"`
+	Sender			uuid.UUID				`json:"sender" bson:"sender"`
+	Message			string					`json:"message" bson:"message"`
+	AttachmentIds	[]uint					`json:"attachment_ids,omitempty" bson:"attachment_ids,omitempty"`
+	SendAt			time.Time				`json:"send_at" bson:"send_at"`
+	Status			ScheduledMessageStatus	`json:"status" bson:"status"`
+	MessageId		uint					`json:"message_id,omitempty" bson:"message_id,omitempty"` // set once sent
+	Error			string					`json:"error,omitempty" bson:"error,omitempty"`
+	ClaimedAt		*time.Time				`json:"claimed_at,omitempty" bson:"claimed_at,omitempty"` // when the scheduler took it
+	CreatedAt		time.Time				`json:"created_at" bson:"created_at"`
+	UpdatedAt		time.Time				`json:"updated_at" bson:"updated_at"`
+}
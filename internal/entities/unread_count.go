@@ -0,0 +1,8 @@
+package entities
+
+// UnreadCount is the per-room unread summary for a user
+type UnreadCount struct {
+	RoomId		uint	`json:"room_id"`
+	Unread		int64	`json:"unread"`
+	Mentions	int64	`json:"mentions"`
+}
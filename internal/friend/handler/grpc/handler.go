@@ -2,7 +2,6 @@ package grpc
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/MingPV/ChatService/internal/entities"
 	"github.com/MingPV/ChatService/internal/friend/usecase"
@@ -16,11 +15,12 @@ import (
 
 type GrpcFriendHandler struct {
 	friendUseCase usecase.FriendUseCase
+	labelUseCase usecase.FriendLabelUseCase
 	friendpb.UnimplementedFriendServiceServer
 }
 
-func NewGrpcFriendHandler(uc usecase.FriendUseCase) *GrpcFriendHandler {
-	return &GrpcFriendHandler{friendUseCase: uc}
+func NewGrpcFriendHandler(uc usecase.FriendUseCase, labelUc usecase.FriendLabelUseCase) *GrpcFriendHandler {
+	return &GrpcFriendHandler{friendUseCase: uc, labelUseCase: labelUc}
 }
 
 func (h *GrpcFriendHandler) CreateFriend(ctx context.Context, req *friendpb.CreateFriendRequest) (*friendpb.CreateFriendResponse, error) {
@@ -36,7 +36,6 @@ func (h *GrpcFriendHandler) CreateFriend(ctx context.Context, req *friendpb.Crea
 	friend := &entities.Friend{
 		UserID: userUUID,
 		FriendID: friendUUID,
-		Status: req.Status,
 	}
 
 	if err := h.friendUseCase.CreateFriend(friend); err != nil {
@@ -129,33 +128,277 @@ func (h *GrpcFriendHandler) IsMyFriend(ctx context.Context, req *friendpb.IsMyFr
 		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
 	}
 	friendUUID, err := uuid.Parse(req.FriendId)
-	fmt.Println(userUUID, friendUUID)
 	if err != nil {
 		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
 	}
 	
 	friend, _ := h.friendUseCase.IsMyfriend(userUUID, friendUUID)
-	fmt.Println(friend)
 	return &friendpb.IsMyFriendResponse{Friend: toProtoFriend(friend)}, nil
 }
 
 func (h *GrpcFriendHandler) AcceptFriend(ctx context.Context, req *friendpb.AcceptFriendRequest) (*friendpb.AcceptFriendResponse, error){
-	friend, err := h.friendUseCase.AcceptFriend(int(req.Id))
+	userUUID, err := uuid.Parse(req.UserId)
 	if err != nil {
-		return nil, err
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+	friend, err := h.friendUseCase.AcceptFriend(int(req.Id), userUUID)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
 	}
 	return &friendpb.AcceptFriendResponse{Friend: toProtoFriend(friend)}, nil
 }
 
+func (h *GrpcFriendHandler) RejectFriend(ctx context.Context, req *friendpb.RejectFriendRequest) (*friendpb.RejectFriendResponse, error) {
+	userUUID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+	friend, err := h.friendUseCase.RejectFriend(int(req.Id), userUUID)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+	return &friendpb.RejectFriendResponse{Friend: toProtoFriend(friend)}, nil
+}
+
+func (h *GrpcFriendHandler) CancelFriend(ctx context.Context, req *friendpb.CancelFriendRequest) (*friendpb.CancelFriendResponse, error) {
+	userUUID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+	friend, err := h.friendUseCase.CancelFriend(int(req.Id), userUUID)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+	return &friendpb.CancelFriendResponse{Friend: toProtoFriend(friend)}, nil
+}
+func (h *GrpcFriendHandler) FindDirectRoom(ctx context.Context, req *friendpb.FindDirectRoomRequest) (*friendpb.FindDirectRoomResponse, error) {
+	userUUID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+	friendUUID, err := uuid.Parse(req.FriendId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+
+	room, err := h.friendUseCase.FindDirectRoom(userUUID, friendUUID)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+	return &friendpb.FindDirectRoomResponse{
+		RoomId:    int32(room.ID),
+		RoomName:  room.RoomName,
+		CreatedAt: timestamppb.New(room.CreatedAt),
+		DisplayName:      room.DisplayName,
+		DisplayAvatarUrl: room.DisplayAvatarURL,
+	}, nil
+}
+
+func (h *GrpcFriendHandler) FindFriendSuggestions(ctx context.Context, req *friendpb.FindFriendSuggestionsRequest) (*friendpb.FindFriendSuggestionsResponse, error) {
+	userUUID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+
+	suggestions, total, err := h.friendUseCase.FindFriendSuggestions(userUUID, int(req.Page), int(req.PageSize))
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+
+	protoSuggestions := make([]*friendpb.FriendSuggestion, 0, len(suggestions))
+	for _, sg := range suggestions {
+		protoSuggestions = append(protoSuggestions, &friendpb.FriendSuggestion{
+			UserId:        sg.UserID.String(),
+			MutualFriends: int32(sg.MutualFriends),
+			SharedRooms:   int32(sg.SharedRooms),
+		})
+	}
+	return &friendpb.FindFriendSuggestionsResponse{Suggestions: protoSuggestions, Total: total}, nil
+}
+
+func (h *GrpcFriendHandler) BlockUser(ctx context.Context, req *friendpb.BlockUserRequest) (*friendpb.BlockUserResponse, error) {
+	userUUID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+	blockedUUID, err := uuid.Parse(req.BlockedId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+
+	block, err := h.friendUseCase.BlockUser(userUUID, blockedUUID)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+	return &friendpb.BlockUserResponse{Block: toProtoBlock(block)}, nil
+}
+
+func (h *GrpcFriendHandler) UnblockUser(ctx context.Context, req *friendpb.UnblockUserRequest) (*friendpb.UnblockUserResponse, error) {
+	userUUID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+	blockedUUID, err := uuid.Parse(req.BlockedId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+
+	if err := h.friendUseCase.UnblockUser(userUUID, blockedUUID); err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+	return &friendpb.UnblockUserResponse{Message: "user unblocked"}, nil
+}
+
+func (h *GrpcFriendHandler) FindBlockedUsers(ctx context.Context, req *friendpb.FindBlockedUsersRequest) (*friendpb.FindBlockedUsersResponse, error) {
+	userUUID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+
+	blocks, err := h.friendUseCase.FindBlockedUsers(userUUID)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+
+	protoBlocks := make([]*friendpb.Block, 0, len(blocks))
+	for _, b := range blocks {
+		protoBlocks = append(protoBlocks, toProtoBlock(b))
+	}
+	return &friendpb.FindBlockedUsersResponse{Blocks: protoBlocks}, nil
+}
+
+func (h *GrpcFriendHandler) CreateFriendLabel(ctx context.Context, req *friendpb.CreateFriendLabelRequest) (*friendpb.FriendLabelResponse, error) {
+	userUUID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+
+	label := &entities.FriendLabel{UserID: userUUID, Name: req.Name}
+	if err := h.labelUseCase.CreateLabel(label); err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+	return &friendpb.FriendLabelResponse{Label: toProtoFriendLabel(label)}, nil
+}
+
+func (h *GrpcFriendHandler) FindFriendLabels(ctx context.Context, req *friendpb.FindFriendLabelsRequest) (*friendpb.FindFriendLabelsResponse, error) {
+	userUUID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+
+	labels, err := h.labelUseCase.FindLabels(userUUID)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+
+	protoLabels := make([]*friendpb.FriendLabel, 0, len(labels))
+	for _, l := range labels {
+		protoLabels = append(protoLabels, toProtoFriendLabel(l))
+	}
+	return &friendpb.FindFriendLabelsResponse{Labels: protoLabels}, nil
+}
+
+func (h *GrpcFriendHandler) DeleteFriendLabel(ctx context.Context, req *friendpb.DeleteFriendLabelRequest) (*friendpb.DeleteFriendLabelResponse, error) {
+	userUUID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+
+	if err := h.labelUseCase.DeleteLabel(int(req.Id), userUUID); err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+	return &friendpb.DeleteFriendLabelResponse{Message: "label deleted"}, nil
+}
+
+func (h *GrpcFriendHandler) AssignFriendLabel(ctx context.Context, req *friendpb.FriendLabelMemberRequest) (*friendpb.FriendLabelResponse, error) {
+	return h.changeLabelMember(req, h.labelUseCase.AssignFriend)
+}
+
+func (h *GrpcFriendHandler) UnassignFriendLabel(ctx context.Context, req *friendpb.FriendLabelMemberRequest) (*friendpb.FriendLabelResponse, error) {
+	return h.changeLabelMember(req, h.labelUseCase.UnassignFriend)
+}
+
+func (h *GrpcFriendHandler) changeLabelMember(req *friendpb.FriendLabelMemberRequest, change func(int, uuid.UUID, uuid.UUID) (*entities.FriendLabel, error)) (*friendpb.FriendLabelResponse, error) {
+	userUUID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+	friendUUID, err := uuid.Parse(req.FriendId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+
+	label, err := change(int(req.Id), userUUID, friendUUID)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+	return &friendpb.FriendLabelResponse{Label: toProtoFriendLabel(label)}, nil
+}
+
+func (h *GrpcFriendHandler) FindFriendsByLabel(ctx context.Context, req *friendpb.FindFriendsByLabelRequest) (*friendpb.FindFriendsByLabelResponse, error) {
+	userUUID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+
+	friends, err := h.labelUseCase.FindFriendsByLabel(int(req.Id), userUUID)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+
+	protoFriends := make([]*friendpb.Friend, 0, len(friends))
+	for _, f := range friends {
+		protoFriends = append(protoFriends, toProtoFriend(f))
+	}
+	return &friendpb.FindFriendsByLabelResponse{Friends: protoFriends}, nil
+}
+
+func (h *GrpcFriendHandler) CreateGroupFromLabel(ctx context.Context, req *friendpb.CreateGroupFromLabelRequest) (*friendpb.CreateGroupFromLabelResponse, error) {
+	userUUID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+
+	room, err := h.labelUseCase.CreateGroupFromLabel(int(req.Id), userUUID, req.RoomName)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+	return &friendpb.CreateGroupFromLabelResponse{RoomId: int32(room.ID), RoomName: room.RoomName, MemberCount: int32(room.MemberCount)}, nil
+}
+
+func toProtoFriendLabel(l *entities.FriendLabel) *friendpb.FriendLabel {
+	friendIds := make([]string, 0, len(l.FriendIDs))
+	for _, id := range l.FriendIDs {
+		friendIds = append(friendIds, id.String())
+	}
+	return &friendpb.FriendLabel{
+		Id:        int32(l.ID),
+		UserId:    l.UserID.String(),
+		Name:      l.Name,
+		FriendIds: friendIds,
+		CreatedAt: timestamppb.New(l.CreatedAt),
+		UpdatedAt: timestamppb.New(l.UpdatedAt),
+	}
+}
 
 func toProtoFriend(f *entities.Friend) *friendpb.Friend {
 	return &friendpb.Friend{
 		Id:    int32(f.ID),
 		UserId: f.UserID.String(),
 		FriendId: f.FriendID.String(),
-		Status: f.Status,
+		Status: string(f.Status),
 		CreatedAt: timestamppb.New(f.CreatedAt),
 		UpdatedAt: timestamppb.New(f.CreatedAt),
+		Online: f.Online,
+	}
+}
+
+func toProtoBlock(b *entities.Block) *friendpb.Block {
+	return &friendpb.Block{
+		Id:        int32(b.ID),
+		UserId:    b.UserID.String(),
+		BlockedId: b.BlockedID.String(),
+		CreatedAt: timestamppb.New(b.CreatedAt),
 	}
 }
 
@@ -168,6 +411,7 @@ func normalizeFriend(f *entities.Friend, me uuid.UUID) *entities.Friend {
 		UserID:   me,
 		FriendID: f.UserID,
 		Status:   f.Status,
+		Online:   f.Online,
 	}
 }
 
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"github.com/MingPV/ChatService/internal/entities"
+	"github.com/google/uuid"
+)
+
+type BlockRepository interface {
+	Save(block *entities.Block) error
+	Delete(userId uuid.UUID, blockedId uuid.UUID) error
+	FindAllByUserID(userId uuid.UUID) ([]*entities.Block, error)
+	// Exists reports whether userId has blocked blockedId.
+	Exists(userId uuid.UUID, blockedId uuid.UUID) (bool, error)
+	// ExistsBetween reports whether either user has blocked the other.
+	ExistsBetween(a uuid.UUID, b uuid.UUID) (bool, error)
+	// FindAllRelatedIDs lists users that userId has blocked or been blocked by.
+	FindAllRelatedIDs(userId uuid.UUID) ([]uuid.UUID, error)
+	EnsureIndexes() error
+}
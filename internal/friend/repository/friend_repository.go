@@ -14,5 +14,13 @@ type FriendRepository interface {
 	IsMyfriend(userId uuid.UUID, friendId uuid.UUID) (*entities.Friend, error)
 	FindByID(id int) (*entities.Friend, error)
 	Delete(id uint) error 
-	Update(id int) (*entities.Friend, error)
+	// DeleteBetween removes every friend record, pending or accepted, between the two users.
+	DeleteBetween(userId uuid.UUID, friendId uuid.UUID) error
+	FindActiveBetween(userId uuid.UUID, friendId uuid.UUID) (*entities.Friend, error)
+	// UpdateStatus moves a record from one status to another, failing with ErrNoDocuments if it is no longer in from.
+	UpdateStatus(id int, from entities.FriendStatus, to entities.FriendStatus) (*entities.Friend, error)
+	// FindSuggestions ranks users by how many of friendIds they are friends with and how many of roomIds
+	// they belong to, skipping exclude, together with the total number of candidates.
+	FindSuggestions(friendIds []uuid.UUID, roomIds []uint, exclude []uuid.UUID, offset, limit int) ([]*entities.FriendSuggestion, int64, error)
+	EnsureIndexes() error
 }
\ No newline at end of file
@@ -0,0 +1,201 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/MingPV/ChatService/internal/entities"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type MongoBlockRepository struct {
+	db   *mongo.Database
+	coll *mongo.Collection
+}
+
+func NewMongoBlockRepository(db *mongo.Database) BlockRepository {
+	return &MongoBlockRepository{
+		db:   db,
+		coll: db.Collection("blocks"),
+	}
+}
+
+type blockDoc struct {
+	ID        int       `bson:"_id,omitempty"`
+	UserID    uuid.UUID `bson:"user_id"`
+	BlockedID uuid.UUID `bson:"blocked_id"`
+	CreatedAt time.Time `bson:"created_at"`
+}
+
+// EnsureIndexes allows a single block per direction
+func (r *MongoBlockRepository) EnsureIndexes() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := r.coll.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "blocked_id", Value: 1}},
+			Options: options.Index().SetName("user_blocked").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "blocked_id", Value: 1}},
+			Options: options.Index().SetName("blocked"),
+		},
+	})
+	return err
+}
+
+func (r *MongoBlockRepository) Save(block *entities.Block) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	nextID, err := r.getNextSequence(ctx, "blocks")
+	if err != nil {
+		return err
+	}
+
+	_, err = r.coll.InsertOne(ctx, blockDoc{
+		ID:        nextID,
+		UserID:    block.UserID,
+		BlockedID: block.BlockedID,
+		CreatedAt: block.CreatedAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	block.ID = uint(nextID)
+	return nil
+}
+
+func (r *MongoBlockRepository) Delete(userId uuid.UUID, blockedId uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	res, err := r.coll.DeleteOne(ctx, bson.M{"user_id": userId, "blocked_id": blockedId})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+func (r *MongoBlockRepository) FindAllByUserID(userId uuid.UUID) ([]*entities.Block, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	cur, err := r.coll.Find(ctx,
+		bson.M{"user_id": userId},
+		options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var results []*entities.Block
+	for cur.Next(ctx) {
+		var d blockDoc
+		if err := cur.Decode(&d); err != nil {
+			return nil, err
+		}
+		results = append(results, &entities.Block{
+			ID:        uint(d.ID),
+			UserID:    d.UserID,
+			BlockedID: d.BlockedID,
+			CreatedAt: d.CreatedAt,
+		})
+	}
+	return results, cur.Err()
+}
+
+func (r *MongoBlockRepository) Exists(userId uuid.UUID, blockedId uuid.UUID) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	n, err := r.coll.CountDocuments(ctx, bson.M{"user_id": userId, "blocked_id": blockedId}, options.Count().SetLimit(1))
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+func (r *MongoBlockRepository) ExistsBetween(a uuid.UUID, b uuid.UUID) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	filter := bson.M{"$or": []bson.M{
+		{"user_id": a, "blocked_id": b},
+		{"user_id": b, "blocked_id": a},
+	}}
+	n, err := r.coll.CountDocuments(ctx, filter, options.Count().SetLimit(1))
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+func (r *MongoBlockRepository) FindAllRelatedIDs(userId uuid.UUID) ([]uuid.UUID, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	filter := bson.M{"$or": []bson.M{
+		{"user_id": userId},
+		{"blocked_id": userId},
+	}}
+	cur, err := r.coll.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var ids []uuid.UUID
+	for cur.Next(ctx) {
+		var d blockDoc
+		if err := cur.Decode(&d); err != nil {
+			return nil, err
+		}
+		if d.UserID == userId {
+			ids = append(ids, d.BlockedID)
+		} else {
+			ids = append(ids, d.UserID)
+		}
+	}
+	return ids, cur.Err()
+}
+
+func (r *MongoBlockRepository) getNextSequence(ctx context.Context, name string) (int, error) {
+	counters := r.db.Collection("counters")
+	opts := options.FindOneAndUpdate().
+		SetUpsert(true).
+		SetReturnDocument(options.After)
+
+	var out counterDoc
+	err := counters.FindOneAndUpdate(
+		ctx,
+		bson.M{"_id": name},
+		bson.M{"$inc": bson.M{"seq": 1}},
+		opts,
+	).Decode(&out)
+
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		_, ierr := counters.InsertOne(ctx, counterDoc{ID: name, Seq: 1})
+		if ierr != nil {
+			return 0, ierr
+		}
+		return 1, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if out.Seq == 0 {
+		return 1, nil
+	}
+	return out.Seq, nil
+}
@@ -1,7 +1,7 @@
 package repository
 
 import (
-	"fmt"
+	"strings"
 
 	"github.com/google/uuid"
 
@@ -31,11 +31,58 @@ type friendDoc struct {
 	ID    	  int    	`bson:"_id,omitempty"`
     UserID    uuid.UUID `bson:"user_id"`
     FriendID  uuid.UUID `bson:"friend_id"`
-    Status    string    `bson:"status"`
+    Status    entities.FriendStatus `bson:"status"`
+    PairKey   string    `bson:"pair_key,omitempty"` // set only while the record is active
     CreatedAt time.Time `bson:"created_at"`
     UpdatedAt time.Time `bson:"updated_at"`
 }
 
+// pairKey identifies an unordered pair of users
+func pairKey(a uuid.UUID, b uuid.UUID) string {
+	if strings.Compare(a.String(), b.String()) > 0 {
+		a, b = b, a
+	}
+	return a.String() + ":" + b.String()
+}
+
+// activeBetween matches pending or accepted records between the pair in either direction,
+// including records written before pair_key existed
+func activeBetween(a uuid.UUID, b uuid.UUID) bson.M {
+	return bson.M{
+		"status": bson.M{"$in": []entities.FriendStatus{entities.FriendStatusPending, entities.FriendStatusAccepted}},
+		"$or": []bson.M{
+			{"user_id": a, "friend_id": b},
+			{"user_id": b, "friend_id": a},
+		},
+	}
+}
+
+func (r *MongoFriendRepository) toEntity(d friendDoc) *entities.Friend {
+	return &entities.Friend{
+		ID: uint(d.ID),
+		UserID: d.UserID,
+		FriendID: d.FriendID,
+		Status: d.Status,
+		CreatedAt: d.CreatedAt,
+		UpdatedAt: d.UpdatedAt,
+	}
+}
+
+// EnsureIndexes allows a single active record per unordered pair of users
+func (r *MongoFriendRepository) EnsureIndexes() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := r.coll.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "pair_key", Value: 1}},
+		Options: options.Index().
+			SetName("active_pair").
+			SetUnique(true).
+			SetPartialFilterExpression(bson.M{"pair_key": bson.M{"$exists": true}}),
+	})
+	return err
+}
+
 type counterDoc struct {
 	ID  string `bson:"_id"`
 	Seq int    `bson:"seq"`
@@ -80,14 +127,18 @@ func (r *MongoFriendRepository)	Save(friend *entities.Friend) error {
 		return err
 	}
 
-	_, err = r.coll.InsertOne(ctx, friendDoc{
+	doc := friendDoc{
 		ID: nextID,
 		UserID: friend.UserID,
 		FriendID: friend.FriendID,
 		Status: friend.Status,
 		CreatedAt: friend.CreatedAt,
 		UpdatedAt: friend.UpdatedAt,
-	})
+	}
+	if friend.Status.IsActive() {
+		doc.PairKey = pairKey(friend.UserID, friend.FriendID)
+	}
+	_, err = r.coll.InsertOne(ctx, doc)
 
 	if err != nil {
 		return err
@@ -174,7 +225,7 @@ func (r *MongoFriendRepository)	FindAllByIsFriend(userId uuid.UUID) ([]*entities
 	defer cancel()
 
 	filter := bson.M{
-		"status": "friend",
+		"status": entities.FriendStatusAccepted,
 		"$or": []bson.M{
 			{"user_id": userId},
 			{"friend_id": userId},
@@ -213,29 +264,15 @@ func (r *MongoFriendRepository)	FindAllByIsFriend(userId uuid.UUID) ([]*entities
 func (r *MongoFriendRepository) IsMyfriend(userId uuid.UUID, friendId uuid.UUID) (*entities.Friend, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
-	fmt.Println("gorm", userId, friendId)
-
-	filter := bson.M{
-		"$or": []bson.M{
-			{
-				"user_id":   userId,
-				"friend_id": friendId,
-			},
-			{
-				"user_id":   friendId,
-				"friend_id": userId,
-			},
-		},
-	}
 
+	// rejected and cancelled requests stay behind as history, so only look at the live one
 	var friend *entities.Friend
-	err := r.coll.FindOne(ctx, filter).Decode(&friend)
-	fmt.Println("result mongo", friend)
+	err := r.coll.FindOne(ctx, activeBetween(userId, friendId)).Decode(&friend)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
 			// No friend record found → return empty struct with status "not friend"
 			return &entities.Friend{
-				Status: "not friend",
+				Status: entities.FriendStatusNone,
 			}, nil
 		}
 		// Real DB error
@@ -244,18 +281,16 @@ func (r *MongoFriendRepository) IsMyfriend(userId uuid.UUID, friendId uuid.UUID)
 
 	// ✅ Ensure friend has correct status meaning
 	switch friend.Status {
-	case "friend":
-		return friend, nil
-	case "meet":
+	case entities.FriendStatusAccepted:
 		return friend, nil
-	case "pending":
+	case entities.FriendStatusPending:
 		// Differentiate direction of request
 		if friend.FriendID == userId {
 			return &entities.Friend{
 				ID: friend.ID,
 				UserID: friend.FriendID,
 				FriendID: friend.UserID,
-				Status: "asked",
+				Status: entities.FriendStatusAsked,
 				CreatedAt: friend.CreatedAt,
 				UpdatedAt: friend.UpdatedAt,
 			}, nil
@@ -264,17 +299,17 @@ func (r *MongoFriendRepository) IsMyfriend(userId uuid.UUID, friendId uuid.UUID)
 				ID: friend.ID,
 				UserID: friend.UserID,
 				FriendID: friend.FriendID,
-				Status: "pending",
+				Status: entities.FriendStatusPending,
 				CreatedAt: friend.CreatedAt,
 				UpdatedAt: friend.UpdatedAt,
 			}, nil
 		}
 	default:
-		friend.Status = "not friend"
+		friend.Status = entities.FriendStatusNone
 	}
 
 	return &entities.Friend{
-				Status: "not friend",
+				Status: entities.FriendStatusNone,
 			}, nil
 }
 
@@ -282,7 +317,7 @@ func (r *MongoFriendRepository) FindAllFriendRequests(userId uuid.UUID) ([]*enti
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	filter := bson.M{"status": "pending", "friend_id" : userId}
+	filter := bson.M{"status": entities.FriendStatusPending, "friend_id" : userId}
 	cur, err := r.coll.Find(ctx, filter)
 	if err != nil {
 		if errors.Is(err, mongo.ErrNoDocuments) {
@@ -321,30 +356,59 @@ func (r *MongoFriendRepository) Delete(id uint) error {
     return err
 }
 
-func (r *MongoFriendRepository) Update(id int) (*entities.Friend, error) {
+func (r *MongoFriendRepository) DeleteBetween(userId uuid.UUID, friendId uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	filter := bson.M{"$or": []bson.M{
+		{"user_id": userId, "friend_id": friendId},
+		{"user_id": friendId, "friend_id": userId},
+	}}
+	_, err := r.coll.DeleteMany(ctx, filter)
+	return err
+}
+
+func (r *MongoFriendRepository) UpdateStatus(id int, from entities.FriendStatus, to entities.FriendStatus) (*entities.Friend, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
 	filter := bson.M{
 		"_id": id,
+		"status": from,
 	}
 
 	update := bson.M{
-		"$set": bson.M{"status": "friend"},
+		"$set": bson.M{"status": to, "updated_at": time.Now().UTC()},
+	}
+	if !to.IsActive() {
+		// free the pair so either user can send a new request
+		update["$unset"] = bson.M{"pair_key": ""}
 	}
 
 	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
 
-	var updatedFriend entities.Friend
-	err := r.coll.FindOneAndUpdate(ctx, filter, update, opts).Decode(&updatedFriend)
+	var d friendDoc
+	err := r.coll.FindOneAndUpdate(ctx, filter, update, opts).Decode(&d)
 	if err != nil {
-		if err == mongo.ErrNoDocuments {
-			return nil, err
-		}
 		return nil, err
 	}
 
-	return &updatedFriend, nil
+	return r.toEntity(d), nil
+}
+
+func (r *MongoFriendRepository) FindActiveBetween(userId uuid.UUID, friendId uuid.UUID) (*entities.Friend, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var d friendDoc
+	err := r.coll.FindOne(ctx, activeBetween(userId, friendId)).Decode(&d)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return &entities.Friend{}, err
+	}
+	if err != nil {
+		return nil, err
+	}
+	return r.toEntity(d), nil
 }
 
 func (r *MongoFriendRepository) FindByID(id int) (*entities.Friend, error) {
@@ -363,14 +427,76 @@ func (r *MongoFriendRepository) FindByID(id int) (*entities.Friend, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &entities.Friend{
-		ID: uint(f.ID),
-		UserID: f.FriendID,
-		FriendID: f.UserID,
-		Status: f.Status,
-		CreatedAt: f.CreatedAt,
-		UpdatedAt: f.UpdatedAt,
-	}, nil
+	return r.toEntity(f), nil
 }
 
+func (r *MongoFriendRepository) FindSuggestions(friendIds []uuid.UUID, roomIds []uint, exclude []uuid.UUID, offset, limit int) ([]*entities.FriendSuggestion, int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	pipeline := mongo.Pipeline{
+		// friends of my friends, one row per mutual friend
+		{{Key: "$match", Value: bson.M{
+			"status": entities.FriendStatusAccepted,
+			"$or": []bson.M{
+				{"user_id": bson.M{"$in": friendIds}},
+				{"friend_id": bson.M{"$in": friendIds}},
+			},
+		}}},
+		{{Key: "$project", Value: bson.M{
+			"_id": 0,
+			"candidate": bson.M{"$cond": bson.A{
+				bson.M{"$in": bson.A{"$user_id", friendIds}}, "$friend_id", "$user_id",
+			}},
+			"mutual": bson.M{"$literal": 1},
+			"rooms":  bson.M{"$literal": 0},
+		}}},
+		// members of my group rooms, one row per shared room
+		{{Key: "$unionWith", Value: bson.M{
+			"coll": "room_members",
+			"pipeline": bson.A{
+				bson.M{"$match": bson.M{"room_id": bson.M{"$in": roomIds}}},
+				bson.M{"$project": bson.M{
+					"_id":       0,
+					"candidate": "$user_id",
+					"mutual":    bson.M{"$literal": 0},
+					"rooms":     bson.M{"$literal": 1},
+				}},
+			},
+		}}},
+		{{Key: "$match", Value: bson.M{"candidate": bson.M{"$nin": exclude}}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":            "$candidate",
+			"mutual_friends": bson.M{"$sum": "$mutual"},
+			"shared_rooms":   bson.M{"$sum": "$rooms"},
+		}}},
+		{{Key: "$facet", Value: bson.M{
+			"total": bson.A{bson.M{"$count": "n"}},
+			"page": bson.A{
+				bson.M{"$sort": bson.D{{Key: "mutual_friends", Value: -1}, {Key: "shared_rooms", Value: -1}, {Key: "_id", Value: 1}}},
+				bson.M{"$skip": offset},
+				bson.M{"$limit": limit},
+			},
+		}}},
+	}
+
+	cur, err := r.coll.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer cur.Close(ctx)
 
+	var out []struct {
+		Total []struct {
+			N int64 `bson:"n"`
+		} `bson:"total"`
+		Page []*entities.FriendSuggestion `bson:"page"`
+	}
+	if err := cur.All(ctx, &out); err != nil {
+		return nil, 0, err
+	}
+	if len(out) == 0 || len(out[0].Total) == 0 {
+		return []*entities.FriendSuggestion{}, 0, nil
+	}
+	return out[0].Page, out[0].Total[0].N, nil
+}
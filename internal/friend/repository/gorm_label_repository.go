@@ -0,0 +1,187 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/MingPV/ChatService/internal/entities"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type MongoLabelRepository struct {
+	db   *mongo.Database
+	coll *mongo.Collection
+}
+
+func NewMongoLabelRepository(db *mongo.Database) LabelRepository {
+	return &MongoLabelRepository{
+		db:   db,
+		coll: db.Collection("friend_labels"),
+	}
+}
+
+type labelDoc struct {
+	ID        int         `bson:"_id,omitempty"`
+	UserID    uuid.UUID   `bson:"user_id"`
+	Name      string      `bson:"name"`
+	FriendIDs []uuid.UUID `bson:"friend_ids"`
+	CreatedAt time.Time   `bson:"created_at"`
+	UpdatedAt time.Time   `bson:"updated_at"`
+}
+
+// EnsureIndexes keeps label names unique per user
+func (r *MongoLabelRepository) EnsureIndexes() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := r.coll.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "name", Value: 1}},
+		Options: options.Index().SetName("user_name").SetUnique(true),
+	})
+	return err
+}
+
+func (r *MongoLabelRepository) Save(label *entities.FriendLabel) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	nextID, err := r.getNextSequence(ctx, "friend_labels")
+	if err != nil {
+		return err
+	}
+
+	friendIds := label.FriendIDs
+	if friendIds == nil {
+		friendIds = []uuid.UUID{}
+	}
+	_, err = r.coll.InsertOne(ctx, labelDoc{
+		ID:        nextID,
+		UserID:    label.UserID,
+		Name:      label.Name,
+		FriendIDs: friendIds,
+		CreatedAt: label.CreatedAt,
+		UpdatedAt: label.UpdatedAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	label.ID = uint(nextID)
+	label.FriendIDs = friendIds
+	return nil
+}
+
+func (r *MongoLabelRepository) FindByID(id int) (*entities.FriendLabel, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var d labelDoc
+	err := r.coll.FindOne(ctx, bson.M{"_id": id}).Decode(&d)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return &entities.FriendLabel{}, err
+	}
+	if err != nil {
+		return nil, err
+	}
+	return r.toEntity(d), nil
+}
+
+func (r *MongoLabelRepository) FindAllByUserID(userId uuid.UUID) ([]*entities.FriendLabel, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	cur, err := r.coll.Find(ctx,
+		bson.M{"user_id": userId},
+		options.Find().SetSort(bson.D{{Key: "name", Value: 1}}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var results []*entities.FriendLabel
+	for cur.Next(ctx) {
+		var d labelDoc
+		if err := cur.Decode(&d); err != nil {
+			return nil, err
+		}
+		results = append(results, r.toEntity(d))
+	}
+	return results, cur.Err()
+}
+
+func (r *MongoLabelRepository) AddFriend(id int, friendId uuid.UUID) error {
+	return r.update(id, bson.M{"$addToSet": bson.M{"friend_ids": friendId}})
+}
+
+func (r *MongoLabelRepository) RemoveFriend(id int, friendId uuid.UUID) error {
+	return r.update(id, bson.M{"$pull": bson.M{"friend_ids": friendId}})
+}
+
+func (r *MongoLabelRepository) update(id int, update bson.M) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	update["$set"] = bson.M{"updated_at": time.Now().UTC()}
+	res, err := r.coll.UpdateOne(ctx, bson.M{"_id": id}, update)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+func (r *MongoLabelRepository) Delete(id int) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := r.coll.DeleteOne(ctx, bson.M{"_id": id})
+	return err
+}
+
+func (r *MongoLabelRepository) toEntity(d labelDoc) *entities.FriendLabel {
+	return &entities.FriendLabel{
+		ID:        uint(d.ID),
+		UserID:    d.UserID,
+		Name:      d.Name,
+		FriendIDs: d.FriendIDs,
+		CreatedAt: d.CreatedAt,
+		UpdatedAt: d.UpdatedAt,
+	}
+}
+
+func (r *MongoLabelRepository) getNextSequence(ctx context.Context, name string) (int, error) {
+	counters := r.db.Collection("counters")
+	opts := options.FindOneAndUpdate().
+		SetUpsert(true).
+		SetReturnDocument(options.After)
+
+	var out counterDoc
+	err := counters.FindOneAndUpdate(
+		ctx,
+		bson.M{"_id": name},
+		bson.M{"$inc": bson.M{"seq": 1}},
+		opts,
+	).Decode(&out)
+
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		_, ierr := counters.InsertOne(ctx, counterDoc{ID: name, Seq: 1})
+		if ierr != nil {
+			return 0, ierr
+		}
+		return 1, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if out.Seq == 0 {
+		return 1, nil
+	}
+	return out.Seq, nil
+}
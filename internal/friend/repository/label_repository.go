@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"github.com/MingPV/ChatService/internal/entities"
+	"github.com/google/uuid"
+)
+
+type LabelRepository interface {
+	Save(label *entities.FriendLabel) error
+	FindByID(id int) (*entities.FriendLabel, error)
+	FindAllByUserID(userId uuid.UUID) ([]*entities.FriendLabel, error)
+	AddFriend(id int, friendId uuid.UUID) error
+	RemoveFriend(id int, friendId uuid.UUID) error
+	Delete(id int) error
+	EnsureIndexes() error
+}
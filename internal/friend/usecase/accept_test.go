@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/MingPV/ChatService/internal/entities"
+	"github.com/MingPV/ChatService/internal/testsupport"
+	"github.com/google/uuid"
+)
+
+func TestAcceptFriendSetsUpDirectRoom(t *testing.T) {
+	sender, recipient := uuid.New(), uuid.New()
+	key := entities.DirectRoomKey(sender, recipient)
+
+	tests := []struct {
+		name        string
+		existing    *entities.Chatroom
+		raced       *entities.Chatroom
+		roomErr     error
+		memberErr   error
+		wantErr     error
+		wantRoom    uint
+		wantMembers int
+		wantDeleted int
+	}{
+		{name: "creates the room with both members", wantRoom: 1, wantMembers: 2},
+		{name: "reuses the existing room", existing: &entities.Chatroom{ID: 7, DirectKey: key}, wantRoom: 7},
+		{name: "uses the room created concurrently", raced: &entities.Chatroom{ID: 9, DirectKey: key}, wantRoom: 9},
+		{name: "room save failure restores the request", roomErr: errSaveFailed, wantErr: errSaveFailed},
+		{name: "member save failure removes the room", memberErr: errSaveFailed, wantErr: errSaveFailed, wantDeleted: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			friends := &fakeFriends{}
+			friends.Save(&entities.Friend{UserID: sender, FriendID: recipient, Status: entities.FriendStatusPending})
+			rooms := testsupport.NewChatrooms(tt.existing)
+			rooms.Raced = tt.raced
+			rooms.SaveErr = tt.roomErr
+			members := &testsupport.Members{SaveErr: tt.memberErr}
+			s := &FriendService{friendRepo: friends, blockRepo: testsupport.NewBlocks(), chatroomRepo: rooms, roommemberRepo: members}
+
+			_, err := s.AcceptFriend(1, recipient)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("AcceptFriend() error = %v, want %v", err, tt.wantErr)
+			}
+			if len(rooms.Deleted) != tt.wantDeleted {
+				t.Fatalf("deleted rooms = %v, want %d", rooms.Deleted, tt.wantDeleted)
+			}
+
+			stored, _ := friends.FindByID(1)
+			if tt.wantErr != nil {
+				if stored.Status != entities.FriendStatusPending {
+					t.Fatalf("status = %q, want the request back to pending", stored.Status)
+				}
+				if _, err := rooms.FindDirect(sender, recipient); err == nil {
+					t.Fatalf("direct room left behind after a failed accept")
+				}
+				return
+			}
+			if stored.Status != entities.FriendStatusAccepted {
+				t.Fatalf("status = %q, want %q", stored.Status, entities.FriendStatusAccepted)
+			}
+			room, err := rooms.FindDirect(sender, recipient)
+			if err != nil || room.ID != tt.wantRoom {
+				t.Fatalf("direct room = %+v (%v), want id %d", room, err, tt.wantRoom)
+			}
+			if added, _ := members.FindAllByRoomID(room.ID); len(added) != tt.wantMembers {
+				t.Fatalf("members added = %d, want %d", len(added), tt.wantMembers)
+			}
+		})
+	}
+}
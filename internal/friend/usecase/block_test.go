@@ -0,0 +1,132 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/MingPV/ChatService/internal/entities"
+	"github.com/MingPV/ChatService/internal/testsupport"
+	"github.com/MingPV/ChatService/pkg/apperror"
+	"github.com/google/uuid"
+)
+
+func TestBlockUser(t *testing.T) {
+	alice, bob := uuid.New(), uuid.New()
+
+	tests := []struct {
+		name          string
+		blockedId     uuid.UUID
+		alreadyBlocks bool
+		wantErr       error
+	}{
+		{name: "blocks another user", blockedId: bob},
+		{name: "cannot block yourself", blockedId: alice, wantErr: apperror.ErrInvalidData},
+		{name: "cannot block twice", blockedId: bob, alreadyBlocks: true, wantErr: apperror.ErrAlreadyExists},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			friends := &fakeFriends{}
+			friends.Save(&entities.Friend{UserID: alice, FriendID: bob, Status: entities.FriendStatusAccepted})
+			friends.Save(&entities.Friend{UserID: bob, FriendID: alice, Status: entities.FriendStatusPending})
+			blocks := testsupport.NewBlocks()
+			if tt.alreadyBlocks {
+				blocks.Save(&entities.Block{UserID: alice, BlockedID: bob})
+			}
+			s := &FriendService{friendRepo: friends, blockRepo: blocks}
+
+			block, err := s.BlockUser(alice, tt.blockedId)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("BlockUser() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				if len(friends.friends) != 2 {
+					t.Fatalf("friend records = %d, want 2 untouched", len(friends.friends))
+				}
+				return
+			}
+			if block.UserID != alice || block.BlockedID != bob {
+				t.Fatalf("block = %+v, want %s -> %s", block, alice, bob)
+			}
+			if len(friends.friends) != 0 {
+				t.Fatalf("friend records = %d, want friendship and requests removed", len(friends.friends))
+			}
+		})
+	}
+}
+
+func TestBlockedUsersCannotBefriend(t *testing.T) {
+	alice, bob := uuid.New(), uuid.New()
+
+	tests := []struct {
+		name    string
+		blocker uuid.UUID
+		blocked uuid.UUID
+	}{
+		{name: "sender blocked the recipient", blocker: alice, blocked: bob},
+		{name: "recipient blocked the sender", blocker: bob, blocked: alice},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			friends := &fakeFriends{}
+			blocks := testsupport.NewBlocks()
+			blocks.Save(&entities.Block{UserID: tt.blocker, BlockedID: tt.blocked})
+			s := &FriendService{friendRepo: friends, blockRepo: blocks}
+
+			err := s.CreateFriend(&entities.Friend{UserID: alice, FriendID: bob})
+			if !errors.Is(err, apperror.ErrForbidden) {
+				t.Fatalf("CreateFriend() error = %v, want %v", err, apperror.ErrForbidden)
+			}
+			if len(friends.friends) != 0 {
+				t.Fatalf("friend records = %d, want none", len(friends.friends))
+			}
+		})
+	}
+}
+
+func TestIsMyfriendHidesBlockedPeers(t *testing.T) {
+	alice, bob := uuid.New(), uuid.New()
+
+	tests := []struct {
+		name       string
+		viewer     uuid.UUID
+		other      uuid.UUID
+		blocks     bool
+		wantStatus entities.FriendStatus
+		wantOnline bool
+	}{
+		{name: "friends see each other online", viewer: alice, other: bob, wantStatus: entities.FriendStatusAccepted, wantOnline: true},
+		{name: "blocker sees the block", viewer: alice, other: bob, blocks: true, wantStatus: entities.FriendStatusBlocked},
+		{name: "blocked user sees a stranger", viewer: bob, other: alice, blocks: true, wantStatus: entities.FriendStatusNone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			friends := &fakeFriends{}
+			blocks := testsupport.NewBlocks()
+			s := &FriendService{
+				friendRepo: friends,
+				blockRepo:  blocks,
+				msgUseCase: &fakePresence{online: map[uuid.UUID]bool{alice: true, bob: true}},
+			}
+			friends.Save(&entities.Friend{UserID: alice, FriendID: bob, Status: entities.FriendStatusAccepted})
+			if tt.blocks {
+				if _, err := s.BlockUser(alice, bob); err != nil {
+					t.Fatalf("BlockUser() error = %v", err)
+				}
+			}
+
+			friend, err := s.IsMyfriend(tt.viewer, tt.other)
+			if err != nil {
+				t.Fatalf("IsMyfriend() error = %v", err)
+			}
+			if friend.Status != tt.wantStatus {
+				t.Fatalf("status = %q, want %q", friend.Status, tt.wantStatus)
+			}
+			if friend.Online != tt.wantOnline {
+				t.Fatalf("online = %v, want %v", friend.Online, tt.wantOnline)
+			}
+		})
+	}
+}
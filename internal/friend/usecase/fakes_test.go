@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"errors"
+
+	"github.com/MingPV/ChatService/internal/entities"
+	friendRepo "github.com/MingPV/ChatService/internal/friend/repository"
+	messageUseCase "github.com/MingPV/ChatService/internal/message/usecase"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+var errSaveFailed = errors.New("save failed")
+
+// fakeFriends keeps friend records in insertion order
+type fakeFriends struct {
+	friendRepo.FriendRepository
+	friends []*entities.Friend
+	// suggestion filters passed to the last FindSuggestions call
+	suggestFriends []uuid.UUID
+	suggestRooms   []uint
+	suggestExclude []uuid.UUID
+}
+
+func (f *fakeFriends) Save(friend *entities.Friend) error {
+	friend.ID = uint(len(f.friends) + 1)
+	copied := *friend
+	f.friends = append(f.friends, &copied)
+	return nil
+}
+
+func (f *fakeFriends) FindByID(id int) (*entities.Friend, error) {
+	for _, fr := range f.friends {
+		if fr.ID == uint(id) {
+			copied := *fr
+			return &copied, nil
+		}
+	}
+	return &entities.Friend{}, mongo.ErrNoDocuments
+}
+
+func (f *fakeFriends) FindActiveBetween(userId uuid.UUID, friendId uuid.UUID) (*entities.Friend, error) {
+	for _, fr := range f.friends {
+		if between(fr, userId, friendId) && (fr.Status == entities.FriendStatusPending || fr.Status == entities.FriendStatusAccepted) {
+			copied := *fr
+			return &copied, nil
+		}
+	}
+	return &entities.Friend{}, mongo.ErrNoDocuments
+}
+
+func (f *fakeFriends) IsMyfriend(userId uuid.UUID, friendId uuid.UUID) (*entities.Friend, error) {
+	fr, err := f.FindActiveBetween(userId, friendId)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return &entities.Friend{UserID: userId, FriendID: friendId, Status: entities.FriendStatusNone}, nil
+	}
+	return fr, err
+}
+
+func (f *fakeFriends) DeleteBetween(userId uuid.UUID, friendId uuid.UUID) error {
+	kept := f.friends[:0]
+	for _, fr := range f.friends {
+		if !between(fr, userId, friendId) {
+			kept = append(kept, fr)
+		}
+	}
+	f.friends = kept
+	return nil
+}
+
+func (f *fakeFriends) UpdateStatus(id int, from entities.FriendStatus, to entities.FriendStatus) (*entities.Friend, error) {
+	for _, fr := range f.friends {
+		if fr.ID == uint(id) && fr.Status == from {
+			fr.Status = to
+			copied := *fr
+			return &copied, nil
+		}
+	}
+	return &entities.Friend{}, mongo.ErrNoDocuments
+}
+
+func (f *fakeFriends) FindAllByUserId(userId uuid.UUID) ([]*entities.Friend, error) {
+	var out []*entities.Friend
+	for _, fr := range f.friends {
+		if fr.UserID == userId || fr.FriendID == userId {
+			out = append(out, fr)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeFriends) FindSuggestions(friendIds []uuid.UUID, roomIds []uint, exclude []uuid.UUID, offset, limit int) ([]*entities.FriendSuggestion, int64, error) {
+	f.suggestFriends = friendIds
+	f.suggestRooms = roomIds
+	f.suggestExclude = exclude
+	return nil, 0, nil
+}
+
+func between(fr *entities.Friend, a uuid.UUID, b uuid.UUID) bool {
+	return (fr.UserID == a && fr.FriendID == b) || (fr.UserID == b && fr.FriendID == a)
+}
+
+// fakePresence reports the given users as online
+type fakePresence struct {
+	messageUseCase.MessageUseCase
+	online map[uuid.UUID]bool
+}
+
+func (f *fakePresence) IsOnline(userId uuid.UUID) bool {
+	return f.online[userId]
+}
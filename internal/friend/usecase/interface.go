@@ -14,5 +14,23 @@ type FriendUseCase interface {
 	FindFriendByID(id int) (*entities.Friend, error)
 	IsMyfriend(userId uuid.UUID, friendId uuid.UUID) (*entities.Friend, error)
 	DeleteFriend(id uint) error
-	AcceptFriend(id int) (*entities.Friend, error)
-}
\ No newline at end of file
+	AcceptFriend(id int, userId uuid.UUID) (*entities.Friend, error)
+	RejectFriend(id int, userId uuid.UUID) (*entities.Friend, error)
+	CancelFriend(id int, userId uuid.UUID) (*entities.Friend, error)
+	FindDirectRoom(userId uuid.UUID, friendId uuid.UUID) (*entities.Chatroom, error)
+	FindFriendSuggestions(userId uuid.UUID, page, pageSize int) ([]*entities.FriendSuggestion, int64, error)
+	BlockUser(userId uuid.UUID, blockedId uuid.UUID) (*entities.Block, error)
+	UnblockUser(userId uuid.UUID, blockedId uuid.UUID) error
+	FindBlockedUsers(userId uuid.UUID) ([]*entities.Block, error)
+}
+
+type FriendLabelUseCase interface {
+	CreateLabel(label *entities.FriendLabel) error
+	FindLabels(userId uuid.UUID) ([]*entities.FriendLabel, error)
+	DeleteLabel(id int, userId uuid.UUID) error
+	AssignFriend(id int, userId uuid.UUID, friendId uuid.UUID) (*entities.FriendLabel, error)
+	UnassignFriend(id int, userId uuid.UUID, friendId uuid.UUID) (*entities.FriendLabel, error)
+	FindFriendsByLabel(id int, userId uuid.UUID) ([]*entities.Friend, error)
+	// CreateGroupFromLabel creates a group room seeded with every friend in the label.
+	CreateGroupFromLabel(id int, userId uuid.UUID, roomName string) (*entities.Chatroom, error)
+}
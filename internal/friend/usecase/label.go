@@ -0,0 +1,180 @@
+package usecase
+
+import (
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	chatroomUseCase "github.com/MingPV/ChatService/internal/chatroom/usecase"
+	"github.com/MingPV/ChatService/internal/entities"
+	friendRepo "github.com/MingPV/ChatService/internal/friend/repository"
+	roommemberRepo "github.com/MingPV/ChatService/internal/room_member/repository"
+	"github.com/MingPV/ChatService/pkg/apperror"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const maxLabelNameLength = 50
+
+// FriendLabelService implements FriendLabelUseCase
+type FriendLabelService struct {
+	labelRepo       friendRepo.LabelRepository
+	friendRepo      friendRepo.FriendRepository
+	roommemberRepo  roommemberRepo.RoomMemberRepository
+	chatroomUseCase chatroomUseCase.ChatroomUseCase
+}
+
+func NewFriendLabelService(labelRepo friendRepo.LabelRepository, friendRepo friendRepo.FriendRepository, roommemberRepo roommemberRepo.RoomMemberRepository, chatroomUseCase chatroomUseCase.ChatroomUseCase) FriendLabelUseCase {
+	return &FriendLabelService{labelRepo: labelRepo, friendRepo: friendRepo, roommemberRepo: roommemberRepo, chatroomUseCase: chatroomUseCase}
+}
+
+func (s *FriendLabelService) CreateLabel(label *entities.FriendLabel) error {
+	label.Name = strings.TrimSpace(label.Name)
+	if label.Name == "" {
+		return apperror.ErrRequiredField
+	}
+	if len([]rune(label.Name)) > maxLabelNameLength {
+		return apperror.ErrOutOfRange
+	}
+
+	now := time.Now().UTC()
+	label.FriendIDs = nil
+	label.CreatedAt = now
+	label.UpdatedAt = now
+	return s.labelRepo.Save(label)
+}
+
+func (s *FriendLabelService) FindLabels(userId uuid.UUID) ([]*entities.FriendLabel, error) {
+	return s.labelRepo.FindAllByUserID(userId)
+}
+
+func (s *FriendLabelService) DeleteLabel(id int, userId uuid.UUID) error {
+	if _, err := s.ownLabel(id, userId); err != nil {
+		return err
+	}
+	return s.labelRepo.Delete(id)
+}
+
+// AssignFriend adds friendId to the label; only current friends can be labelled
+func (s *FriendLabelService) AssignFriend(id int, userId uuid.UUID, friendId uuid.UUID) (*entities.FriendLabel, error) {
+	if _, err := s.ownLabel(id, userId); err != nil {
+		return nil, err
+	}
+	friend, err := s.friendRepo.FindActiveBetween(userId, friendId)
+	if errors.Is(err, mongo.ErrNoDocuments) || (err == nil && friend.Status != entities.FriendStatusAccepted) {
+		return nil, apperror.ErrInvalidData
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.labelRepo.AddFriend(id, friendId); err != nil {
+		return nil, err
+	}
+	return s.labelRepo.FindByID(id)
+}
+
+func (s *FriendLabelService) UnassignFriend(id int, userId uuid.UUID, friendId uuid.UUID) (*entities.FriendLabel, error) {
+	if _, err := s.ownLabel(id, userId); err != nil {
+		return nil, err
+	}
+	if err := s.labelRepo.RemoveFriend(id, friendId); err != nil {
+		return nil, err
+	}
+	return s.labelRepo.FindByID(id)
+}
+
+// FindFriendsByLabel lists the label's friends, skipping anyone who is no longer a friend
+func (s *FriendLabelService) FindFriendsByLabel(id int, userId uuid.UUID) ([]*entities.Friend, error) {
+	label, err := s.ownLabel(id, userId)
+	if err != nil {
+		return nil, err
+	}
+	return s.currentFriends(label)
+}
+
+// CreateGroupFromLabel starts a group room owned by userId with every current friend in the label.
+// The room is named after the label unless roomName is given.
+func (s *FriendLabelService) CreateGroupFromLabel(id int, userId uuid.UUID, roomName string) (*entities.Chatroom, error) {
+	label, err := s.ownLabel(id, userId)
+	if err != nil {
+		return nil, err
+	}
+	friends, err := s.currentFriends(label)
+	if err != nil {
+		return nil, err
+	}
+	if len(friends) == 0 {
+		return nil, apperror.ErrInvalidData
+	}
+
+	if strings.TrimSpace(roomName) == "" {
+		roomName = label.Name
+	}
+	now := time.Now().UTC()
+	room := &entities.Chatroom{
+		RoomName:  roomName,
+		IsGroup:   true,
+		Owner:     userId,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := s.chatroomUseCase.CreateChatroom(room); err != nil {
+		return nil, err
+	}
+
+	userIds := []uuid.UUID{userId}
+	for _, f := range friends {
+		userIds = append(userIds, f.FriendID)
+	}
+	if err := s.roommemberRepo.Save(room.ID, userIds); err != nil {
+		if derr := s.roommemberRepo.DeleteAllByRoomID(int(room.ID)); derr != nil {
+			log.Printf("failed to remove members of room %d: %v", room.ID, derr)
+		}
+		if derr := s.chatroomUseCase.DeleteChatroom(int(room.ID)); derr != nil {
+			log.Printf("failed to remove room %d: %v", room.ID, derr)
+		}
+		return nil, err
+	}
+	room.MemberCount = int64(len(userIds))
+	return room, nil
+}
+
+// ownLabel loads a label, hiding labels that belong to someone else
+func (s *FriendLabelService) ownLabel(id int, userId uuid.UUID) (*entities.FriendLabel, error) {
+	label, err := s.labelRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if label.UserID != userId {
+		return nil, apperror.ErrRecordNotFound
+	}
+	return label, nil
+}
+
+// currentFriends resolves the label's members to friend records seen from the label owner's side
+func (s *FriendLabelService) currentFriends(label *entities.FriendLabel) ([]*entities.Friend, error) {
+	friends := []*entities.Friend{}
+	for _, friendId := range label.FriendIDs {
+		f, err := s.friendRepo.FindActiveBetween(label.UserID, friendId)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if f.Status != entities.FriendStatusAccepted {
+			continue
+		}
+		friends = append(friends, &entities.Friend{
+			ID:        f.ID,
+			UserID:    label.UserID,
+			FriendID:  friendId,
+			Status:    f.Status,
+			CreatedAt: f.CreatedAt,
+			UpdatedAt: f.UpdatedAt,
+		})
+	}
+	return friends, nil
+}
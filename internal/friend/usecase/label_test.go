@@ -0,0 +1,180 @@
+package usecase
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	chatroomUseCase "github.com/MingPV/ChatService/internal/chatroom/usecase"
+	"github.com/MingPV/ChatService/internal/entities"
+	friendRepo "github.com/MingPV/ChatService/internal/friend/repository"
+	"github.com/MingPV/ChatService/internal/testsupport"
+	"github.com/MingPV/ChatService/pkg/apperror"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type fakeLabels struct {
+	friendRepo.LabelRepository
+	labels map[uint]*entities.FriendLabel
+}
+
+func (f *fakeLabels) Save(label *entities.FriendLabel) error {
+	label.ID = uint(len(f.labels) + 1)
+	f.labels[label.ID] = label
+	return nil
+}
+
+func (f *fakeLabels) FindByID(id int) (*entities.FriendLabel, error) {
+	if label, ok := f.labels[uint(id)]; ok {
+		return label, nil
+	}
+	return &entities.FriendLabel{}, mongo.ErrNoDocuments
+}
+
+func (f *fakeLabels) AddFriend(id int, friendId uuid.UUID) error {
+	f.labels[uint(id)].FriendIDs = append(f.labels[uint(id)].FriendIDs, friendId)
+	return nil
+}
+
+// fakeRoomCreator records rooms created and deleted through the chatroom use case
+type fakeRoomCreator struct {
+	chatroomUseCase.ChatroomUseCase
+	created []*entities.Chatroom
+	deleted []int
+}
+
+func (f *fakeRoomCreator) CreateChatroom(room *entities.Chatroom) error {
+	room.ID = uint(len(f.created) + 1)
+	f.created = append(f.created, room)
+	return nil
+}
+
+func (f *fakeRoomCreator) DeleteChatroom(id int) error {
+	f.deleted = append(f.deleted, id)
+	return nil
+}
+
+func TestCreateLabel(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		wantName string
+		wantErr  error
+	}{
+		{name: "trims the name", input: "  Family ", wantName: "Family"},
+		{name: "blank name", input: "   ", wantErr: apperror.ErrRequiredField},
+		{name: "name at the limit", input: strings.Repeat("ก", maxLabelNameLength), wantName: strings.Repeat("ก", maxLabelNameLength)},
+		{name: "name too long", input: strings.Repeat("a", maxLabelNameLength+1), wantErr: apperror.ErrOutOfRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			labels := &fakeLabels{labels: make(map[uint]*entities.FriendLabel)}
+			s := NewFriendLabelService(labels, &fakeFriends{}, &testsupport.Members{}, &fakeRoomCreator{})
+
+			label := &entities.FriendLabel{UserID: uuid.New(), Name: tt.input, FriendIDs: []uuid.UUID{uuid.New()}}
+			err := s.CreateLabel(label)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CreateLabel() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if label.Name != tt.wantName {
+				t.Fatalf("name = %q, want %q", label.Name, tt.wantName)
+			}
+			if len(label.FriendIDs) != 0 {
+				t.Fatalf("friend ids = %v, want a new label to start empty", label.FriendIDs)
+			}
+		})
+	}
+}
+
+func TestAssignFriend(t *testing.T) {
+	owner, friend, pending, stranger := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	tests := []struct {
+		name     string
+		userId   uuid.UUID
+		friendId uuid.UUID
+		wantErr  error
+	}{
+		{name: "labels a friend", userId: owner, friendId: friend},
+		{name: "someone else's label", userId: stranger, friendId: friend, wantErr: apperror.ErrRecordNotFound},
+		{name: "pending request is not a friend", userId: owner, friendId: pending, wantErr: apperror.ErrInvalidData},
+		{name: "stranger is not a friend", userId: owner, friendId: stranger, wantErr: apperror.ErrInvalidData},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			friends := &fakeFriends{}
+			friends.Save(&entities.Friend{UserID: owner, FriendID: friend, Status: entities.FriendStatusAccepted})
+			friends.Save(&entities.Friend{UserID: owner, FriendID: pending, Status: entities.FriendStatusPending})
+			labels := &fakeLabels{labels: map[uint]*entities.FriendLabel{1: {ID: 1, UserID: owner, Name: "Family"}}}
+			s := NewFriendLabelService(labels, friends, &testsupport.Members{}, &fakeRoomCreator{})
+
+			label, err := s.AssignFriend(1, tt.userId, tt.friendId)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("AssignFriend() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				if len(labels.labels[1].FriendIDs) != 0 {
+					t.Fatalf("friend ids = %v, want none", labels.labels[1].FriendIDs)
+				}
+				return
+			}
+			if len(label.FriendIDs) != 1 || label.FriendIDs[0] != tt.friendId {
+				t.Fatalf("friend ids = %v, want [%s]", label.FriendIDs, tt.friendId)
+			}
+		})
+	}
+}
+
+func TestCreateGroupFromLabel(t *testing.T) {
+	owner, friend, former := uuid.New(), uuid.New(), uuid.New()
+
+	tests := []struct {
+		name        string
+		friendIds   []uuid.UUID
+		roomName    string
+		memberErr   error
+		wantErr     error
+		wantName    string
+		wantMembers int
+	}{
+		{name: "named after the label", friendIds: []uuid.UUID{friend}, wantName: "Team A", wantMembers: 2},
+		{name: "explicit room name", friendIds: []uuid.UUID{friend}, roomName: "Launch", wantName: "Launch", wantMembers: 2},
+		{name: "former friends are left out", friendIds: []uuid.UUID{friend, former}, wantName: "Team A", wantMembers: 2},
+		{name: "no current friends", friendIds: []uuid.UUID{former}, wantErr: apperror.ErrInvalidData},
+		{name: "member save failure removes the room", friendIds: []uuid.UUID{friend}, memberErr: errSaveFailed, wantErr: errSaveFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			friends := &fakeFriends{}
+			friends.Save(&entities.Friend{UserID: friend, FriendID: owner, Status: entities.FriendStatusAccepted})
+			labels := &fakeLabels{labels: map[uint]*entities.FriendLabel{1: {ID: 1, UserID: owner, Name: "Team A", FriendIDs: tt.friendIds}}}
+			members := &testsupport.Members{SaveErr: tt.memberErr}
+			rooms := &fakeRoomCreator{}
+			s := NewFriendLabelService(labels, friends, members, rooms)
+
+			room, err := s.CreateGroupFromLabel(1, owner, tt.roomName)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CreateGroupFromLabel() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				if len(rooms.created) != len(rooms.deleted) {
+					t.Fatalf("created %d rooms, deleted %d, want none left behind", len(rooms.created), len(rooms.deleted))
+				}
+				return
+			}
+			if room.RoomName != tt.wantName || !room.IsGroup || room.Owner != owner {
+				t.Fatalf("room = %+v, want group %q owned by %s", room, tt.wantName, owner)
+			}
+			if added, _ := members.FindAllByRoomID(room.ID); len(added) != tt.wantMembers || room.MemberCount != int64(tt.wantMembers) {
+				t.Fatalf("members = %d (count %d), want %d", len(added), room.MemberCount, tt.wantMembers)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/MingPV/ChatService/internal/entities"
+	"github.com/MingPV/ChatService/internal/testsupport"
+	"github.com/MingPV/ChatService/pkg/apperror"
+	"github.com/google/uuid"
+)
+
+func TestCreateFriend(t *testing.T) {
+	alice, bob := uuid.New(), uuid.New()
+
+	tests := []struct {
+		name       string
+		existing   *entities.Friend
+		friendId   uuid.UUID
+		wantErr    error
+		wantStatus entities.FriendStatus
+	}{
+		{name: "sends a new request", friendId: bob, wantStatus: entities.FriendStatusPending},
+		{name: "cannot befriend yourself", friendId: alice, wantErr: apperror.ErrInvalidData},
+		{
+			name:     "request already pending",
+			existing: &entities.Friend{UserID: alice, FriendID: bob, Status: entities.FriendStatusPending},
+			friendId: bob,
+			wantErr:  apperror.ErrAlreadyExists,
+		},
+		{
+			name:     "already friends",
+			existing: &entities.Friend{UserID: bob, FriendID: alice, Status: entities.FriendStatusAccepted},
+			friendId: bob,
+			wantErr:  apperror.ErrAlreadyExists,
+		},
+		{
+			name:       "asking back accepts their request",
+			existing:   &entities.Friend{UserID: bob, FriendID: alice, Status: entities.FriendStatusPending},
+			friendId:   bob,
+			wantStatus: entities.FriendStatusAccepted,
+		},
+		{
+			name:       "rejected request can be sent again",
+			existing:   &entities.Friend{UserID: alice, FriendID: bob, Status: entities.FriendStatusRejected},
+			friendId:   bob,
+			wantStatus: entities.FriendStatusPending,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			friends := &fakeFriends{}
+			if tt.existing != nil {
+				friends.Save(tt.existing)
+			}
+			s := &FriendService{friendRepo: friends, blockRepo: testsupport.NewBlocks(), chatroomRepo: testsupport.NewChatrooms(), roommemberRepo: &testsupport.Members{}}
+
+			friend := &entities.Friend{UserID: alice, FriendID: tt.friendId}
+			err := s.CreateFriend(friend)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CreateFriend() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if friend.Status != tt.wantStatus {
+				t.Fatalf("status = %q, want %q", friend.Status, tt.wantStatus)
+			}
+			active, err := friends.FindActiveBetween(alice, bob)
+			if err != nil {
+				t.Fatalf("FindActiveBetween() error = %v", err)
+			}
+			if active.Status != tt.wantStatus {
+				t.Fatalf("stored status = %q, want %q", active.Status, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestRespondToFriendRequest(t *testing.T) {
+	sender, recipient, stranger := uuid.New(), uuid.New(), uuid.New()
+
+	tests := []struct {
+		name       string
+		status     entities.FriendStatus
+		respond    func(s *FriendService, id int, userId uuid.UUID) (*entities.Friend, error)
+		actor      uuid.UUID
+		wantErr    error
+		wantStatus entities.FriendStatus
+	}{
+		{name: "recipient accepts", status: entities.FriendStatusPending, respond: (*FriendService).AcceptFriend, actor: recipient, wantStatus: entities.FriendStatusAccepted},
+		{name: "recipient rejects", status: entities.FriendStatusPending, respond: (*FriendService).RejectFriend, actor: recipient, wantStatus: entities.FriendStatusRejected},
+		{name: "sender cancels", status: entities.FriendStatusPending, respond: (*FriendService).CancelFriend, actor: sender, wantStatus: entities.FriendStatusCancelled},
+		{name: "sender cannot accept", status: entities.FriendStatusPending, respond: (*FriendService).AcceptFriend, actor: sender, wantErr: apperror.ErrForbidden},
+		{name: "sender cannot reject", status: entities.FriendStatusPending, respond: (*FriendService).RejectFriend, actor: sender, wantErr: apperror.ErrForbidden},
+		{name: "recipient cannot cancel", status: entities.FriendStatusPending, respond: (*FriendService).CancelFriend, actor: recipient, wantErr: apperror.ErrForbidden},
+		{name: "stranger cannot accept", status: entities.FriendStatusPending, respond: (*FriendService).AcceptFriend, actor: stranger, wantErr: apperror.ErrForbidden},
+		{name: "rejected request cannot be accepted", status: entities.FriendStatusRejected, respond: (*FriendService).AcceptFriend, actor: recipient, wantErr: apperror.ErrNotAvailable},
+		{name: "cancelled request cannot be rejected", status: entities.FriendStatusCancelled, respond: (*FriendService).RejectFriend, actor: recipient, wantErr: apperror.ErrNotAvailable},
+		{name: "accepted request cannot be cancelled", status: entities.FriendStatusAccepted, respond: (*FriendService).CancelFriend, actor: sender, wantErr: apperror.ErrNotAvailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			friends := &fakeFriends{}
+			friends.Save(&entities.Friend{UserID: sender, FriendID: recipient, Status: tt.status})
+			s := &FriendService{friendRepo: friends, blockRepo: testsupport.NewBlocks(), chatroomRepo: testsupport.NewChatrooms(), roommemberRepo: &testsupport.Members{}}
+
+			friend, err := tt.respond(s, 1, tt.actor)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("respond error = %v, want %v", err, tt.wantErr)
+			}
+			stored, _ := friends.FindByID(1)
+			if tt.wantErr != nil {
+				if stored.Status != tt.status {
+					t.Fatalf("stored status = %q, want %q unchanged", stored.Status, tt.status)
+				}
+				return
+			}
+			if friend.Status != tt.wantStatus || stored.Status != tt.wantStatus {
+				t.Fatalf("status = %q, stored %q, want %q", friend.Status, stored.Status, tt.wantStatus)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/MingPV/ChatService/internal/entities"
+	"github.com/MingPV/ChatService/internal/testsupport"
+	"github.com/google/uuid"
+)
+
+func TestFindFriendSuggestionsFilters(t *testing.T) {
+	me, friend, requested, asking, rejected, blocked, blocker := uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	tests := []struct {
+		name        string
+		friends     []*entities.Friend
+		blocks      map[uuid.UUID][]uuid.UUID
+		memberships []*entities.RoomMember
+		wantFriends []uuid.UUID
+		wantRooms   []uint
+		wantExclude []uuid.UUID
+	}{
+		{
+			name:        "no relations only excludes yourself",
+			wantFriends: []uuid.UUID{},
+			wantRooms:   []uint{},
+			wantExclude: []uuid.UUID{me},
+		},
+		{
+			name: "friends seed suggestions and pending requests are skipped",
+			friends: []*entities.Friend{
+				{UserID: friend, FriendID: me, Status: entities.FriendStatusAccepted},
+				{UserID: me, FriendID: requested, Status: entities.FriendStatusPending},
+				{UserID: asking, FriendID: me, Status: entities.FriendStatusPending},
+				{UserID: me, FriendID: rejected, Status: entities.FriendStatusRejected},
+			},
+			wantFriends: []uuid.UUID{friend},
+			wantRooms:   []uint{},
+			wantExclude: []uuid.UUID{me, friend, requested, asking},
+		},
+		{
+			name:        "blocks in either direction are excluded",
+			blocks:      map[uuid.UUID][]uuid.UUID{me: {blocked}, blocker: {me}},
+			wantFriends: []uuid.UUID{},
+			wantRooms:   []uint{},
+			wantExclude: []uuid.UUID{me, blocked, blocker},
+		},
+		{
+			name: "only group rooms count",
+			memberships: []*entities.RoomMember{
+				{RoomId: 3, Chatroom: entities.Chatroom{ID: 3, IsGroup: true}},
+				{RoomId: 4, Chatroom: entities.Chatroom{ID: 4}},
+			},
+			wantFriends: []uuid.UUID{},
+			wantRooms:   []uint{3},
+			wantExclude: []uuid.UUID{me},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			friends := &fakeFriends{friends: tt.friends}
+			blocks := testsupport.NewBlocks()
+			for blockerId, ids := range tt.blocks {
+				blocks.Blocked[blockerId] = ids
+			}
+			members := &testsupport.Members{}
+			for _, m := range tt.memberships {
+				m.UserId = me
+				members.Add(m)
+			}
+			s := &FriendService{friendRepo: friends, blockRepo: blocks, roommemberRepo: members}
+
+			if _, _, err := s.FindFriendSuggestions(me, 1, 20); err != nil {
+				t.Fatalf("FindFriendSuggestions() error = %v", err)
+			}
+			if !sameIDs(friends.suggestFriends, tt.wantFriends) {
+				t.Fatalf("friendIds = %v, want %v", friends.suggestFriends, tt.wantFriends)
+			}
+			if len(friends.suggestRooms) != len(tt.wantRooms) || (len(tt.wantRooms) > 0 && friends.suggestRooms[0] != tt.wantRooms[0]) {
+				t.Fatalf("roomIds = %v, want %v", friends.suggestRooms, tt.wantRooms)
+			}
+			if !sameIDs(friends.suggestExclude, tt.wantExclude) {
+				t.Fatalf("exclude = %v, want %v", friends.suggestExclude, tt.wantExclude)
+			}
+		})
+	}
+}
+
+// sameIDs compares two id lists ignoring order
+func sameIDs(got []uuid.UUID, want []uuid.UUID) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	a := make([]string, len(got))
+	b := make([]string, len(want))
+	for i := range got {
+		a[i] = got[i].String()
+		b[i] = want[i].String()
+	}
+	sort.Strings(a)
+	sort.Strings(b)
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
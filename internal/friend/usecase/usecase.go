@@ -1,47 +1,70 @@
 package usecase
 
 import (
-	"fmt"
+	"context"
+	"errors"
+	"log"
+	"time"
 
 	chatroomRepo "github.com/MingPV/ChatService/internal/chatroom/repository"
 	"github.com/MingPV/ChatService/internal/entities"
 	friendRepo "github.com/MingPV/ChatService/internal/friend/repository"
+	messageUseCase "github.com/MingPV/ChatService/internal/message/usecase"
 	roommemberRepo "github.com/MingPV/ChatService/internal/room_member/repository"
+	"github.com/MingPV/ChatService/pkg/apperror"
+	"github.com/MingPV/ChatService/pkg/pagination"
+	"github.com/MingPV/ChatService/pkg/profile"
 	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/mongo"
 )
 
 
 type FriendService struct {
 	friendRepo friendRepo.FriendRepository
+	blockRepo friendRepo.BlockRepository
 	chatroomRepo chatroomRepo.ChatroomRepository
 	roommemberRepo roommemberRepo.RoomMemberRepository
+	msgUseCase messageUseCase.MessageUseCase
+	profiles profile.Provider
 }
 
-func NewFriendService(friendRepo friendRepo.FriendRepository, chatroomRepo chatroomRepo.ChatroomRepository, roommemberRepo roommemberRepo.RoomMemberRepository) FriendUseCase {
-	return &FriendService{friendRepo: friendRepo, chatroomRepo: chatroomRepo, roommemberRepo: roommemberRepo}
+func NewFriendService(friendRepo friendRepo.FriendRepository, blockRepo friendRepo.BlockRepository, chatroomRepo chatroomRepo.ChatroomRepository, roommemberRepo roommemberRepo.RoomMemberRepository, msgUseCase messageUseCase.MessageUseCase, profiles profile.Provider) FriendUseCase {
+	return &FriendService{friendRepo: friendRepo, blockRepo: blockRepo, chatroomRepo: chatroomRepo, roommemberRepo: roommemberRepo, msgUseCase: msgUseCase, profiles: profiles}
 }
 
 func (s *FriendService)	CreateFriend(friend *entities.Friend) error {
-	if err := s.friendRepo.Save(friend); err != nil {
-		return err
+	if friend.UserID == friend.FriendID {
+		return apperror.ErrInvalidData
 	}
-	fmt.Println("create at this")
-	//create chatroom between the two users
-	chatroom := &entities.Chatroom{
-		RoomName: fmt.Sprintf("room_%s_%s", friend.UserID, friend.FriendID),
-		IsGroup: false,
+	blocked, err := s.blockRepo.ExistsBetween(friend.UserID, friend.FriendID)
+	if err != nil {
+		return err
 	}
-	
-	if err := s.chatroomRepo.Save(chatroom); err != nil {
-		return nil
+	if blocked {
+		return apperror.ErrForbidden
 	}
 
-	userIDs := []uuid.UUID{ friend.UserID, friend.FriendID}
-	if err := s.roommemberRepo.Save(chatroom.ID, userIDs); err != nil {
+	existing, err := s.friendRepo.FindActiveBetween(friend.UserID, friend.FriendID)
+	switch {
+	case err == nil && existing.Status == entities.FriendStatusPending && existing.UserID == friend.FriendID:
+		// they already asked us, so asking back is the same as accepting
+		accepted, err := s.accept(int(existing.ID))
+		if err != nil {
+			return err
+		}
+		*friend = *accepted
 		return nil
+	case err == nil:
+		return apperror.ErrAlreadyExists
+	case !errors.Is(err, mongo.ErrNoDocuments):
+		return err
 	}
 
-	return nil
+	now := time.Now().UTC()
+	friend.Status = entities.FriendStatusPending
+	friend.CreatedAt = now
+	friend.UpdatedAt = now
+	return s.friendRepo.Save(friend)
 }
 
 func (s *FriendService)	FindAllFriends() ([]*entities.Friend, error) {
@@ -64,6 +87,13 @@ func (s *FriendService)	FindAllFriendsByIsFriend(userId uuid.UUID) ([]*entities.
 	if err != nil {
 		return nil, err
 	}
+	for _, f := range orders {
+		other := f.FriendID
+		if other == userId {
+			other = f.UserID
+		}
+		f.Online = s.isVisiblyOnline(userId, other)
+	}
 	return orders, nil
 }
 
@@ -76,12 +106,28 @@ func (s *FriendService)	FindAllFriendsRequests(userId uuid.UUID) ([]*entities.Fr
 }
 
 func (s *FriendService) IsMyfriend(userId uuid.UUID, friendId uuid.UUID) (*entities.Friend, error) {
+	// only the blocker learns about the block; the blocked user just sees a stranger
+	blocked, err := s.blockRepo.Exists(userId, friendId)
+	if err != nil {
+		return &entities.Friend{
+				Status: entities.FriendStatusNone,
+			}, err
+	}
+	if blocked {
+		return &entities.Friend{
+				UserID: userId,
+				FriendID: friendId,
+				Status: entities.FriendStatusBlocked,
+			}, nil
+	}
+
 	friend, err := s.friendRepo.IsMyfriend(userId, friendId)
 	if err != nil {
 		return &entities.Friend{
-				Status: "not friend",
+				Status: entities.FriendStatusNone,
 			}, err
 	}
+	friend.Online = s.isVisiblyOnline(userId, friendId)
 	return friend, nil
 }
 
@@ -101,31 +147,219 @@ func (s *FriendService) FindFriendByID(id int) (*entities.Friend, error) {
 	return friend, nil
 }
 
-func (s *FriendService) AcceptFriend(id int) (*entities.Friend, error) {
-	//add friend
-	friend, err := s.friendRepo.Update(id)
+// AcceptFriend accepts a pending request; only its recipient may do so
+func (s *FriendService) AcceptFriend(id int, userId uuid.UUID) (*entities.Friend, error) {
+	return s.respond(id, userId, true, entities.FriendStatusAccepted)
+}
+
+// RejectFriend declines a pending request; only its recipient may do so
+func (s *FriendService) RejectFriend(id int, userId uuid.UUID) (*entities.Friend, error) {
+	return s.respond(id, userId, true, entities.FriendStatusRejected)
+}
+
+// CancelFriend withdraws a pending request; only its sender may do so
+func (s *FriendService) CancelFriend(id int, userId uuid.UUID) (*entities.Friend, error) {
+	return s.respond(id, userId, false, entities.FriendStatusCancelled)
+}
+
+// respond moves a pending request to status on behalf of its recipient or sender
+func (s *FriendService) respond(id int, userId uuid.UUID, byRecipient bool, status entities.FriendStatus) (*entities.Friend, error) {
+	request, err := s.friendRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+	actor := request.UserID
+	if byRecipient {
+		actor = request.FriendID
+	}
+	if actor != userId {
+		return nil, apperror.ErrForbidden
+	}
+	if request.Status != entities.FriendStatusPending {
+		return nil, apperror.ErrNotAvailable
+	}
+
+	if status == entities.FriendStatusAccepted {
+		return s.accept(id)
+	}
+	friend, err := s.friendRepo.UpdateStatus(id, entities.FriendStatusPending, status)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		// decided concurrently
+		return nil, apperror.ErrNotAvailable
+	}
 	if err != nil {
 		return nil, err
 	}
+	return friend, nil
+}
 
-	// ch, err := s.friendRepo.FindByID(id)
+// accept turns a pending request into a friendship and makes sure the pair has a 1:1 room.
+// If the room cannot be set up the request goes back to pending.
+func (s *FriendService) accept(id int) (*entities.Friend, error) {
+	friend, err := s.friendRepo.UpdateStatus(id, entities.FriendStatusPending, entities.FriendStatusAccepted)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, apperror.ErrNotAvailable
+	}
+	if err != nil {
+		return nil, err
+	}
 
-	// //create chatroom between the two users
-	// chatroom := &entities.Chatroom{
-	// 	RoomName: fmt.Sprintf("room_%s_%s", ch.UserID, ch.FriendID),
-	// 	IsGroup: false,
-	// }
-	
-	// if err := s.chatroomRepo.Save(chatroom); err != nil {
-	// 	return nil, err
-	// }
+	if _, err := s.ensureDirectRoom(friend.UserID, friend.FriendID); err != nil {
+		if _, rerr := s.friendRepo.UpdateStatus(id, entities.FriendStatusAccepted, entities.FriendStatusPending); rerr != nil {
+			log.Printf("failed to restore friend request %d to pending: %v", id, rerr)
+		}
+		return nil, err
+	}
+	return friend, nil
+}
 
-	// userIDs := []uuid.UUID{ch.UserID, ch.FriendID}
-	// if err := s.roommemberRepo.Save(chatroom.ID, userIDs); err != nil {
-	// 	return nil, err
-	// }
+// ensureDirectRoom returns the pair's 1:1 room, creating it and both memberships on first use.
+// A half-created room is removed again so the next attempt starts clean.
+func (s *FriendService) ensureDirectRoom(a uuid.UUID, b uuid.UUID) (*entities.Chatroom, error) {
+	room, err := s.chatroomRepo.FindDirect(a, b)
+	if err == nil {
+		return room, nil
+	}
+	if !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, err
+	}
 
-	//create room member
+	now := time.Now().UTC()
+	// no stored name: each side sees the other participant, see Chatroom.DirectPeer
+	room = &entities.Chatroom{
+		IsGroup: false,
+		DirectKey: entities.DirectRoomKey(a, b),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := s.chatroomRepo.Save(room); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			// created concurrently by the other side
+			return s.chatroomRepo.FindDirect(a, b)
+		}
+		return nil, err
+	}
 
-	return friend, nil
-}
\ No newline at end of file
+	if err := s.roommemberRepo.Save(room.ID, []uuid.UUID{a, b}); err != nil {
+		if derr := s.roommemberRepo.DeleteAllByRoomID(int(room.ID)); derr != nil {
+			log.Printf("failed to remove members of direct room %d: %v", room.ID, derr)
+		}
+		if derr := s.chatroomRepo.Delete(int(room.ID)); derr != nil {
+			log.Printf("failed to remove direct room %d: %v", room.ID, derr)
+		}
+		return nil, err
+	}
+	return room, nil
+}
+
+// FindDirectRoom returns the 1:1 room shared by userId and friendId
+func (s *FriendService) FindDirectRoom(userId uuid.UUID, friendId uuid.UUID) (*entities.Chatroom, error) {
+	if userId == friendId {
+		return nil, apperror.ErrInvalidData
+	}
+	room, err := s.chatroomRepo.FindDirect(userId, friendId)
+	if err != nil {
+		return nil, err
+	}
+
+	p := profile.Fallback(friendId)
+	profiles, err := s.profiles.FindProfiles(context.Background(), []uuid.UUID{friendId})
+	if err != nil {
+		log.Printf("failed to load profile of %s: %v", friendId, err)
+	} else if found, ok := profiles[friendId]; ok {
+		p = found
+	}
+	room.DisplayName = p.DisplayName
+	room.DisplayAvatarURL = p.AvatarURL
+	return room, nil
+}
+
+// FindFriendSuggestions ranks people userId may know by mutual friends, then shared group rooms.
+// Friends, pending requests in either direction and blocked users are left out.
+func (s *FriendService) FindFriendSuggestions(userId uuid.UUID, page, pageSize int) ([]*entities.FriendSuggestion, int64, error) {
+	offset, limit := pagination.OffsetLimit(page, pageSize)
+
+	records, err := s.friendRepo.FindAllByUserId(userId)
+	if err != nil {
+		return nil, 0, err
+	}
+	friendIds := []uuid.UUID{}
+	exclude := []uuid.UUID{userId}
+	for _, f := range records {
+		other := f.FriendID
+		if other == userId {
+			other = f.UserID
+		}
+		switch f.Status {
+		case entities.FriendStatusAccepted:
+			friendIds = append(friendIds, other)
+			exclude = append(exclude, other)
+		case entities.FriendStatusPending:
+			exclude = append(exclude, other)
+		}
+	}
+
+	blocked, err := s.blockRepo.FindAllRelatedIDs(userId)
+	if err != nil {
+		return nil, 0, err
+	}
+	exclude = append(exclude, blocked...)
+
+	memberships, err := s.roommemberRepo.FindAllByUserID(userId)
+	if err != nil {
+		return nil, 0, err
+	}
+	roomIds := []uint{}
+	for _, m := range memberships {
+		if m.Chatroom.IsGroup {
+			roomIds = append(roomIds, m.RoomId)
+		}
+	}
+
+	return s.friendRepo.FindSuggestions(friendIds, roomIds, exclude, offset, limit)
+}
+
+func (s *FriendService) BlockUser(userId uuid.UUID, blockedId uuid.UUID) (*entities.Block, error) {
+	if userId == blockedId {
+		return nil, apperror.ErrInvalidData
+	}
+	exists, err := s.blockRepo.Exists(userId, blockedId)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, apperror.ErrAlreadyExists
+	}
+
+	block := &entities.Block{
+		UserID: userId,
+		BlockedID: blockedId,
+		CreatedAt: time.Now().UTC(),
+	}
+	if err := s.blockRepo.Save(block); err != nil {
+		return nil, err
+	}
+
+	// a block ends the friendship and drops pending requests in both directions
+	if err := s.friendRepo.DeleteBetween(userId, blockedId); err != nil {
+		return nil, err
+	}
+	return block, nil
+}
+
+func (s *FriendService) UnblockUser(userId uuid.UUID, blockedId uuid.UUID) error {
+	return s.blockRepo.Delete(userId, blockedId)
+}
+
+func (s *FriendService) FindBlockedUsers(userId uuid.UUID) ([]*entities.Block, error) {
+	return s.blockRepo.FindAllByUserID(userId)
+}
+
+// isVisiblyOnline reports other's presence as seen by viewer; blocks hide it both ways
+func (s *FriendService) isVisiblyOnline(viewer uuid.UUID, other uuid.UUID) bool {
+	blocked, err := s.blockRepo.ExistsBetween(viewer, other)
+	if err != nil || blocked {
+		return false
+	}
+	return s.msgUseCase.IsOnline(other)
+}
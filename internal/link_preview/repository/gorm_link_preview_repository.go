@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/MingPV/ChatService/internal/entities"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type MongoLinkPreviewRepository struct {
+	db   *mongo.Database
+	coll *mongo.Collection
+}
+
+func NewMongoLinkPreviewRepository(db *mongo.Database) LinkPreviewRepository {
+	return &MongoLinkPreviewRepository{
+		db:   db,
+		coll: db.Collection("link_previews"),
+	}
+}
+
+func (r *MongoLinkPreviewRepository) FindByURL(url string) (*entities.LinkPreview, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var preview entities.LinkPreview
+	err := r.coll.FindOne(ctx, bson.M{"_id": url}).Decode(&preview)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return &entities.LinkPreview{}, err
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &preview, nil
+}
+
+// Save inserts or refreshes the cached preview for preview.URL
+func (r *MongoLinkPreviewRepository) Save(preview *entities.LinkPreview) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := r.coll.ReplaceOne(ctx, bson.M{"_id": preview.URL}, preview, options.Replace().SetUpsert(true))
+	return err
+}
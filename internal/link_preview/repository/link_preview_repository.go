@@ -0,0 +1,11 @@
+package repository
+
+import (
+	"github.com/MingPV/ChatService/internal/entities"
+)
+
+// LinkPreviewRepository caches unfurled link metadata by URL
+type LinkPreviewRepository interface {
+	FindByURL(url string) (*entities.LinkPreview, error)
+	Save(preview *entities.LinkPreview) error
+}
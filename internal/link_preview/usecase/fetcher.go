@@ -0,0 +1,190 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/MingPV/ChatService/internal/entities"
+	"golang.org/x/net/html"
+)
+
+// maxPageSize bounds how much of a page is read when looking for metadata
+const maxPageSize = 1 << 20
+
+var ErrBlockedAddress = errors.New("address is not publicly routable")
+
+// Fetcher loads preview metadata for a single URL.
+type Fetcher interface {
+	Fetch(ctx context.Context, rawURL string) (*entities.LinkPreview, error)
+}
+
+// HTTPFetcher reads Open Graph and basic HTML metadata over HTTP.
+type HTTPFetcher struct {
+	client *http.Client
+}
+
+// NewHTTPFetcher uses client for every request. Production code should pass
+// NewSafeHTTPClient; tests may pass a plain client to reach a local server.
+func NewHTTPFetcher(client *http.Client) Fetcher {
+	return &HTTPFetcher{client: client}
+}
+
+// NewSafeHTTPClient returns a client that refuses to connect to loopback,
+// private, link-local and other non-public addresses. The check runs on the
+// resolved address of every connection, so redirects and DNS rebinding are
+// covered as well.
+func NewSafeHTTPClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || !isPublicIP(ip) {
+				return fmt.Errorf("%w: %s", ErrBlockedAddress, host)
+			}
+			return nil
+		},
+	}
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			Proxy:               nil,
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: timeout,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= 3 {
+				return errors.New("too many redirects")
+			}
+			return nil
+		},
+	}
+}
+
+func (f *HTTPFetcher) Fetch(ctx context.Context, rawURL string) (*entities.LinkPreview, error) {
+	pageURL, err := url.Parse(rawURL)
+	if err != nil || (pageURL.Scheme != "http" && pageURL.Scheme != "https") || pageURL.Host == "" {
+		return nil, fmt.Errorf("unsupported url %q", rawURL)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, pageURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", "ChatServiceBot/1.0 (+link preview)")
+	req.Header.Set("Accept", "text/html")
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	if mt, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type")); mt != "text/html" {
+		return nil, fmt.Errorf("unsupported content type %q", mt)
+	}
+
+	preview := parseMetadata(io.LimitReader(resp.Body, maxPageSize), resp.Request.URL)
+	preview.URL = rawURL
+	preview.FetchedAt = time.Now().UTC()
+	return preview, nil
+}
+
+// parseMetadata reads <head> metadata, preferring Open Graph over plain tags.
+func parseMetadata(r io.Reader, base *url.URL) *entities.LinkPreview {
+	preview := &entities.LinkPreview{}
+	var title, description string
+
+	z := html.NewTokenizer(r)
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			return finishPreview(preview, title, description, base)
+		case html.StartTagToken, html.SelfClosingTagToken:
+			tok := z.Token()
+			switch tok.Data {
+			case "title":
+				if z.Next() == html.TextToken {
+					title = strings.TrimSpace(z.Token().Data)
+				}
+			case "meta":
+				key, content := metaAttrs(tok)
+				switch key {
+				case "og:title":
+					preview.Title = content
+				case "og:description":
+					preview.Description = content
+				case "og:image", "og:image:url":
+					if preview.ImageURL == "" {
+						preview.ImageURL = content
+					}
+				case "og:site_name":
+					preview.SiteName = content
+				case "description":
+					description = content
+				}
+			}
+		case html.EndTagToken:
+			// metadata lives in <head>, stop before downloading the whole body
+			if tok := z.Token(); tok.Data == "head" {
+				return finishPreview(preview, title, description, base)
+			}
+		}
+	}
+}
+
+func metaAttrs(tok html.Token) (key, content string) {
+	for _, a := range tok.Attr {
+		switch a.Key {
+		case "property", "name":
+			key = strings.ToLower(a.Val)
+		case "content":
+			content = strings.TrimSpace(a.Val)
+		}
+	}
+	return key, content
+}
+
+func finishPreview(preview *entities.LinkPreview, title, description string, base *url.URL) *entities.LinkPreview {
+	if preview.Title == "" {
+		preview.Title = title
+	}
+	if preview.Description == "" {
+		preview.Description = description
+	}
+	if preview.ImageURL != "" {
+		if ref, err := url.Parse(preview.ImageURL); err == nil {
+			preview.ImageURL = base.ResolveReference(ref).String()
+		}
+	}
+	if preview.SiteName == "" {
+		preview.SiteName = base.Hostname()
+	}
+	return preview
+}
+
+func isPublicIP(ip net.IP) bool {
+	return !(ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() || ip.IsMulticast() ||
+		isSharedAddress(ip))
+}
+
+// isSharedAddress matches 100.64.0.0/10 (carrier-grade NAT), which IsPrivate does not cover.
+func isSharedAddress(ip net.IP) bool {
+	ip4 := ip.To4()
+	return ip4 != nil && ip4[0] == 100 && ip4[1]&0xc0 == 64
+}
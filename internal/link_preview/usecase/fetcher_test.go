@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testPage = `<html><head>
+<title>Fallback title</title>
+<meta property="og:title" content="Release notes">
+<meta property="og:image" content="/img/cover.png">
+<meta name="description" content="What changed this week">
+</head><body>ignored</body></html>`
+
+func TestHTTPFetcherReadsOpenGraph(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_, _ = w.Write([]byte(testPage))
+	}))
+	defer srv.Close()
+
+	preview, err := NewHTTPFetcher(srv.Client()).Fetch(context.Background(), srv.URL+"/post")
+	assert.NoError(t, err)
+	assert.Equal(t, "Release notes", preview.Title)
+	assert.Equal(t, "What changed this week", preview.Description)
+	assert.Equal(t, srv.URL+"/img/cover.png", preview.ImageURL)
+	assert.Equal(t, srv.URL+"/post", preview.URL)
+}
+
+func TestHTTPFetcherRejectsNonHTML(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	_, err := NewHTTPFetcher(srv.Client()).Fetch(context.Background(), srv.URL)
+	assert.Error(t, err)
+}
+
+func TestSafeHTTPClientBlocksPrivateAddresses(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request reached a loopback server")
+	}))
+	defer srv.Close()
+
+	_, err := NewHTTPFetcher(NewSafeHTTPClient(time.Second)).Fetch(context.Background(), srv.URL)
+	assert.True(t, errors.Is(err, ErrBlockedAddress), "got %v", err)
+}
+
+func TestExtractURLs(t *testing.T) {
+	urls := ExtractURLs("see https://example.com/a, and (http://example.org/b). again https://example.com/a")
+	assert.Equal(t, []string{"https://example.com/a", "http://example.org/b"}, urls)
+}
@@ -0,0 +1,16 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/MingPV/ChatService/internal/entities"
+)
+
+type LinkPreviewUseCase interface {
+	// Unfurl returns the preview for rawURL, served from cache while fresh.
+	Unfurl(ctx context.Context, rawURL string) (*entities.LinkPreview, error)
+	// Process queues link unfurling for a newly created message.
+	Process(message *entities.Message)
+	// Start runs n background workers until ctx is cancelled.
+	Start(ctx context.Context, n int)
+}
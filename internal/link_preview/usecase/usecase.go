@@ -0,0 +1,131 @@
+package usecase
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/MingPV/ChatService/internal/entities"
+	linkPreviewRepo "github.com/MingPV/ChatService/internal/link_preview/repository"
+	messageRepo "github.com/MingPV/ChatService/internal/message/repository"
+	messageUseCase "github.com/MingPV/ChatService/internal/message/usecase"
+)
+
+// maxLinksPerMessage limits how many URLs of a single message are unfurled
+const maxLinksPerMessage = 3
+
+var urlPattern = regexp.MustCompile(`https?://[^\s<>"']+`)
+
+// LinkPreviewService implements LinkPreviewUseCase and messageUseCase.MessageProcessor
+type LinkPreviewService struct {
+	repo           linkPreviewRepo.LinkPreviewRepository
+	messageRepo    messageRepo.MessageRepository
+	messageUseCase messageUseCase.MessageUseCase
+	fetcher        Fetcher
+	cacheTTL       time.Duration
+	queue          chan *entities.Message
+}
+
+func NewLinkPreviewService(repo linkPreviewRepo.LinkPreviewRepository, messageRepo messageRepo.MessageRepository, messageUseCase messageUseCase.MessageUseCase, fetcher Fetcher, cacheTTL time.Duration) LinkPreviewUseCase {
+	return &LinkPreviewService{
+		repo:           repo,
+		messageRepo:    messageRepo,
+		messageUseCase: messageUseCase,
+		fetcher:        fetcher,
+		cacheTTL:       cacheTTL,
+		queue:          make(chan *entities.Message, 100),
+	}
+}
+
+func (s *LinkPreviewService) Unfurl(ctx context.Context, rawURL string) (*entities.LinkPreview, error) {
+	if cached, err := s.repo.FindByURL(rawURL); err == nil && time.Since(cached.FetchedAt) < s.cacheTTL {
+		return cached, nil
+	}
+
+	preview, err := s.fetcher.Fetch(ctx, rawURL)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.Save(preview); err != nil {
+		log.Printf("failed to cache link preview for %s: %v", rawURL, err)
+	}
+	return preview, nil
+}
+
+func (s *LinkPreviewService) Process(message *entities.Message) {
+	if len(ExtractURLs(message.Message)) == 0 {
+		return
+	}
+	select {
+	case s.queue <- message:
+	default:
+		log.Printf("link preview queue full, skipping message %d", message.ID)
+	}
+}
+
+func (s *LinkPreviewService) Start(ctx context.Context, n int) {
+	for i := 0; i < n; i++ {
+		go func() {
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case message := <-s.queue:
+					if err := s.attachPreviews(ctx, message); err != nil {
+						log.Printf("link previews for message %d failed: %v", message.ID, err)
+					}
+				}
+			}
+		}()
+	}
+}
+
+func (s *LinkPreviewService) attachPreviews(ctx context.Context, message *entities.Message) error {
+	var previews []entities.LinkPreview
+	for _, u := range ExtractURLs(message.Message) {
+		fetchCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		preview, err := s.Unfurl(fetchCtx, u)
+		cancel()
+		if err != nil {
+			log.Printf("skipping link preview for %s: %v", u, err)
+			continue
+		}
+		if preview.Title == "" && preview.Description == "" && preview.ImageURL == "" {
+			continue
+		}
+		previews = append(previews, *preview)
+	}
+	if len(previews) == 0 {
+		return nil
+	}
+
+	if err := s.messageRepo.UpdateLinkPreviews(message.ID, previews); err != nil {
+		return err
+	}
+	updated, err := s.messageRepo.FindByID(int(message.ID))
+	if err != nil {
+		return err
+	}
+	s.messageUseCase.PublishRoomEvent(&entities.RoomEvent{Type: entities.RoomEventMessageUpdated, RoomId: updated.RoomId, Message: updated})
+	return nil
+}
+
+// ExtractURLs returns the distinct http(s) URLs in text, in order of appearance.
+func ExtractURLs(text string) []string {
+	var urls []string
+	seen := map[string]bool{}
+	for _, match := range urlPattern.FindAllString(text, -1) {
+		u := strings.TrimRight(match, ".,;:!?)]}")
+		if seen[u] {
+			continue
+		}
+		seen[u] = true
+		urls = append(urls, u)
+		if len(urls) == maxLinksPerMessage {
+			break
+		}
+	}
+	return urls
+}
@@ -0,0 +1,27 @@
+package dto
+
+import "github.com/MingPV/ChatService/internal/entities"
+
+func ToMessageResponse(m *entities.Message) MessageResponse {
+	return MessageResponse{
+		ID:          m.ID,
+		RoomId:      m.RoomId,
+		Message:     m.Message,
+		Sender:      m.Sender.String(),
+		Attachments: len(m.Attachments),
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+}
+
+func ToSearchResponse(hits []*entities.MessageSearchHit, total int64, page, pageSize int) *SearchResponse {
+	out := make([]SearchHitResponse, 0, len(hits))
+	for _, h := range hits {
+		out = append(out, SearchHitResponse{
+			Message: ToMessageResponse(h.Message),
+			Score:   h.Score,
+			Snippet: h.Snippet,
+		})
+	}
+	return &SearchResponse{Hits: out, Total: total, Page: page, PageSize: pageSize}
+}
@@ -0,0 +1,26 @@
+package dto
+
+import "time"
+
+type MessageResponse struct {
+	ID          uint      `json:"id"`
+	RoomId      uint      `json:"room_id"`
+	Message     string    `json:"message"`
+	Sender      string    `json:"sender"`
+	Attachments int       `json:"attachments"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type SearchHitResponse struct {
+	Message MessageResponse `json:"message"`
+	Score   float64         `json:"score"`
+	Snippet string          `json:"snippet"`
+}
+
+type SearchResponse struct {
+	Hits     []SearchHitResponse `json:"hits"`
+	Total    int64               `json:"total"`
+	Page     int                 `json:"page"`
+	PageSize int                 `json:"page_size"`
+}
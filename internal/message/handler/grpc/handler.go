@@ -3,6 +3,7 @@ package grpc
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
@@ -101,13 +102,17 @@ func NewGrpcMessageHandler(uc usecase.MessageUseCase) *GrpcMessageHandler {
 
 func (h *GrpcMessageHandler) Chat(stream messagepb.MessageService_ChatServer) error {
     type roomSub struct {
-        ch      <-chan *entities.Message
+        ch      <-chan *entities.RoomEvent
         cleanup func()
     }
 
     rooms := make(map[int]*roomSub)
+    var userSub *roomSub // events addressed to the user that joined
+    var mu sync.Mutex    // guards rooms and userSub
     recvErr := error(nil)
     done := make(chan struct{})
+    // replies from the reader go through the writer loop; a stream allows only one sender at a time
+    replies := make(chan *messagepb.ServerEvent, 10)
 
     // Reader goroutine
     go func() {
@@ -122,10 +127,18 @@ func (h *GrpcMessageHandler) Chat(stream messagepb.MessageService_ChatServer) er
             switch payload := in.Payload.(type) {
             case *messagepb.ClientEvent_Join:
                 rid := int(payload.Join.RoomId)
+                mu.Lock()
                 if rooms[rid] == nil {
                     ch, cleanup := h.messageUseCase.SubscribeRoom(rid)
                     rooms[rid] = &roomSub{ch: ch, cleanup: cleanup}
                 }
+                if userSub == nil {
+                    if userUUID, err := uuid.Parse(payload.Join.GetUserId()); err == nil {
+                        ch, cleanup := h.messageUseCase.SubscribeUser(userUUID)
+                        userSub = &roomSub{ch: ch, cleanup: cleanup}
+                    }
+                }
+                mu.Unlock()
 
             
 
@@ -137,10 +150,16 @@ func (h *GrpcMessageHandler) Chat(stream messagepb.MessageService_ChatServer) er
                     RoomId:    uint(send.GetRoomId()),
                     Message:   send.GetText(),
                     Sender:    senderUUID,
+                    Attachments: toAttachmentRefs(send.GetAttachmentIds()),
                     CreatedAt: now,
                     UpdatedAt: now,
                 }
-                _ = h.messageUseCase.CreateMessage(m)
+                if err := h.messageUseCase.CreateMessage(m); err != nil {
+                    select {
+                    case replies <- &messagepb.ServerEvent{Payload: &messagepb.ServerEvent_Error{Error: &messagepb.ErrorEvent{Message: err.Error()}}}:
+                    default: // the client is not keeping up; drop the reply like any other event
+                    }
+                }
                 
                 
             }
@@ -152,34 +171,49 @@ func (h *GrpcMessageHandler) Chat(stream messagepb.MessageService_ChatServer) er
         select {
         case <-done:
             // cleanup all
+            mu.Lock()
             for _, sub := range rooms {
                 sub.cleanup()
             }
+            if userSub != nil {
+                userSub.cleanup()
+            }
+            mu.Unlock()
             return recvErr
         default:
+            select {
+            case out := <-replies:
+                _ = stream.Send(out)
+            default:
+            }
+            mu.Lock()
+            if userSub != nil {
+                select {
+                case ev, ok := <-userSub.ch:
+                    if ok {
+                        if out := toServerEvent(ev); out != nil {
+                            _ = stream.Send(out)
+                        }
+                    }
+                default:
+                }
+            }
             for rid, sub := range rooms {
                 select {
-                case m, ok := <-sub.ch:
+                case ev, ok := <-sub.ch:
                     if !ok {
                         sub.cleanup()
                         delete(rooms, rid)
                         continue
                     }
-                    _ = stream.Send(&messagepb.ServerEvent{
-                        Payload: &messagepb.ServerEvent_Delivered{
-                            Delivered: &messagepb.MessageDelivered{
-                                Id:            uint32(m.ID),
-                                RoomId:        uint32(m.RoomId),
-                                Text:          m.Message,
-                                SenderId:      m.Sender.String(),
-                                CreatedAtUnix: m.CreatedAt.Unix(),
-                            },
-                        },
-                    })
+                    if out := toServerEvent(ev); out != nil {
+                        _ = stream.Send(out)
+                    }
                 default:
                     // no message for this room, continue
                 }
             }
+            mu.Unlock()
             time.Sleep(50 * time.Millisecond)
         }
     }
@@ -229,15 +263,358 @@ func (h *GrpcMessageHandler) FindAllMessageUnread(ctx context.Context, req *mess
 
 }
 
+func (h *GrpcMessageHandler) FindAllMentions(ctx context.Context, req *messagepb.FindAllMentionsRequest) (*messagepb.FindAllMentionsResponse, error) {
+    userUUID, err := uuid.Parse(req.UserId)
+    if err != nil {
+        return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+    }
+    messages, total, err := h.messageUseCase.FindAllMentions(userUUID, int(req.Page), int(req.PageSize))
+    if err != nil {
+        return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+    }
+
+    var protoMessages []*messagepb.Message
+    for _, m := range messages {
+        protoMessages = append(protoMessages, toProtoMessage(m))
+    }
+
+    return &messagepb.FindAllMentionsResponse{Messages: protoMessages, Total: total}, nil
+}
+
+func (h *GrpcMessageHandler) FindUnreadCounts(ctx context.Context, req *messagepb.FindUnreadCountsRequest) (*messagepb.FindUnreadCountsResponse, error) {
+    userUUID, err := uuid.Parse(req.UserId)
+    if err != nil {
+        return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+    }
+    counts, err := h.messageUseCase.FindUnreadCounts(userUUID)
+    if err != nil {
+        return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+    }
+
+    var protoCounts []*messagepb.UnreadCount
+    for _, c := range counts {
+        protoCounts = append(protoCounts, &messagepb.UnreadCount{
+            RoomId:       int32(c.RoomId),
+            UnreadCount:  c.Unread,
+            MentionCount: c.Mentions,
+        })
+    }
+
+    return &messagepb.FindUnreadCountsResponse{Counts: protoCounts}, nil
+}
+
+func (h *GrpcMessageHandler) SearchMessages(ctx context.Context, req *messagepb.SearchMessagesRequest) (*messagepb.SearchMessagesResponse, error) {
+    userUUID, err := uuid.Parse(req.UserId)
+    if err != nil {
+        return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+    }
+    query := &entities.MessageSearchQuery{
+        Text:          req.Query,
+        RoomId:        uint(req.RoomId),
+        HasAttachment: req.HasAttachment,
+        Sort:          entities.MessageSearchSort(req.Sort),
+        Page:          int(req.Page),
+        PageSize:      int(req.PageSize),
+    }
+    if req.SenderId != "" {
+        if query.Sender, err = uuid.Parse(req.SenderId); err != nil {
+            return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+        }
+    }
+    if req.From != nil {
+        query.From = req.From.AsTime()
+    }
+    if req.To != nil {
+        query.To = req.To.AsTime()
+    }
+
+    hits, total, err := h.messageUseCase.SearchMessages(userUUID, query)
+    if err != nil {
+        return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+    }
+
+    var protoHits []*messagepb.SearchHit
+    for _, hit := range hits {
+        protoHits = append(protoHits, &messagepb.SearchHit{
+            Message: toProtoMessage(hit.Message),
+            Score:   hit.Score,
+            Snippet: hit.Snippet,
+        })
+    }
+
+    return &messagepb.SearchMessagesResponse{Hits: protoHits, Total: total}, nil
+}
+
+func (h *GrpcMessageHandler) PinMessage(ctx context.Context, req *messagepb.PinMessageRequest) (*messagepb.PinMessageResponse, error) {
+    userUUID, err := uuid.Parse(req.UserId)
+    if err != nil {
+        return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+    }
+    message, err := h.messageUseCase.PinMessage(int(req.MessageId), userUUID)
+    if err != nil {
+        return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+    }
+    return &messagepb.PinMessageResponse{Message: toProtoMessage(message)}, nil
+}
+
+func (h *GrpcMessageHandler) UnpinMessage(ctx context.Context, req *messagepb.UnpinMessageRequest) (*messagepb.UnpinMessageResponse, error) {
+    userUUID, err := uuid.Parse(req.UserId)
+    if err != nil {
+        return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+    }
+    message, err := h.messageUseCase.UnpinMessage(int(req.MessageId), userUUID)
+    if err != nil {
+        return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+    }
+    return &messagepb.UnpinMessageResponse{Message: toProtoMessage(message)}, nil
+}
+
+func (h *GrpcMessageHandler) FindPinnedMessages(ctx context.Context, req *messagepb.FindPinnedMessagesRequest) (*messagepb.FindPinnedMessagesResponse, error) {
+    userUUID, err := uuid.Parse(req.UserId)
+    if err != nil {
+        return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+    }
+    messages, err := h.messageUseCase.FindPinnedMessages(uint(req.RoomId), userUUID)
+    if err != nil {
+        return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+    }
+
+    var protoMessages []*messagepb.Message
+    for _, m := range messages {
+        protoMessages = append(protoMessages, toProtoMessage(m))
+    }
+    return &messagepb.FindPinnedMessagesResponse{Messages: protoMessages}, nil
+}
+
 func toProtoMessage(m *entities.Message) *messagepb.Message {
-    return &messagepb.Message{
+    out := &messagepb.Message{
         Id: int32(m.ID),
         RoomId: int32(m.RoomId),
         Sender: m.Sender.String(),
         Message: m.Message,
         CreatedAt: timestamppb.New(m.CreatedAt),
 		UpdatedAt: timestamppb.New(m.CreatedAt),
+        Attachments: toProtoAttachments(m.Attachments),
+        LinkPreviews: toProtoLinkPreviews(m.LinkPreviews),
+        Mentions: toProtoMentions(m.Mentions),
+        MentionRoom: m.MentionRoom,
+        MentionHere: m.MentionHere,
+        PollId: int32(m.PollId),
+    }
+    if m.ExpiresAt != nil {
+        out.ExpiresAt = timestamppb.New(*m.ExpiresAt)
+    }
+    out.System = toProtoSystemEvent(m.System)
+    if m.Pin != nil {
+        out.Pinned = true
+        out.PinnedBy = m.Pin.PinnedBy.String()
+        out.PinnedAt = timestamppb.New(m.Pin.PinnedAt)
+    }
+    return out
+}
+
+func toProtoSystemEvent(e *entities.SystemEvent) *messagepb.SystemEvent {
+    if e == nil {
+        return nil
+    }
+    out := &messagepb.SystemEvent{
+        Type:   string(e.Type),
+        Actor:  e.Actor.String(),
+        Target: e.Target.String(),
+    }
+    if e.Until != nil {
+        out.Until = timestamppb.New(*e.Until)
+    }
+    return out
+}
+
+func toProtoMentions(ids []uuid.UUID) []string {
+    var out []string
+    for _, id := range ids {
+        out = append(out, id.String())
+    }
+    return out
+}
+
+func toProtoLinkPreviews(previews []entities.LinkPreview) []*messagepb.LinkPreview {
+    var out []*messagepb.LinkPreview
+    for _, p := range previews {
+        out = append(out, &messagepb.LinkPreview{
+            Url:         p.URL,
+            Title:       p.Title,
+            Description: p.Description,
+            ImageUrl:    p.ImageURL,
+            SiteName:    p.SiteName,
+        })
+    }
+    return out
+}
+
+// toServerEvent maps a room event to the stream payload; unknown events are skipped
+func toServerEvent(ev *entities.RoomEvent) *messagepb.ServerEvent {
+    switch ev.Type {
+    case entities.RoomEventMessageCreated:
+        m := ev.Message
+        delivered := &messagepb.MessageDelivered{
+            Id:            uint32(m.ID),
+            RoomId:        uint32(m.RoomId),
+            Text:          m.Message,
+            SenderId:      m.Sender.String(),
+            CreatedAtUnix: m.CreatedAt.Unix(),
+            Attachments:   toProtoAttachments(m.Attachments),
+            System:        toProtoSystemEvent(m.System),
+            PollId:        int32(m.PollId),
+            Mentions:      toProtoMentions(m.Mentions),
+            MentionRoom:   m.MentionRoom,
+            MentionHere:   m.MentionHere,
+        }
+        if m.ExpiresAt != nil {
+            delivered.ExpiresAtUnix = m.ExpiresAt.Unix()
+        }
+        return &messagepb.ServerEvent{
+            Payload: &messagepb.ServerEvent_Delivered{Delivered: delivered},
+        }
+    case entities.RoomEventMessageUpdated:
+        return &messagepb.ServerEvent{
+            Payload: &messagepb.ServerEvent_Updated{
+                Updated: &messagepb.MessageUpdated{Message: toProtoMessage(ev.Message)},
+            },
+        }
+    case entities.RoomEventMentioned:
+        return &messagepb.ServerEvent{
+            Payload: &messagepb.ServerEvent_Mention{
+                Mention: &messagepb.MentionNotification{Message: toProtoMessage(ev.Message)},
+            },
+        }
+    case entities.RoomEventMessagePinned, entities.RoomEventMessageUnpinned:
+        return &messagepb.ServerEvent{
+            Payload: &messagepb.ServerEvent_Pin{
+                Pin: &messagepb.PinChanged{Message: toProtoMessage(ev.Message)},
+            },
+        }
+    case entities.RoomEventMessageExpired:
+        return &messagepb.ServerEvent{
+            Payload: &messagepb.ServerEvent_Expired{
+                Expired: &messagepb.MessageExpired{MessageId: int32(ev.Message.ID), RoomId: int32(ev.RoomId)},
+            },
+        }
+    case entities.RoomEventMessageDeleted:
+        return &messagepb.ServerEvent{
+            Payload: &messagepb.ServerEvent_Deleted{
+                Deleted: &messagepb.MessageDeleted{MessageId: int32(ev.Message.ID), RoomId: int32(ev.RoomId)},
+            },
+        }
+    case entities.RoomEventChatroomUpdated:
+        c := ev.Chatroom
+        return &messagepb.ServerEvent{
+            Payload: &messagepb.ServerEvent_Room{
+                Room: &messagepb.ChatroomUpdated{
+                    RoomId: int32(ev.RoomId),
+                    Fields: ev.Fields,
+                    Settings: &messagepb.RoomSettings{
+                        RoomName:      c.RoomName,
+                        Description:   c.Description,
+                        Topic:         c.Topic,
+                        AvatarUrl:     c.AvatarURL,
+                        Visibility:    string(c.Visibility),
+                        JoinPolicy:    string(c.JoinPolicy),
+                        PostingPolicy: string(c.PostingPolicy),
+                        MessageTtl:    int32(c.MessageTTL),
+                    },
+                },
+            },
+        }
+    case entities.RoomEventJoinRequested, entities.RoomEventJoinRequestDecided:
+        r := ev.JoinRequest
+        event := &messagepb.JoinRequestEvent{
+            Id:      int32(r.ID),
+            RoomId:  int32(r.RoomId),
+            UserId:  r.UserId.String(),
+            Message: r.Message,
+            Status:  string(r.Status),
+        }
+        if r.DecidedBy != nil {
+            event.DecidedBy = r.DecidedBy.String()
+        }
+        return &messagepb.ServerEvent{
+            Payload: &messagepb.ServerEvent_JoinRequest{JoinRequest: event},
+        }
+    case entities.RoomEventPollUpdated:
+        return &messagepb.ServerEvent{
+            Payload: &messagepb.ServerEvent_Poll{
+                Poll: &messagepb.PollUpdated{Poll: toProtoPoll(ev.Poll)},
+            },
+        }
+    }
+    return nil
+}
+
+func toProtoPoll(p *entities.Poll) *messagepb.Poll {
+    out := &messagepb.Poll{
+        Id: int32(p.ID),
+        RoomId: int32(p.RoomId),
+        MessageId: int32(p.MessageId),
+        Creator: p.Creator.String(),
+        Question: p.Question,
+        MultipleChoice: p.MultipleChoice,
+        Anonymous: p.Anonymous,
+        TotalVoters: p.TotalVoters,
+    }
+    if p.ClosesAt != nil {
+        out.ClosesAt = timestamppb.New(*p.ClosesAt)
+    }
+    if p.ClosedAt != nil {
+        out.ClosedAt = timestamppb.New(*p.ClosedAt)
+    }
+    for _, o := range p.Options {
+        out.Options = append(out.Options, &messagepb.PollOption{
+            Id:        int32(o.ID),
+            Text:      o.Text,
+            VoteCount: o.VoteCount,
+            Voters:    toProtoMentions(o.Voters),
+        })
+    }
+    return out
+}
+
+func toProtoAttachments(attachments []entities.Attachment) []*messagepb.Attachment {
+    var out []*messagepb.Attachment
+    for _, a := range attachments {
+        out = append(out, &messagepb.Attachment{
+            Id:       int32(a.ID),
+            FileName: a.FileName,
+            MimeType: a.MimeType,
+            Size:     a.Size,
+            Checksum: a.Checksum,
+            Width:    int32(a.Width),
+            Height:   int32(a.Height),
+            Thumbnails:  toProtoThumbnails(a.Thumbnails),
+            Placeholder: a.Placeholder,
+        })
+    }
+    return out
+}
+
+func toProtoThumbnails(thumbnails []entities.Thumbnail) []*messagepb.Thumbnail {
+    var out []*messagepb.Thumbnail
+    for _, t := range thumbnails {
+        out = append(out, &messagepb.Thumbnail{
+            Size:     int32(t.Size),
+            Width:    int32(t.Width),
+            Height:   int32(t.Height),
+            MimeType: t.MimeType,
+        })
+    }
+    return out
+}
+
+// toAttachmentRefs builds placeholder attachments that CreateMessage resolves by id
+func toAttachmentRefs(ids []int32) []entities.Attachment {
+    var out []entities.Attachment
+    for _, id := range ids {
+        out = append(out, entities.Attachment{ID: uint(id)})
     }
+    return out
 }
 
 
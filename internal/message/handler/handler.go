@@ -47,9 +47,10 @@ func (h *WebSocketMessageHandler) SubscribeRoomWebSocket(c *websocket.Conn) {
 
     // Reader goroutine: receive messages from client and persist
     type inbound struct {
-        Message string    `json:"message"`
-        Sender  string    `json:"sender"`
-        SentAt  time.Time `json:"sent_at"`
+        Message       string    `json:"message"`
+        Sender        string    `json:"sender"`
+        SentAt        time.Time `json:"sent_at"`
+        AttachmentIDs []uint    `json:"attachment_ids"`
     }
 
     go func() {
@@ -67,10 +68,15 @@ func (h *WebSocketMessageHandler) SubscribeRoomWebSocket(c *websocket.Conn) {
                 continue
             }
             now := time.Now().UTC()
+            var attachments []entities.Attachment
+            for _, id := range in.AttachmentIDs {
+                attachments = append(attachments, entities.Attachment{ID: id})
+            }
             m := &entities.Message{
                 RoomId:    uint(roomID),
                 Message:   in.Message,
                 Sender:    senderUUID,
+                Attachments: attachments,
                 CreatedAt: now,
                 UpdatedAt: now,
             }
@@ -78,9 +84,9 @@ func (h *WebSocketMessageHandler) SubscribeRoomWebSocket(c *websocket.Conn) {
         }
     }()
 
-    // Writer loop: forward new messages to this client
-    for m := range msgCh {
-        _ = c.WriteJSON(m)
+    // Writer loop: forward room events to this client
+    for ev := range msgCh {
+        _ = c.WriteJSON(ev)
     }
 }
 
@@ -0,0 +1,85 @@
+package rest
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/MingPV/ChatService/internal/entities"
+	"github.com/MingPV/ChatService/internal/message/dto"
+	"github.com/MingPV/ChatService/internal/message/usecase"
+	"github.com/MingPV/ChatService/pkg/apperror"
+	"github.com/MingPV/ChatService/pkg/middleware"
+	"github.com/MingPV/ChatService/pkg/pagination"
+	responses "github.com/MingPV/ChatService/pkg/responses"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type HttpMessageHandler struct {
+	messageUseCase usecase.MessageUseCase
+}
+
+func NewHttpMessageHandler(useCase usecase.MessageUseCase) *HttpMessageHandler {
+	return &HttpMessageHandler{messageUseCase: useCase}
+}
+
+// SearchMessages godoc
+// @Summary Full-text search over messages in the user's rooms
+// @Tags messages
+// @Produce json
+// @Param q query string true "Search text"
+// @Param room_id query int false "Restrict to one room"
+// @Param sender query string false "Restrict to one sender"
+// @Param from query string false "Earliest created_at (RFC3339)"
+// @Param to query string false "Latest created_at (RFC3339)"
+// @Param has_attachment query bool false "Only messages with (true) or without (false) attachments"
+// @Param sort query string false "relevance (default) or recent"
+// @Param page query int false "Page, starting at 1"
+// @Param page_size query int false "Results per page, at most 100"
+// @Success 200 {object} dto.SearchResponse
+// @Router /messages/search [get]
+func (h *HttpMessageHandler) SearchMessages(c *fiber.Ctx) error {
+	userID, err := middleware.UserID(c)
+	if err != nil {
+		return responses.ErrorWithMessage(c, apperror.ErrUnauthorized, "invalid token")
+	}
+
+	query := &entities.MessageSearchQuery{
+		Text:     c.Query("q"),
+		RoomId:   uint(c.QueryInt("room_id")),
+		Sort:     entities.MessageSearchSort(c.Query("sort")),
+		Page:     c.QueryInt("page", 1),
+		PageSize: c.QueryInt("page_size", 20),
+	}
+	if v := c.Query("sender"); v != "" {
+		if query.Sender, err = uuid.Parse(v); err != nil {
+			return responses.ErrorWithMessage(c, apperror.ErrInvalidID, "invalid sender")
+		}
+	}
+	if v := c.Query("from"); v != "" {
+		if query.From, err = time.Parse(time.RFC3339, v); err != nil {
+			return responses.ErrorWithMessage(c, apperror.ErrInvalidFormat, "invalid from")
+		}
+	}
+	if v := c.Query("to"); v != "" {
+		if query.To, err = time.Parse(time.RFC3339, v); err != nil {
+			return responses.ErrorWithMessage(c, apperror.ErrInvalidFormat, "invalid to")
+		}
+	}
+	if v := c.Query("has_attachment"); v != "" {
+		has, err := strconv.ParseBool(v)
+		if err != nil {
+			return responses.ErrorWithMessage(c, apperror.ErrInvalidFormat, "invalid has_attachment")
+		}
+		query.HasAttachment = &has
+	}
+
+	hits, total, err := h.messageUseCase.SearchMessages(userID, query)
+	if err != nil {
+		return responses.Error(c, err)
+	}
+
+	// report the page actually served, not the one asked for
+	offset, limit := pagination.OffsetLimit(query.Page, query.PageSize)
+	return c.JSON(dto.ToSearchResponse(hits, total, offset/limit+1, limit))
+}
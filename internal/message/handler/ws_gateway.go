@@ -29,6 +29,7 @@ func (h *WebSocketGatewayHandler) UpgradeMiddleware(c *fiber.Ctx) error {
 }
 
 // SubscribeRoomWebSocket bridges the WebSocket to the gRPC streaming Chat method.
+// Optional query params: user_id=<uuid> to also receive mention notifications
 func (h *WebSocketGatewayHandler) SubscribeRoomWebSocket(c *websocket.Conn) {
     roomIDStr := c.Params("roomId")
     roomID, err := strconv.Atoi(roomIDStr)
@@ -49,13 +50,14 @@ func (h *WebSocketGatewayHandler) SubscribeRoomWebSocket(c *websocket.Conn) {
     }
 
     // Send JoinRoom first
-    _ = stream.Send(&messagepb.ClientEvent{Payload: &messagepb.ClientEvent_Join{Join: &messagepb.JoinRoom{RoomId: uint32(roomID)}}})
+    _ = stream.Send(&messagepb.ClientEvent{Payload: &messagepb.ClientEvent_Join{Join: &messagepb.JoinRoom{RoomId: uint32(roomID), UserId: c.Query("user_id")}}})
 
     // Reader: WS -> gRPC stream
     type inbound struct {
-        Message string `json:"message"`
-        Sender  string `json:"sender"`
-        SentAt  int64  `json:"sent_at_unix"`
+        Message       string  `json:"message"`
+        Sender        string  `json:"sender"`
+        SentAt        int64   `json:"sent_at_unix"`
+        AttachmentIDs []int32 `json:"attachment_ids"`
     }
     go func() {
         for {
@@ -77,6 +79,7 @@ func (h *WebSocketGatewayHandler) SubscribeRoomWebSocket(c *websocket.Conn) {
                 Text:        in.Message,
                 SenderId:    in.Sender,
                 SentAtUnix:  sentAt,
+                AttachmentIds: in.AttachmentIDs,
             }}})
         }
     }()
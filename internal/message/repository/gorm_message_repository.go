@@ -31,6 +31,17 @@ type messageDoc struct {
 	RoomId    uint      `bson:"room_id"`
 	Message   string    `bson:"message"`
 	Sender    uuid.UUID `bson:"sender"`
+	Attachments []entities.Attachment `bson:"attachments,omitempty"`
+	LinkPreviews []entities.LinkPreview `bson:"link_previews,omitempty"`
+	Mentions  []uuid.UUID `bson:"mentions,omitempty"`
+	MentionRoom bool `bson:"mention_room,omitempty"`
+	MentionHere bool `bson:"mention_here,omitempty"`
+	HereMentions []uuid.UUID `bson:"here_mentions,omitempty"`
+	Pin       *entities.MessagePin `bson:"pin,omitempty"`
+	ExpiresAt *time.Time `bson:"expires_at,omitempty"`
+	PollId    uint `bson:"poll_id,omitempty"`
+	System    *entities.SystemEvent `bson:"system,omitempty"`
+	ScheduledId uint `bson:"scheduled_id,omitempty"`
 	CreatedAt time.Time `bson:"created_at"`
 	UpdatedAt time.Time `bson:"updated_at"`
 }
@@ -70,6 +81,13 @@ func (r *MongoMessageRepository) getNextSequence(ctx context.Context, name strin
 	return out.Seq, nil
 }
 
+// visible hides messages whose disappearing timer has run out but that the reaper
+// has not removed yet. A missing expires_at never matches $lte, so it stays visible.
+func visible(filter bson.M) bson.M {
+	filter["expires_at"] = bson.M{"$not": bson.M{"$lte": time.Now()}}
+	return filter
+}
+
 func (r *MongoMessageRepository) Save(message *entities.Message) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -84,6 +102,15 @@ func (r *MongoMessageRepository) Save(message *entities.Message) error {
 		RoomId:    message.RoomId,
 		Message:   message.Message,
 		Sender:    message.Sender,
+		Attachments: message.Attachments,
+		Mentions:  message.Mentions,
+		MentionRoom: message.MentionRoom,
+		MentionHere: message.MentionHere,
+		HereMentions: message.HereMentions,
+		ExpiresAt: message.ExpiresAt,
+		PollId:    message.PollId,
+		System:    message.System,
+		ScheduledId: message.ScheduledId,
 		CreatedAt: message.CreatedAt,
 		UpdatedAt: message.UpdatedAt,
 	})
@@ -96,11 +123,66 @@ func (r *MongoMessageRepository) Save(message *entities.Message) error {
 	return nil
 }
 
+func (r *MongoMessageRepository) FindByID(id int) (*entities.Message, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var message entities.Message
+	err := r.coll.FindOne(ctx, visible(bson.M{"_id": id})).Decode(&message)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return &entities.Message{}, err
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &message, nil
+}
+
+// FindByScheduledID returns the message delivered for a scheduled message, if any
+func (r *MongoMessageRepository) FindByScheduledID(scheduledId uint) (*entities.Message, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var message entities.Message
+	err := r.coll.FindOne(ctx, bson.M{"scheduled_id": scheduledId}).Decode(&message)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return &entities.Message{}, err
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &message, nil
+}
+
+// UpdateAttachments replaces the embedded attachment metadata of a message
+func (r *MongoMessageRepository) UpdateAttachments(id uint, attachments []entities.Attachment) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := r.coll.UpdateByID(ctx, id, bson.M{"$set": bson.M{
+		"attachments": attachments,
+		"updated_at":  time.Now().UTC(),
+	}})
+	return err
+}
+
+// UpdateLinkPreviews replaces the unfurled link previews of a message
+func (r *MongoMessageRepository) UpdateLinkPreviews(id uint, previews []entities.LinkPreview) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := r.coll.UpdateByID(ctx, id, bson.M{"$set": bson.M{
+		"link_previews": previews,
+		"updated_at":    time.Now().UTC(),
+	}})
+	return err
+}
+
 func (r *MongoMessageRepository) FindAllByRoomID(roomId int) ([]*entities.Message, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	filter := bson.M{"room_id": roomId}
+	filter := visible(bson.M{"room_id": roomId})
 	cur, err := r.coll.Find(ctx, filter)
 	if errors.Is(err, mongo.ErrNoDocuments) {
 		return []*entities.Message{}, err
@@ -121,6 +203,17 @@ func (r *MongoMessageRepository) FindAllByRoomID(roomId int) ([]*entities.Messag
 			RoomId:    m.RoomId,
 			Message:   m.Message,
 			Sender:    m.Sender,
+			Attachments: m.Attachments,
+			LinkPreviews: m.LinkPreviews,
+			Mentions:  m.Mentions,
+			MentionRoom: m.MentionRoom,
+			MentionHere: m.MentionHere,
+			HereMentions: m.HereMentions,
+			Pin:       m.Pin,
+			ExpiresAt: m.ExpiresAt,
+			PollId:    m.PollId,
+			System:    m.System,
+			ScheduledId: m.ScheduledId,
 			CreatedAt: m.CreatedAt,
 			UpdatedAt: m.UpdatedAt,
 		})
@@ -147,7 +240,7 @@ func (r *MongoMessageRepository) FindByRoomId(roomId int) (*entities.Message, er
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	filter := bson.M{"room_id": roomId}
+	filter := visible(bson.M{"room_id": roomId})
 	opts := options.FindOne().SetSort(bson.D{{Key: "created_at", Value: -1}})
 
 	var message entities.Message
@@ -192,12 +285,12 @@ func (r *MongoMessageRepository) FindAllMessagesUnread(userId uuid.UUID, roomId
 	fmt.Println(lastVisitDoc)
 	fmt.Println("lastVisitDoc.LastVisit", lastVisitDoc.LastVisit)
 
-	filter := bson.M{
+	filter := visible(bson.M{
 		"room_id": roomId,
 		"created_at": bson.M{
 			"$gt": lastVisitDoc.LastVisit,
 		},
-	}
+	})
 
 	cursor, err := messagesCollection.Find(ctx, filter)
 	if err != nil {
@@ -220,3 +313,265 @@ func (r *MongoMessageRepository) FindAllMessagesUnread(userId uuid.UUID, roomId
 
 	return messages, nil
 }
+
+// mentionFilter matches messages that mention userId directly, through @room,
+// or through @here while the user was online
+func mentionFilter(userId uuid.UUID) bson.M {
+	return bson.M{
+		"sender": bson.M{"$ne": userId},
+		"$or": []bson.M{
+			{"mentions": userId},
+			{"mention_room": true},
+			{"here_mentions": userId},
+		},
+	}
+}
+
+func (r *MongoMessageRepository) FindAllMentions(userId uuid.UUID, roomIds []uint, offset, limit int) ([]*entities.Message, int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	filter := visible(mentionFilter(userId))
+	filter["room_id"] = bson.M{"$in": roomIds}
+
+	total, err := r.coll.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}}).
+		SetSkip(int64(offset)).
+		SetLimit(int64(limit))
+	cur, err := r.coll.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer cur.Close(ctx)
+
+	var messages []*entities.Message
+	for cur.Next(ctx) {
+		var m entities.Message
+		if err := cur.Decode(&m); err != nil {
+			return nil, 0, err
+		}
+		messages = append(messages, &m)
+	}
+	return messages, total, cur.Err()
+}
+
+// CountUnread counts messages since the user's last visit of the room and how many of them mention the user
+func (r *MongoMessageRepository) CountUnread(userId uuid.UUID, roomId int) (int64, int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var lastVisitDoc struct {
+		LastVisit time.Time `bson:"lastvisit"`
+	}
+	err := r.db.Collection("lastvisits").FindOne(ctx, bson.M{"user_id": userId, "room_id": roomId}).Decode(&lastVisitDoc)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return 0, 0, err
+	}
+
+	filter := visible(bson.M{
+		"room_id":    roomId,
+		"sender":     bson.M{"$ne": userId},
+		"created_at": bson.M{"$gt": lastVisitDoc.LastVisit},
+	})
+	unread, err := r.coll.CountDocuments(ctx, filter)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	filter = visible(mentionFilter(userId))
+	filter["room_id"] = roomId
+	filter["created_at"] = bson.M{"$gt": lastVisitDoc.LastVisit}
+	mentions, err := r.coll.CountDocuments(ctx, filter)
+	if err != nil {
+		return 0, 0, err
+	}
+	return unread, mentions, nil
+}
+
+// EnsureIndexes creates the text index used by Search, the expiry index
+// used by the reaper and the index that keeps a scheduled message from being
+// delivered twice; it is safe to call on every startup
+func (r *MongoMessageRepository) EnsureIndexes() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	_, err := r.coll.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "message", Value: "text"}},
+			Options: options.Index().SetName("message_text"),
+		},
+		{
+			Keys:    bson.D{{Key: "expires_at", Value: 1}},
+			Options: options.Index().SetName("expires_at").SetSparse(true),
+		},
+		{
+			Keys:    bson.D{{Key: "scheduled_id", Value: 1}},
+			Options: options.Index().SetName("scheduled_id_unique").SetUnique(true).SetSparse(true),
+		},
+	})
+	return err
+}
+
+func (r *MongoMessageRepository) Search(query *entities.MessageSearchQuery, roomIds []uint, offset, limit int) ([]*entities.MessageSearchHit, int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	filter := visible(bson.M{
+		"$text":   bson.M{"$search": query.Text},
+		"room_id": bson.M{"$in": roomIds},
+	})
+	if query.Sender != uuid.Nil {
+		filter["sender"] = query.Sender
+	}
+	createdAt := bson.M{}
+	if !query.From.IsZero() {
+		createdAt["$gte"] = query.From
+	}
+	if !query.To.IsZero() {
+		createdAt["$lte"] = query.To
+	}
+	if len(createdAt) > 0 {
+		filter["created_at"] = createdAt
+	}
+	if query.HasAttachment != nil {
+		filter["attachments.0"] = bson.M{"$exists": *query.HasAttachment}
+	}
+
+	total, err := r.coll.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	score := bson.M{"$meta": "textScore"}
+	sort := bson.D{{Key: "score", Value: score}, {Key: "created_at", Value: -1}}
+	if query.Sort == entities.MessageSearchByRecent {
+		sort = bson.D{{Key: "created_at", Value: -1}}
+	}
+	opts := options.Find().
+		SetProjection(bson.M{"score": score}).
+		SetSort(sort).
+		SetSkip(int64(offset)).
+		SetLimit(int64(limit))
+	cur, err := r.coll.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer cur.Close(ctx)
+
+	var hits []*entities.MessageSearchHit
+	for cur.Next(ctx) {
+		var d struct {
+			entities.Message `bson:",inline"`
+			Score            float64 `bson:"score"`
+		}
+		if err := cur.Decode(&d); err != nil {
+			return nil, 0, err
+		}
+		m := d.Message
+		hits = append(hits, &entities.MessageSearchHit{Message: &m, Score: d.Score})
+	}
+	return hits, total, cur.Err()
+}
+
+// UpdatePin sets the pin of a message, or removes it when pin is nil
+func (r *MongoMessageRepository) UpdatePin(id uint, pin *entities.MessagePin) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	update := bson.M{"$set": bson.M{"pin": pin}}
+	if pin == nil {
+		update = bson.M{"$unset": bson.M{"pin": ""}}
+	}
+	res, err := r.coll.UpdateByID(ctx, id, update)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// FindAllPinnedByRoomID returns the pinned messages of a room, most recently pinned first
+func (r *MongoMessageRepository) FindAllPinnedByRoomID(roomId uint) ([]*entities.Message, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	opts := options.Find().SetSort(bson.D{{Key: "pin.pinned_at", Value: -1}})
+	cur, err := r.coll.Find(ctx, visible(bson.M{"room_id": roomId, "pin": bson.M{"$exists": true}}), opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var messages []*entities.Message
+	for cur.Next(ctx) {
+		var m entities.Message
+		if err := cur.Decode(&m); err != nil {
+			return nil, err
+		}
+		messages = append(messages, &m)
+	}
+	return messages, cur.Err()
+}
+
+func (r *MongoMessageRepository) CountPinnedByRoomID(roomId uint) (int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	return r.coll.CountDocuments(ctx, visible(bson.M{"room_id": roomId, "pin": bson.M{"$exists": true}}))
+}
+
+// FindAllExpired returns up to limit messages whose expires_at has passed
+func (r *MongoMessageRepository) FindAllExpired(now time.Time, limit int) ([]*entities.Message, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "expires_at", Value: 1}}).
+		SetLimit(int64(limit))
+	cur, err := r.coll.Find(ctx, bson.M{"expires_at": bson.M{"$lte": now}}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var messages []*entities.Message
+	for cur.Next(ctx) {
+		var m entities.Message
+		if err := cur.Decode(&m); err != nil {
+			return nil, err
+		}
+		messages = append(messages, &m)
+	}
+	return messages, cur.Err()
+}
+
+// Expire moves a visible message's expires_at up to at, so it is hidden at once
+// and the reaper removes it with its attachments on its next pass
+func (r *MongoMessageRepository) Expire(id uint, at time.Time) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	res, err := r.coll.UpdateOne(ctx, visible(bson.M{"_id": id}), bson.M{"$set": bson.M{"expires_at": at}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+func (r *MongoMessageRepository) DeleteAllByIDs(ids []uint) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := r.coll.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": ids}})
+	return err
+}
@@ -1,16 +1,33 @@
 package repository
 
 import (
+	"time"
+
 	"github.com/MingPV/ChatService/internal/entities"
 	"github.com/google/uuid"
 )
 
 type MessageRepository interface {
 	Save(message *entities.Message) error
+	FindByID(id int) (*entities.Message, error)
+	FindByScheduledID(scheduledId uint) (*entities.Message, error)
+	UpdateAttachments(id uint, attachments []entities.Attachment) error
+	UpdateLinkPreviews(id uint, previews []entities.LinkPreview) error
 	FindAllByRoomID(roomId int) ([]*entities.Message, error)
 
 	DeleteAllMessagesByRoomID(roomId int) error
 	FindByRoomId(roomId int) (*entities.Message, error)
 	FindAllMessagesUnread(userId uuid.UUID, roomId int) ([]*entities.Message, error)
+	FindAllMentions(userId uuid.UUID, roomIds []uint, offset, limit int) ([]*entities.Message, int64, error)
+	CountUnread(userId uuid.UUID, roomId int) (unread int64, mentions int64, err error)
+	Search(query *entities.MessageSearchQuery, roomIds []uint, offset, limit int) ([]*entities.MessageSearchHit, int64, error)
+	EnsureIndexes() error
+	UpdatePin(id uint, pin *entities.MessagePin) error
+	FindAllPinnedByRoomID(roomId uint) ([]*entities.Message, error)
+	CountPinnedByRoomID(roomId uint) (int64, error)
+	FindAllExpired(now time.Time, limit int) ([]*entities.Message, error)
+	// Expire hides the message from now on and leaves its removal to the reaper.
+	Expire(id uint, at time.Time) error
+	DeleteAllByIDs(ids []uint) error
 
 }
\ No newline at end of file
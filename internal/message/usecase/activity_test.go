@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/MingPV/ChatService/internal/entities"
+	"github.com/MingPV/ChatService/internal/message/repository"
+	"github.com/MingPV/ChatService/internal/testsupport"
+	"github.com/google/uuid"
+)
+
+var errStoreFailed = errors.New("store failed")
+
+type savingMessages struct {
+	repository.MessageRepository
+	saved   []*entities.Message
+	saveErr error
+	deleted []uint
+}
+
+func (f *savingMessages) Save(message *entities.Message) error {
+	if f.saveErr != nil {
+		return f.saveErr
+	}
+	message.ID = uint(len(f.saved) + 1)
+	f.saved = append(f.saved, message)
+	return nil
+}
+
+func (f *savingMessages) DeleteAllByIDs(ids []uint) error {
+	f.deleted = append(f.deleted, ids...)
+	return nil
+}
+
+// touchedChatrooms records the latest activity reported for each room
+type touchedChatrooms struct {
+	*testsupport.Chatrooms
+	touched  map[int]uint
+	touchErr error
+}
+
+func (f *touchedChatrooms) TouchActivity(id int, messageId uint, at time.Time) error {
+	if f.touchErr != nil {
+		return f.touchErr
+	}
+	f.touched[id] = messageId
+	return nil
+}
+
+func TestCreateMessageTouchesRoomActivity(t *testing.T) {
+	tests := []struct {
+		name        string
+		saveErr     error
+		touchErr    error
+		wantErr     error
+		wantTouched bool
+	}{
+		{name: "records the new message as the room's latest", wantTouched: true},
+		{name: "activity failure does not fail the send", touchErr: errStoreFailed},
+		{name: "unsaved message leaves the room alone", saveErr: errStoreFailed, wantErr: errStoreFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			messages := &savingMessages{saveErr: tt.saveErr}
+			rooms := &touchedChatrooms{
+				Chatrooms: testsupport.NewChatrooms(&entities.Chatroom{ID: 5, IsGroup: true}),
+				touched:   make(map[int]uint),
+				touchErr:  tt.touchErr,
+			}
+			s := NewMessageService(messages, nil, nil, rooms, nil, &testsupport.Restrictions{}, MessagePolicy{})
+
+			message := &entities.Message{RoomId: 5, Sender: uuid.New(), Message: "hello"}
+			err := s.CreateMessage(message)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CreateMessage() error = %v, want %v", err, tt.wantErr)
+			}
+			messageId, touched := rooms.touched[5]
+			if touched != tt.wantTouched {
+				t.Fatalf("room touched = %v, want %v", touched, tt.wantTouched)
+			}
+			if touched && messageId != message.ID {
+				t.Fatalf("latest message = %d, want %d", messageId, message.ID)
+			}
+		})
+	}
+}
+
+func TestPostSystemMessageTouchesRoomActivity(t *testing.T) {
+	messages := &savingMessages{}
+	rooms := &touchedChatrooms{touched: make(map[int]uint)}
+	s := NewMessageService(messages, nil, nil, rooms, nil, nil, MessagePolicy{})
+
+	message, err := s.PostSystemMessage(5, "Alice removed Bob", &entities.SystemEvent{Type: entities.SystemEventMemberKicked, Actor: uuid.New()})
+	if err != nil {
+		t.Fatalf("PostSystemMessage() error = %v", err)
+	}
+	if rooms.touched[5] != message.ID {
+		t.Fatalf("latest message = %d, want %d", rooms.touched[5], message.ID)
+	}
+}
@@ -0,0 +1,98 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	attachmentRepo "github.com/MingPV/ChatService/internal/attachment/repository"
+	"github.com/MingPV/ChatService/internal/entities"
+	"github.com/MingPV/ChatService/internal/testsupport"
+	"github.com/MingPV/ChatService/pkg/apperror"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// claimableAttachments serves stored uploads and fails claims with claimErr
+type claimableAttachments struct {
+	attachmentRepo.AttachmentRepository
+	stored   map[uint]*entities.Attachment
+	claimErr error
+	claimed  []uint
+}
+
+func (f *claimableAttachments) FindByID(id int) (*entities.Attachment, error) {
+	if a, ok := f.stored[uint(id)]; ok {
+		copied := *a
+		return &copied, nil
+	}
+	return &entities.Attachment{}, mongo.ErrNoDocuments
+}
+
+func (f *claimableAttachments) AttachToMessage(ids []uint, messageId uint) error {
+	if f.claimErr != nil {
+		return f.claimErr
+	}
+	f.claimed = append(f.claimed, ids...)
+	return nil
+}
+
+func TestCreateMessageClaimsAttachments(t *testing.T) {
+	sender, other := uuid.New(), uuid.New()
+
+	tests := []struct {
+		name        string
+		ids         []uint
+		claimErr    error
+		wantErr     error
+		wantSaved   bool
+		wantDeleted bool
+	}{
+		{name: "claims the sender's uploads", ids: []uint{1, 2}, wantSaved: true},
+		{name: "upload claimed by another send", ids: []uint{1}, claimErr: mongo.ErrNoDocuments, wantErr: apperror.ErrAlreadyExists, wantSaved: true, wantDeleted: true},
+		{name: "claim failure undoes the message", ids: []uint{1}, claimErr: errStoreFailed, wantErr: errStoreFailed, wantSaved: true, wantDeleted: true},
+		{name: "upload already used", ids: []uint{3}, wantErr: apperror.ErrAlreadyExists},
+		{name: "someone else's upload", ids: []uint{4}, wantErr: apperror.ErrForbidden},
+		{name: "same upload twice", ids: []uint{1, 1}, wantErr: apperror.ErrInvalidData},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attachments := &claimableAttachments{claimErr: tt.claimErr, stored: map[uint]*entities.Attachment{
+				1: {ID: 1, RoomId: 5, Uploader: sender},
+				2: {ID: 2, RoomId: 5, Uploader: sender},
+				3: {ID: 3, RoomId: 5, Uploader: sender, MessageId: 9},
+				4: {ID: 4, RoomId: 5, Uploader: other},
+			}}
+			messages := &savingMessages{}
+			rooms := &touchedChatrooms{
+				Chatrooms: testsupport.NewChatrooms(&entities.Chatroom{ID: 5, IsGroup: true}),
+				touched:   make(map[int]uint),
+			}
+			s := NewMessageService(messages, attachments, nil, rooms, nil, &testsupport.Restrictions{}, MessagePolicy{})
+
+			message := &entities.Message{RoomId: 5, Sender: sender, Message: "files"}
+			for _, id := range tt.ids {
+				message.Attachments = append(message.Attachments, entities.Attachment{ID: id})
+			}
+			err := s.CreateMessage(message)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CreateMessage() error = %v, want %v", err, tt.wantErr)
+			}
+			if saved := len(messages.saved) == 1; saved != tt.wantSaved {
+				t.Fatalf("message saved = %v, want %v", saved, tt.wantSaved)
+			}
+			if deleted := len(messages.deleted) == 1; deleted != tt.wantDeleted {
+				t.Fatalf("message deleted = %v, want %v", deleted, tt.wantDeleted)
+			}
+			if tt.wantErr != nil {
+				if _, touched := rooms.touched[5]; touched {
+					t.Fatalf("failed send was recorded as room activity")
+				}
+				return
+			}
+			if len(attachments.claimed) != len(tt.ids) {
+				t.Fatalf("claimed %v, want %v", attachments.claimed, tt.ids)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package usecase
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	attachmentRepo "github.com/MingPV/ChatService/internal/attachment/repository"
+	bookmarkRepo "github.com/MingPV/ChatService/internal/bookmark/repository"
+	"github.com/MingPV/ChatService/internal/entities"
+	"github.com/MingPV/ChatService/internal/message/repository"
+	"github.com/MingPV/ChatService/internal/testsupport"
+	"github.com/MingPV/ChatService/pkg/blobstore"
+	"github.com/google/uuid"
+)
+
+func TestApplyRoomSettingsStampsExpiry(t *testing.T) {
+	sentAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := []struct {
+		name string
+		ttl  int
+		want *time.Time
+	}{
+		{"no ttl", 0, nil},
+		{"one minute", 60, func() *time.Time { at := sentAt.Add(time.Minute); return &at }()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rooms := testsupport.NewChatrooms(&entities.Chatroom{ID: 1, IsGroup: true, MessageTTL: tt.ttl})
+			s := NewMessageService(nil, nil, &testsupport.Members{}, rooms, nil, &testsupport.Restrictions{}, MessagePolicy{}).(*MessageService)
+
+			message := &entities.Message{RoomId: 1, Sender: uuid.New(), CreatedAt: sentAt}
+			if err := s.applyRoomSettings(message); err != nil {
+				t.Fatalf("applyRoomSettings: %v", err)
+			}
+			switch {
+			case tt.want == nil && message.ExpiresAt != nil:
+				t.Fatalf("expires_at = %v, want unset", *message.ExpiresAt)
+			case tt.want != nil && (message.ExpiresAt == nil || !message.ExpiresAt.Equal(*tt.want)):
+				t.Fatalf("expires_at = %v, want %v", message.ExpiresAt, *tt.want)
+			}
+		})
+	}
+}
+
+type fakeExpiredMessages struct {
+	repository.MessageRepository
+	expired []*entities.Message
+	deleted []uint
+}
+
+func (f *fakeExpiredMessages) FindAllExpired(now time.Time, limit int) ([]*entities.Message, error) {
+	out := f.expired
+	f.expired = nil
+	return out, nil
+}
+
+func (f *fakeExpiredMessages) DeleteAllByIDs(ids []uint) error {
+	f.deleted = append(f.deleted, ids...)
+	return nil
+}
+
+type fakeAttachments struct {
+	attachmentRepo.AttachmentRepository
+	deleted []int
+}
+
+func (f *fakeAttachments) Delete(id int) error {
+	f.deleted = append(f.deleted, id)
+	return nil
+}
+
+type fakeBookmarks struct {
+	bookmarkRepo.BookmarkRepository
+	tombstoned []uint
+}
+
+func (f *fakeBookmarks) TombstoneAllByMessageIDs(ids []uint) error {
+	f.tombstoned = append(f.tombstoned, ids...)
+	return nil
+}
+
+type fakeBlobStore struct {
+	blobstore.BlobStore
+	deleted []string
+}
+
+func (f *fakeBlobStore) Delete(ctx context.Context, key string) error {
+	f.deleted = append(f.deleted, key)
+	return nil
+}
+
+type recordingMessages struct {
+	MessageUseCase
+	events []*entities.RoomEvent
+}
+
+func (r *recordingMessages) PublishRoomEvent(event *entities.RoomEvent) {
+	r.events = append(r.events, event)
+}
+
+func TestReaperRemovesExpiredMessages(t *testing.T) {
+	messages := &fakeExpiredMessages{expired: []*entities.Message{
+		{ID: 1, RoomId: 5, Attachments: []entities.Attachment{{ID: 9, StorageKey: "orig", Thumbnails: []entities.Thumbnail{{StorageKey: "thumb"}}}}},
+		{ID: 2, RoomId: 6},
+	}}
+	attachments := &fakeAttachments{}
+	bookmarks := &fakeBookmarks{}
+	store := &fakeBlobStore{}
+	events := &recordingMessages{}
+
+	NewReaper(messages, attachments, bookmarks, store, events, time.Second).reap(context.Background())
+
+	if len(messages.deleted) != 2 || len(bookmarks.tombstoned) != 2 {
+		t.Fatalf("deleted %v, tombstoned %v, want both messages", messages.deleted, bookmarks.tombstoned)
+	}
+	sort.Strings(store.deleted)
+	if len(store.deleted) != 2 || store.deleted[0] != "orig" || store.deleted[1] != "thumb" {
+		t.Fatalf("deleted blobs %v, want [orig thumb]", store.deleted)
+	}
+	if len(attachments.deleted) != 1 || attachments.deleted[0] != 9 {
+		t.Fatalf("deleted attachments %v, want [9]", attachments.deleted)
+	}
+	if len(events.events) != 2 {
+		t.Fatalf("published %d events, want 2", len(events.events))
+	}
+	for i, ev := range events.events {
+		if ev.Type != entities.RoomEventMessageExpired || ev.Message.ID != uint(i+1) {
+			t.Fatalf("event %d = %+v, want expiry of message %d", i, ev, i+1)
+		}
+	}
+}
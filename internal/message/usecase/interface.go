@@ -11,8 +11,34 @@ type MessageUseCase interface {
 	DeleteAllMessagesByRoomID(roomId int) error
 	FindLatestMessageByRoomId(roomId int) (*entities.Message, error)
 	FindAllMessagesUnread(userId uuid.UUID, roomId int) ([]*entities.Message, error)
+	FindAllMentions(userId uuid.UUID, page, pageSize int) ([]*entities.Message, int64, error)
+	FindUnreadCounts(userId uuid.UUID) ([]*entities.UnreadCount, error)
+	SearchMessages(userId uuid.UUID, query *entities.MessageSearchQuery) ([]*entities.MessageSearchHit, int64, error)
+	PinMessage(messageId int, userId uuid.UUID) (*entities.Message, error)
+	UnpinMessage(messageId int, userId uuid.UUID) (*entities.Message, error)
+	FindPinnedMessages(roomId uint, userId uuid.UUID) ([]*entities.Message, error)
+	// RemoveMessage takes a message down on a moderator's behalf; callers check permissions.
+	RemoveMessage(messageId uint) (*entities.Message, error)
 
-	// SubscribeRoom subscribes to a room and returns a read-only channel of messages
+	// SubscribeRoom subscribes to a room and returns a read-only channel of room events
 	// and a cleanup function to unsubscribe and release resources.
-	SubscribeRoom(roomId int) (<-chan *entities.Message, func())
+	SubscribeRoom(roomId int) (<-chan *entities.RoomEvent, func())
+	// PublishRoomEvent delivers event to every current subscriber of event.RoomId.
+	PublishRoomEvent(event *entities.RoomEvent)
+	// SubscribeUser subscribes to events addressed to a single user, such as mentions.
+	SubscribeUser(userId uuid.UUID) (<-chan *entities.RoomEvent, func())
+	// NotifyUser delivers event to every current subscriber of userId.
+	NotifyUser(userId uuid.UUID, event *entities.RoomEvent)
+	// IsOnline reports whether userId currently has an open event stream.
+	IsOnline(userId uuid.UUID) bool
+	// PostSystemMessage announces event in the room as a message attributed to event.Actor.
+	PostSystemMessage(roomId uint, text string, event *entities.SystemEvent) (*entities.Message, error)
+	// RegisterProcessor adds a processor that is handed every created message.
+	RegisterProcessor(p MessageProcessor)
+}
+
+// MessageProcessor runs background work (thumbnails, link previews) for new messages.
+// Process is called synchronously from CreateMessage and must not block.
+type MessageProcessor interface {
+	Process(message *entities.Message)
 }
\ No newline at end of file
@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/MingPV/ChatService/internal/entities"
+	"github.com/google/uuid"
+)
+
+var mentionPattern = regexp.MustCompile(`(^|[^\w@])@([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|room|here)\b`)
+
+// ParseMentions extracts @<user-id>, @room and @here tokens from text.
+// Duplicate user mentions are collapsed and keep their first position.
+func ParseMentions(text string) (users []uuid.UUID, room bool, here bool) {
+	seen := make(map[uuid.UUID]bool)
+	for _, match := range mentionPattern.FindAllStringSubmatch(text, -1) {
+		switch token := strings.ToLower(match[2]); token {
+		case "room":
+			room = true
+		case "here":
+			here = true
+		default:
+			id, err := uuid.Parse(token)
+			if err != nil || seen[id] {
+				continue
+			}
+			seen[id] = true
+			users = append(users, id)
+		}
+	}
+	return users, room, here
+}
+
+// mentionTargets picks the members a message mentions: everyone for @room,
+// the members recorded as online for @here, plus anyone mentioned by name.
+// Muting a room silences @room and @here, but not mentions by name.
+func mentionTargets(message *entities.Message, members []*entities.RoomMember, now time.Time) []uuid.UUID {
+	direct := make(map[uuid.UUID]bool, len(message.Mentions))
+	for _, userId := range message.Mentions {
+		direct[userId] = true
+	}
+	here := make(map[uuid.UUID]bool, len(message.HereMentions))
+	for _, userId := range message.HereMentions {
+		here[userId] = true
+	}
+
+	targets := make([]uuid.UUID, 0, len(members))
+	for _, m := range members {
+		switch {
+		case direct[m.UserId]:
+		case m.IsMuted(now):
+			continue
+		case message.MentionRoom, here[m.UserId]:
+		default:
+			continue
+		}
+		targets = append(targets, m.UserId)
+	}
+	return targets
+}
@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MingPV/ChatService/internal/entities"
+	"github.com/MingPV/ChatService/internal/testsupport"
+	"github.com/google/uuid"
+)
+
+func TestParseMentions(t *testing.T) {
+	a := uuid.New()
+	b := uuid.New()
+	text := "hi @" + a.String() + " and @" + b.String() + ", also @" + a.String() + " @here"
+
+	users, room, here := ParseMentions(text)
+	if len(users) != 2 || users[0] != a || users[1] != b {
+		t.Fatalf("users = %v, want [%s %s]", users, a, b)
+	}
+	if room {
+		t.Error("room = true, want false")
+	}
+	if !here {
+		t.Error("here = false, want true")
+	}
+}
+
+func TestParseMentionsIgnoresEmbedded(t *testing.T) {
+	users, room, here := ParseMentions("mail me at someone@room.example or @roommate")
+	if len(users) != 0 || room || here {
+		t.Fatalf("got users=%v room=%v here=%v, want none", users, room, here)
+	}
+}
+
+func TestMentionTargets(t *testing.T) {
+	now := time.Now().UTC()
+	online := uuid.New()
+	offline := uuid.New()
+	muted := uuid.New()
+	named := uuid.New()
+	members := []*entities.RoomMember{
+		{UserId: online},
+		{UserId: offline},
+		{UserId: muted, Muted: true},
+		{UserId: named, Muted: true},
+	}
+
+	tests := []struct {
+		name    string
+		message *entities.Message
+		want    []uuid.UUID
+	}{
+		{"room reaches everyone not muted", &entities.Message{MentionRoom: true}, []uuid.UUID{online, offline}},
+		{"here reaches online members only", &entities.Message{MentionHere: true, HereMentions: []uuid.UUID{online, muted}}, []uuid.UUID{online}},
+		{"named mentions pass a mute", &entities.Message{MentionHere: true, Mentions: []uuid.UUID{named}}, []uuid.UUID{named}},
+		{"no broadcast", &entities.Message{Mentions: []uuid.UUID{offline}}, []uuid.UUID{offline}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mentionTargets(tt.message, members, now)
+			if len(got) != len(tt.want) {
+				t.Fatalf("targets = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("targets = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestNotifyMentionsHereSkipsOfflineMembers(t *testing.T) {
+	sender := uuid.New()
+	online := uuid.New()
+	offline := uuid.New()
+	s := NewMessageService(nil, nil, &testsupport.Members{Members: []*entities.RoomMember{
+		{RoomId: 1, UserId: sender}, {RoomId: 1, UserId: online}, {RoomId: 1, UserId: offline},
+	}}, nil, nil, nil, MessagePolicy{}).(*MessageService)
+
+	events, cancel := s.SubscribeUser(online)
+	defer cancel()
+
+	tests := []struct {
+		text        string
+		wantOffline bool
+	}{
+		{"@room lunch", true},
+		{"@here lunch", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			message := &entities.Message{RoomId: 1, Sender: sender, Message: tt.text}
+			if err := s.resolveMentions(message); err != nil {
+				t.Fatalf("resolveMentions: %v", err)
+			}
+			s.notifyMentions(message)
+
+			select {
+			case <-events:
+			default:
+				t.Fatal("online member was not notified")
+			}
+			targets := mentionTargets(message, []*entities.RoomMember{{UserId: offline}}, time.Now())
+			if got := len(targets) == 1; got != tt.wantOffline {
+				t.Fatalf("offline member notified = %v, want %v", got, tt.wantOffline)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/MingPV/ChatService/internal/entities"
+	"github.com/MingPV/ChatService/internal/message/repository"
+	"github.com/MingPV/ChatService/internal/testsupport"
+	"github.com/MingPV/ChatService/pkg/apperror"
+	"github.com/google/uuid"
+)
+
+type pinnedMessages struct {
+	repository.MessageRepository
+	message *entities.Message
+	pinned  int64
+	updates int
+	// raced pins land in the room right after this one, as if made at the same time
+	raced int64
+}
+
+func (f *pinnedMessages) FindByID(id int) (*entities.Message, error) {
+	copied := *f.message
+	return &copied, nil
+}
+
+func (f *pinnedMessages) CountPinnedByRoomID(roomId uint) (int64, error) {
+	return f.pinned, nil
+}
+
+func (f *pinnedMessages) UpdatePin(id uint, pin *entities.MessagePin) error {
+	f.updates++
+	if pin != nil && f.message.Pin == nil {
+		f.pinned += 1 + f.raced
+	} else if pin == nil && f.message.Pin != nil {
+		f.pinned--
+	}
+	f.message.Pin = pin
+	return nil
+}
+
+func TestPinLimitReached(t *testing.T) {
+	tests := []struct {
+		name  string
+		max   int
+		count int64
+		want  bool
+	}{
+		{"below the limit", 3, 2, false},
+		{"at the limit", 3, 3, true},
+		{"above the limit", 3, 5, true},
+		{"zero means no limit", 0, 1000, false},
+		{"negative means no limit", -1, 1000, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := (MessagePolicy{MaxPinsPerRoom: tt.max}).pinLimitReached(tt.count); got != tt.want {
+				t.Fatalf("pinLimitReached(%d) with max %d = %v, want %v", tt.count, tt.max, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPinMessage(t *testing.T) {
+	owner, admin, member, outsider := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	tests := []struct {
+		name        string
+		group       bool
+		userId      uuid.UUID
+		pinned      int64
+		raced       int64
+		alreadyPin  bool
+		wantErr     error
+		wantUpdates int
+	}{
+		{name: "admin pins in a group", group: true, userId: admin, wantUpdates: 1},
+		{name: "owner pins in a group", group: true, userId: owner, wantUpdates: 1},
+		{name: "member cannot pin in a group", group: true, userId: member, wantErr: apperror.ErrForbidden},
+		{name: "anyone pins in a direct chat", userId: member, wantUpdates: 1},
+		{name: "non-member cannot pin", userId: outsider, wantErr: apperror.ErrForbidden},
+		{name: "room at the pin limit", group: true, userId: admin, pinned: 3, wantErr: apperror.ErrLimitExceeded},
+		{name: "concurrent pin over the limit is undone", group: true, userId: admin, pinned: 2, raced: 1, wantErr: apperror.ErrLimitExceeded, wantUpdates: 2},
+		{name: "pinning twice changes nothing", group: true, userId: admin, pinned: 3, alreadyPin: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			message := &entities.Message{ID: 1, RoomId: 1, Sender: member}
+			if tt.alreadyPin {
+				message.Pin = &entities.MessagePin{PinnedBy: owner}
+			}
+			messages := &pinnedMessages{message: message, pinned: tt.pinned, raced: tt.raced}
+			members := &testsupport.Members{Members: []*entities.RoomMember{
+				{RoomId: 1, UserId: owner},
+				{RoomId: 1, UserId: admin, Role: entities.RoomRoleAdmin},
+				{RoomId: 1, UserId: member, Role: entities.RoomRoleMember},
+			}}
+			rooms := testsupport.NewChatrooms(&entities.Chatroom{ID: 1, IsGroup: tt.group, Owner: owner})
+			s := NewMessageService(messages, nil, members, rooms, nil, nil, MessagePolicy{MaxPinsPerRoom: 3})
+
+			pinned, err := s.PinMessage(1, tt.userId)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("PinMessage() error = %v, want %v", err, tt.wantErr)
+			}
+			if messages.updates != tt.wantUpdates {
+				t.Fatalf("pin updates = %d, want %d", messages.updates, tt.wantUpdates)
+			}
+			if tt.wantErr == nil && pinned.Pin == nil {
+				t.Fatalf("message is not pinned")
+			}
+			if tt.wantErr != nil && !tt.alreadyPin && message.Pin != nil {
+				t.Fatalf("stored pin = %+v, want none", message.Pin)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package usecase
+
+import (
+	"context"
+	"log"
+	"time"
+
+	attachmentRepo "github.com/MingPV/ChatService/internal/attachment/repository"
+	bookmarkRepo "github.com/MingPV/ChatService/internal/bookmark/repository"
+	"github.com/MingPV/ChatService/internal/entities"
+	"github.com/MingPV/ChatService/internal/message/repository"
+	"github.com/MingPV/ChatService/pkg/blobstore"
+)
+
+// reapBatchSize caps how many expired messages are removed per query
+const reapBatchSize = 500
+
+// Reaper deletes disappearing messages once they expire, together with their
+// attachment files, and tells room subscribers to drop them.
+type Reaper struct {
+	repo           repository.MessageRepository
+	attachmentRepo attachmentRepo.AttachmentRepository
+	bookmarkRepo   bookmarkRepo.BookmarkRepository
+	store          blobstore.BlobStore
+	messageUseCase MessageUseCase
+	interval       time.Duration
+}
+
+func NewReaper(repo repository.MessageRepository, attachmentRepo attachmentRepo.AttachmentRepository, bookmarkRepo bookmarkRepo.BookmarkRepository, store blobstore.BlobStore, messageUseCase MessageUseCase, interval time.Duration) *Reaper {
+	if interval <= 0 {
+		interval = 30 * time.Second
+	}
+	return &Reaper{
+		repo:           repo,
+		attachmentRepo: attachmentRepo,
+		bookmarkRepo:   bookmarkRepo,
+		store:          store,
+		messageUseCase: messageUseCase,
+		interval:       interval,
+	}
+}
+
+func (r *Reaper) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				r.reap(ctx)
+			}
+		}
+	}()
+}
+
+func (r *Reaper) reap(ctx context.Context) {
+	for {
+		expired, err := r.repo.FindAllExpired(time.Now().UTC(), reapBatchSize)
+		if err != nil {
+			log.Printf("reaper: failed to load expired messages: %v", err)
+			return
+		}
+		if len(expired) == 0 {
+			return
+		}
+
+		ids := make([]uint, 0, len(expired))
+		for _, m := range expired {
+			ids = append(ids, m.ID)
+			r.removeAttachments(ctx, m)
+		}
+		if err := r.repo.DeleteAllByIDs(ids); err != nil {
+			log.Printf("reaper: failed to delete expired messages: %v", err)
+			return
+		}
+		if err := r.bookmarkRepo.TombstoneAllByMessageIDs(ids); err != nil {
+			log.Printf("reaper: failed to tombstone bookmarks: %v", err)
+		}
+
+		for _, m := range expired {
+			r.messageUseCase.PublishRoomEvent(&entities.RoomEvent{
+				Type:    entities.RoomEventMessageExpired,
+				RoomId:  m.RoomId,
+				Message: &entities.Message{ID: m.ID, RoomId: m.RoomId},
+			})
+		}
+		if len(expired) < reapBatchSize {
+			return
+		}
+	}
+}
+
+func (r *Reaper) removeAttachments(ctx context.Context, message *entities.Message) {
+	for _, a := range message.Attachments {
+		keys := []string{a.StorageKey}
+		for _, t := range a.Thumbnails {
+			keys = append(keys, t.StorageKey)
+		}
+		for _, key := range keys {
+			if key == "" {
+				continue
+			}
+			if err := r.store.Delete(ctx, key); err != nil {
+				log.Printf("reaper: failed to delete blob %s: %v", key, err)
+			}
+		}
+		if err := r.attachmentRepo.Delete(int(a.ID)); err != nil {
+			log.Printf("reaper: failed to delete attachment %d: %v", a.ID, err)
+		}
+	}
+}
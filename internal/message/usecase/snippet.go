@@ -0,0 +1,95 @@
+package usecase
+
+import (
+	"html"
+	"strings"
+	"unicode"
+)
+
+const (
+	snippetRadius = 60 // runes kept on each side of the first match
+	markOpen      = "<mark>"
+	markClose     = "</mark>"
+)
+
+// searchTerms splits a text search into the plain words worth highlighting.
+// Negated words ("-foo") are dropped and phrase quotes are stripped.
+func searchTerms(query string) []string {
+	var terms []string
+	for _, field := range strings.Fields(query) {
+		if strings.HasPrefix(field, "-") {
+			continue
+		}
+		field = strings.Trim(field, `"`)
+		if field != "" {
+			terms = append(terms, strings.ToLower(field))
+		}
+	}
+	return terms
+}
+
+// Snippet cuts text down to a window around the first matched term and wraps
+// every term occurrence inside it in <mark></mark>. Matching is
+// case-insensitive on whole runes so multi-byte text is never split. The rest
+// of the text is HTML-escaped so the snippet can be rendered as markup.
+func Snippet(text string, terms []string) string {
+	runes := []rune(text)
+	lower := make([]rune, len(runes))
+	for i, r := range runes {
+		lower[i] = unicode.ToLower(r)
+	}
+
+	lowerTerms := make([][]rune, 0, len(terms))
+	for _, t := range terms {
+		if t != "" {
+			lowerTerms = append(lowerTerms, []rune(strings.ToLower(t)))
+		}
+	}
+
+	// matchAt returns the length of the longest term starting at i, or 0
+	matchAt := func(i int) int {
+		best := 0
+		for _, t := range lowerTerms {
+			if len(t) > best && i+len(t) <= len(lower) && string(lower[i:i+len(t)]) == string(t) {
+				best = len(t)
+			}
+		}
+		return best
+	}
+
+	first := -1
+	for i := range lower {
+		if matchAt(i) > 0 {
+			first = i
+			break
+		}
+	}
+
+	start, end := 0, len(runes)
+	if first >= 0 {
+		start = max(0, first-snippetRadius)
+		end = min(len(runes), first+snippetRadius)
+	} else if len(runes) > 2*snippetRadius {
+		end = 2 * snippetRadius
+	}
+
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString("…")
+	}
+	for i := start; i < end; {
+		if n := matchAt(i); n > 0 {
+			b.WriteString(markOpen)
+			b.WriteString(html.EscapeString(string(runes[i : i+n])))
+			b.WriteString(markClose)
+			i += n
+			continue
+		}
+		b.WriteString(html.EscapeString(string(runes[i])))
+		i++
+	}
+	if end < len(runes) {
+		b.WriteString("…")
+	}
+	return b.String()
+}
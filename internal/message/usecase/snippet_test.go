@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSnippetHighlightsTerms(t *testing.T) {
+	got := Snippet("Deploy went fine, the deploy script is done", searchTerms(`deploy -fine`))
+	want := "<mark>Deploy</mark> went fine, the <mark>deploy</mark> script is done"
+	if got != want {
+		t.Fatalf("Snippet() = %q, want %q", got, want)
+	}
+}
+
+func TestSnippetTrimsAroundFirstMatch(t *testing.T) {
+	text := strings.Repeat("a ", 100) + "needle" + strings.Repeat(" b", 100)
+	got := Snippet(text, []string{"needle"})
+	if !strings.HasPrefix(got, "…") || !strings.HasSuffix(got, "…") {
+		t.Fatalf("Snippet() = %q, want ellipsis on both ends", got)
+	}
+	if !strings.Contains(got, "<mark>needle</mark>") {
+		t.Fatalf("Snippet() = %q, want highlighted term", got)
+	}
+}
+
+func TestSnippetEscapesMarkup(t *testing.T) {
+	got := Snippet("<b>bold</b> claim", []string{"claim"})
+	want := "&lt;b&gt;bold&lt;/b&gt; <mark>claim</mark>"
+	if got != want {
+		t.Fatalf("Snippet() = %q, want %q", got, want)
+	}
+}
@@ -1,38 +1,188 @@
 package usecase
 
 import (
+	"errors"
+	"log"
+	"strings"
 	"sync"
+	"time"
 
+	attachmentRepo "github.com/MingPV/ChatService/internal/attachment/repository"
+	chatroomRepo "github.com/MingPV/ChatService/internal/chatroom/repository"
 	"github.com/MingPV/ChatService/internal/entities"
+	friendRepo "github.com/MingPV/ChatService/internal/friend/repository"
 	"github.com/MingPV/ChatService/internal/message/repository"
+	roommemberRepo "github.com/MingPV/ChatService/internal/room_member/repository"
+	"github.com/MingPV/ChatService/pkg/apperror"
+	"github.com/MingPV/ChatService/pkg/pagination"
+	"github.com/MingPV/ChatService/pkg/config"
 	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/mongo"
 )
 
+// MessagePolicy holds the per-room limits enforced by MessageService
+type MessagePolicy struct {
+	MaxPinsPerRoom int // 0 or less means no limit
+}
+
+func NewMessagePolicy(cfg *config.Config) MessagePolicy {
+	return MessagePolicy{
+		MaxPinsPerRoom: cfg.MaxPinsPerRoom,
+	}
+}
+
+// pinLimitReached reports whether a room with count pins is full
+func (p MessagePolicy) pinLimitReached(count int64) bool {
+	return p.MaxPinsPerRoom > 0 && count >= int64(p.MaxPinsPerRoom)
+}
+
 type MessageService struct {
 	repo repository.MessageRepository
+	attachmentRepo attachmentRepo.AttachmentRepository
+	roommemberRepo roommemberRepo.RoomMemberRepository
+	chatroomRepo   chatroomRepo.ChatroomRepository
+	blockRepo      friendRepo.BlockRepository
+	restrictionRepo roommemberRepo.RestrictionRepository
+	policy         MessagePolicy
 
-	subscribers map[int][]chan *entities.Message // roomId -> list of channels
+	subscribers     map[int][]chan *entities.RoomEvent       // roomId -> list of channels
+	userSubscribers map[uuid.UUID][]chan *entities.RoomEvent // userId -> list of channels
+	processors  []MessageProcessor
 	mu          sync.RWMutex
 }
 
-func NewMessageService(repo repository.MessageRepository) MessageUseCase {
-	return &MessageService{repo: repo, subscribers: make(map[int][]chan *entities.Message),}
+func NewMessageService(repo repository.MessageRepository, attachmentRepo attachmentRepo.AttachmentRepository, roommemberRepo roommemberRepo.RoomMemberRepository, chatroomRepo chatroomRepo.ChatroomRepository, blockRepo friendRepo.BlockRepository, restrictionRepo roommemberRepo.RestrictionRepository, policy MessagePolicy) MessageUseCase {
+	return &MessageService{
+		repo:            repo,
+		attachmentRepo:  attachmentRepo,
+		roommemberRepo:  roommemberRepo,
+		chatroomRepo:    chatroomRepo,
+		blockRepo:       blockRepo,
+		restrictionRepo: restrictionRepo,
+		policy:          policy,
+		subscribers:     make(map[int][]chan *entities.RoomEvent),
+		userSubscribers: make(map[uuid.UUID][]chan *entities.RoomEvent),
+	}
 }
 
 func (s *MessageService) CreateMessage(message *entities.Message) error {
+	if err := s.resolveAttachments(message); err != nil {
+		return err
+	}
+	if err := s.resolveMentions(message); err != nil {
+		return err
+	}
+	if err := s.applyRoomSettings(message); err != nil {
+		return err
+	}
+
 	if err := s.repo.Save(message); err != nil {
 		return err
 	}
+	if err := s.claimAttachments(message); err != nil {
+		return err
+	}
+	// the message is stored either way, so a stale room list is not worth failing the send
+	at := message.CreatedAt
+	if at.IsZero() {
+		at = time.Now().UTC()
+	}
+	if err := s.chatroomRepo.TouchActivity(int(message.RoomId), message.ID, at); err != nil {
+		log.Printf("failed to record activity in room %d: %v", message.RoomId, err)
+	}
+
+	s.PublishRoomEvent(&entities.RoomEvent{Type: entities.RoomEventMessageCreated, RoomId: message.RoomId, Message: message})
+	s.notifyMentions(message)
+
+	s.mu.RLock()
+	processors := s.processors
+	s.mu.RUnlock()
+	for _, p := range processors {
+		p.Process(message)
+	}
+	return nil
+}
+
+// PostSystemMessage stores and broadcasts a message announcing event. It skips the
+// posting checks and background processors that apply to user messages.
+func (s *MessageService) PostSystemMessage(roomId uint, text string, event *entities.SystemEvent) (*entities.Message, error) {
+	now := time.Now().UTC()
+	message := &entities.Message{
+		RoomId:    roomId,
+		Message:   text,
+		Sender:    event.Actor,
+		System:    event,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := s.repo.Save(message); err != nil {
+		return nil, err
+	}
+	if err := s.chatroomRepo.TouchActivity(int(roomId), message.ID, now); err != nil {
+		log.Printf("failed to record activity in room %d: %v", roomId, err)
+	}
+	s.PublishRoomEvent(&entities.RoomEvent{Type: entities.RoomEventMessageCreated, RoomId: roomId, Message: message})
+	return message, nil
+}
 
+func (s *MessageService) PublishRoomEvent(event *entities.RoomEvent) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
-	for _, ch := range s.subscribers[int(message.RoomId)] {
+	for _, ch := range s.subscribers[int(event.RoomId)] {
 		select {
-		case ch <- message: // non-blocking
+		case ch <- event: // non-blocking
 		default:
 		}
 	}
-	return nil
+}
+
+func (s *MessageService) NotifyUser(userId uuid.UUID, event *entities.RoomEvent) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, ch := range s.userSubscribers[userId] {
+		select {
+		case ch <- event: // non-blocking
+		default:
+		}
+	}
+}
+
+func (s *MessageService) IsOnline(userId uuid.UUID) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.userSubscribers[userId]) > 0
+}
+
+func (s *MessageService) SubscribeUser(userId uuid.UUID) (<-chan *entities.RoomEvent, func()) {
+	ch := make(chan *entities.RoomEvent, 10)
+
+	s.mu.Lock()
+	s.userSubscribers[userId] = append(s.userSubscribers[userId], ch)
+	s.mu.Unlock()
+
+	cleanup := func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		channels := s.userSubscribers[userId]
+		for i, c := range channels {
+			if c == ch {
+				s.userSubscribers[userId] = append(channels[:i], channels[i+1:]...)
+				close(c)
+				break
+			}
+		}
+		if len(s.userSubscribers[userId]) == 0 {
+			delete(s.userSubscribers, userId)
+		}
+	}
+
+	return ch, cleanup
+}
+
+func (s *MessageService) RegisterProcessor(p MessageProcessor) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.processors = append(s.processors, p)
 }
 
 func (s *MessageService) FindAllByRoomID(roomId int) ([]*entities.Message, error) {
@@ -43,8 +193,8 @@ func (s *MessageService) FindAllByRoomID(roomId int) ([]*entities.Message, error
 	return messages, nil
 } 
 
-func (s *MessageService) SubscribeRoom(roomId int) (<-chan *entities.Message, func()) {
-	ch := make(chan *entities.Message, 10) // buffered channel
+func (s *MessageService) SubscribeRoom(roomId int) (<-chan *entities.RoomEvent, func()) {
+	ch := make(chan *entities.RoomEvent, 10) // buffered channel
 
 	// Add channel to subscribers
 	s.mu.Lock()
@@ -90,4 +240,360 @@ func (s *MessageService) FindAllMessagesUnread(userId uuid.UUID, roomId int) ([]
 		return nil, err
 	}
 	return messages, nil
-}
\ No newline at end of file
+}
+
+func (s *MessageService) FindAllMentions(userId uuid.UUID, page, pageSize int) ([]*entities.Message, int64, error) {
+	roomIds, err := s.memberRoomIds(userId)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(roomIds) == 0 {
+		return []*entities.Message{}, 0, nil
+	}
+
+	offset, limit := pagination.OffsetLimit(page, pageSize)
+	return s.repo.FindAllMentions(userId, roomIds, offset, limit)
+}
+
+func (s *MessageService) SearchMessages(userId uuid.UUID, query *entities.MessageSearchQuery) ([]*entities.MessageSearchHit, int64, error) {
+	query.Text = strings.TrimSpace(query.Text)
+	if query.Text == "" {
+		return nil, 0, apperror.ErrRequiredField
+	}
+	switch query.Sort {
+	case "":
+		query.Sort = entities.MessageSearchByRelevance
+	case entities.MessageSearchByRelevance, entities.MessageSearchByRecent:
+	default:
+		return nil, 0, apperror.ErrInvalidData
+	}
+	if !query.From.IsZero() && !query.To.IsZero() && query.From.After(query.To) {
+		return nil, 0, apperror.ErrInvalidData
+	}
+
+	roomIds, err := s.memberRoomIds(userId)
+	if err != nil {
+		return nil, 0, err
+	}
+	if query.RoomId != 0 {
+		found := false
+		for _, id := range roomIds {
+			if id == query.RoomId {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, 0, apperror.ErrForbidden
+		}
+		roomIds = []uint{query.RoomId}
+	}
+	if len(roomIds) == 0 {
+		return []*entities.MessageSearchHit{}, 0, nil
+	}
+
+	offset, limit := pagination.OffsetLimit(query.Page, query.PageSize)
+	hits, total, err := s.repo.Search(query, roomIds, offset, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	terms := searchTerms(query.Text)
+	for _, h := range hits {
+		h.Snippet = Snippet(h.Message.Message, terms)
+	}
+	return hits, total, nil
+}
+
+func (s *MessageService) PinMessage(messageId int, userId uuid.UUID) (*entities.Message, error) {
+	message, err := s.repo.FindByID(messageId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkCanPin(message.RoomId, userId); err != nil {
+		return nil, err
+	}
+	if message.Pin != nil {
+		return message, nil
+	}
+
+	if s.policy.MaxPinsPerRoom > 0 {
+		count, err := s.repo.CountPinnedByRoomID(message.RoomId)
+		if err != nil {
+			return nil, err
+		}
+		if s.policy.pinLimitReached(count) {
+			return nil, apperror.ErrLimitExceeded
+		}
+	}
+
+	message.Pin = &entities.MessagePin{PinnedBy: userId, PinnedAt: time.Now().UTC()}
+	if err := s.repo.UpdatePin(message.ID, message.Pin); err != nil {
+		return nil, err
+	}
+	if s.policy.MaxPinsPerRoom > 0 {
+		// pins made at the same time all pass the check above, so count again and back out when over
+		count, err := s.repo.CountPinnedByRoomID(message.RoomId)
+		if err == nil && count > int64(s.policy.MaxPinsPerRoom) {
+			err = apperror.ErrLimitExceeded
+		}
+		if err != nil {
+			if undoErr := s.repo.UpdatePin(message.ID, nil); undoErr != nil {
+				log.Printf("failed to unpin message %d over the pin limit: %v", message.ID, undoErr)
+			}
+			return nil, err
+		}
+	}
+	s.PublishRoomEvent(&entities.RoomEvent{Type: entities.RoomEventMessagePinned, RoomId: message.RoomId, Message: message})
+	return message, nil
+}
+
+func (s *MessageService) UnpinMessage(messageId int, userId uuid.UUID) (*entities.Message, error) {
+	message, err := s.repo.FindByID(messageId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkCanPin(message.RoomId, userId); err != nil {
+		return nil, err
+	}
+	if message.Pin == nil {
+		return message, nil
+	}
+
+	message.Pin = nil
+	if err := s.repo.UpdatePin(message.ID, nil); err != nil {
+		return nil, err
+	}
+	s.PublishRoomEvent(&entities.RoomEvent{Type: entities.RoomEventMessageUnpinned, RoomId: message.RoomId, Message: message})
+	return message, nil
+}
+
+func (s *MessageService) FindPinnedMessages(roomId uint, userId uuid.UUID) ([]*entities.Message, error) {
+	if _, _, err := s.roleIn(roomId, userId); err != nil {
+		return nil, err
+	}
+	return s.repo.FindAllPinnedByRoomID(roomId)
+}
+
+func (s *MessageService) RemoveMessage(messageId uint) (*entities.Message, error) {
+	message, err := s.repo.FindByID(int(messageId))
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.Expire(message.ID, time.Now().UTC()); err != nil {
+		return nil, err
+	}
+	s.PublishRoomEvent(&entities.RoomEvent{Type: entities.RoomEventMessageDeleted, RoomId: message.RoomId, Message: &entities.Message{ID: message.ID, RoomId: message.RoomId}})
+	return message, nil
+}
+
+// checkCanPin allows any member to pin in a direct chat; group rooms require an owner or admin
+func (s *MessageService) checkCanPin(roomId uint, userId uuid.UUID) error {
+	room, role, err := s.roleIn(roomId, userId)
+	if err != nil {
+		return err
+	}
+	if room.IsGroup && !role.CanModerate() {
+		return apperror.ErrForbidden
+	}
+	return nil
+}
+
+// roleIn returns the room and the caller's role in it, or ErrForbidden for non-members
+func (s *MessageService) roleIn(roomId uint, userId uuid.UUID) (*entities.Chatroom, entities.RoomRole, error) {
+	room, err := s.chatroomRepo.FindByID(int(roomId))
+	if err != nil {
+		return nil, "", err
+	}
+	role, err := roommemberRepo.RoleIn(s.roommemberRepo, room, userId)
+	if err != nil {
+		return nil, "", err
+	}
+	return room, role, nil
+}
+
+// memberRoomIds lists the rooms userId belongs to
+func (s *MessageService) memberRoomIds(userId uuid.UUID) ([]uint, error) {
+	members, err := s.roommemberRepo.FindAllByUserID(userId)
+	if err != nil {
+		return nil, err
+	}
+	roomIds := make([]uint, 0, len(members))
+	for _, m := range members {
+		roomIds = append(roomIds, m.RoomId)
+	}
+	return roomIds, nil
+}
+
+func (s *MessageService) FindUnreadCounts(userId uuid.UUID) ([]*entities.UnreadCount, error) {
+	members, err := s.roommemberRepo.FindAllByUserID(userId)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make([]*entities.UnreadCount, 0, len(members))
+	for _, m := range members {
+		unread, mentions, err := s.repo.CountUnread(userId, int(m.RoomId))
+		if err != nil {
+			return nil, err
+		}
+		counts = append(counts, &entities.UnreadCount{RoomId: m.RoomId, Unread: unread, Mentions: mentions})
+	}
+	return counts, nil
+}
+
+// applyRoomSettings enforces the room's posting policy and moderator mutes, and stamps ExpiresAt
+// on messages sent to rooms with disappearing messages
+func (s *MessageService) applyRoomSettings(message *entities.Message) error {
+	room, err := s.chatroomRepo.FindByID(int(message.RoomId))
+	if err != nil {
+		return err
+	}
+	if !room.IsGroup {
+		if err := s.checkBlocked(message); err != nil {
+			return err
+		}
+	}
+	restricted, err := s.restrictionRepo.ExistsActive(message.RoomId, message.Sender, entities.RestrictionMute, entities.RestrictionBan)
+	if err != nil {
+		return err
+	}
+	if restricted {
+		return apperror.ErrForbidden
+	}
+	if room.PostingPolicy == entities.PostingPolicyModerators {
+		if err := roommemberRepo.CheckCanModerate(s.roommemberRepo, room, message.Sender); err != nil {
+			return err
+		}
+	}
+	if room.MessageTTL > 0 {
+		sentAt := message.CreatedAt
+		if sentAt.IsZero() {
+			sentAt = time.Now().UTC()
+		}
+		expiresAt := sentAt.Add(time.Duration(room.MessageTTL) * time.Second)
+		message.ExpiresAt = &expiresAt
+	}
+	return nil
+}
+
+// checkBlocked refuses messages in a 1:1 room once either side has blocked the other
+func (s *MessageService) checkBlocked(message *entities.Message) error {
+	members, err := s.roommemberRepo.FindAllByRoomID(message.RoomId)
+	if err != nil {
+		return err
+	}
+	for _, m := range members {
+		if m.UserId == message.Sender {
+			continue
+		}
+		blocked, err := s.blockRepo.ExistsBetween(message.Sender, m.UserId)
+		if err != nil {
+			return err
+		}
+		if blocked {
+			return apperror.ErrForbidden
+		}
+	}
+	return nil
+}
+
+// resolveMentions parses the message text and records who it mentions.
+// Every directly mentioned user must be a member of the room.
+func (s *MessageService) resolveMentions(message *entities.Message) error {
+	users, room, here := ParseMentions(message.Message)
+	for _, userId := range users {
+		if _, err := s.roommemberRepo.FindAllByRoomIDAndUserID(message.RoomId, userId); err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				return apperror.ErrInvalidData
+			}
+			return err
+		}
+	}
+	message.Mentions = users
+	message.MentionRoom = room
+	message.MentionHere = here
+	message.HereMentions = nil
+	if here {
+		// who counts as mentioned by @here is fixed when the message is sent
+		members, err := s.roommemberRepo.FindAllByRoomID(message.RoomId)
+		if err != nil {
+			return err
+		}
+		for _, m := range members {
+			if m.UserId != message.Sender && s.IsOnline(m.UserId) {
+				message.HereMentions = append(message.HereMentions, m.UserId)
+			}
+		}
+	}
+	return nil
+}
+
+// notifyMentions pushes a mention event to every user the message mentions.
+// @room fans out to all room members and @here to the members that were online.
+func (s *MessageService) notifyMentions(message *entities.Message) {
+	targets := message.Mentions
+	if message.MentionRoom || message.MentionHere {
+		members, err := s.roommemberRepo.FindAllByRoomID(message.RoomId)
+		if err != nil {
+			return
+		}
+		targets = mentionTargets(message, members, time.Now().UTC())
+	}
+
+	event := &entities.RoomEvent{Type: entities.RoomEventMentioned, RoomId: message.RoomId, Message: message}
+	for _, userId := range targets {
+		if userId == message.Sender {
+			continue
+		}
+		s.NotifyUser(userId, event)
+	}
+}
+
+// resolveAttachments replaces the attachment ids on message with the stored
+// metadata. Only unused uploads made by the sender into the same room qualify.
+func (s *MessageService) resolveAttachments(message *entities.Message) error {
+	seen := make(map[uint]bool, len(message.Attachments))
+	for i, a := range message.Attachments {
+		if seen[a.ID] {
+			return apperror.ErrInvalidData
+		}
+		seen[a.ID] = true
+		stored, err := s.attachmentRepo.FindByID(int(a.ID))
+		if err != nil {
+			return err
+		}
+		if stored.RoomId != message.RoomId || stored.Uploader != message.Sender {
+			return apperror.ErrForbidden
+		}
+		if stored.MessageId != 0 {
+			return apperror.ErrAlreadyExists
+		}
+		message.Attachments[i] = *stored
+	}
+	return nil
+}
+
+// claimAttachments links the message's attachments to the saved message. The
+// check in resolveAttachments can race with another send, so if an attachment
+// was claimed in between the message is deleted again and the send fails.
+func (s *MessageService) claimAttachments(message *entities.Message) error {
+	if len(message.Attachments) == 0 {
+		return nil
+	}
+	ids := make([]uint, 0, len(message.Attachments))
+	for _, a := range message.Attachments {
+		ids = append(ids, a.ID)
+	}
+	err := s.attachmentRepo.AttachToMessage(ids, message.ID)
+	if err == nil {
+		return nil
+	}
+	if delErr := s.repo.DeleteAllByIDs([]uint{message.ID}); delErr != nil {
+		log.Printf("failed to undo message %d: %v", message.ID, delErr)
+	}
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return apperror.ErrAlreadyExists
+	}
+	return err
+}
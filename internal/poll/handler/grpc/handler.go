@@ -0,0 +1,137 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/MingPV/ChatService/internal/entities"
+	"github.com/MingPV/ChatService/internal/poll/usecase"
+	"github.com/MingPV/ChatService/pkg/apperror"
+	pollpb "github.com/MingPV/ChatService/proto/poll"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type GrpcPollHandler struct {
+	pollUseCase usecase.PollUseCase
+	pollpb.UnimplementedPollServiceServer
+}
+
+func NewGrpcPollHandler(uc usecase.PollUseCase) *GrpcPollHandler {
+	return &GrpcPollHandler{pollUseCase: uc}
+}
+
+func (h *GrpcPollHandler) CreatePoll(ctx context.Context, req *pollpb.CreatePollRequest) (*pollpb.CreatePollResponse, error) {
+	creator, err := uuid.Parse(req.Creator)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+	poll := &entities.Poll{
+		RoomId:         uint(req.RoomId),
+		Creator:        creator,
+		Question:       req.Question,
+		MultipleChoice: req.MultipleChoice,
+		Anonymous:      req.Anonymous,
+	}
+	for _, text := range req.Options {
+		poll.Options = append(poll.Options, entities.PollOption{Text: text})
+	}
+	if req.ClosesAt != nil {
+		closesAt := req.ClosesAt.AsTime()
+		poll.ClosesAt = &closesAt
+	}
+
+	if err := h.pollUseCase.CreatePoll(poll); err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+	return &pollpb.CreatePollResponse{Poll: toProtoPoll(poll)}, nil
+}
+
+func (h *GrpcPollHandler) CastVote(ctx context.Context, req *pollpb.CastVoteRequest) (*pollpb.CastVoteResponse, error) {
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+	optionIds := make([]int, 0, len(req.OptionIds))
+	for _, id := range req.OptionIds {
+		optionIds = append(optionIds, int(id))
+	}
+	poll, err := h.pollUseCase.CastVote(int(req.PollId), userId, optionIds)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+	return &pollpb.CastVoteResponse{Poll: toProtoPoll(poll)}, nil
+}
+
+func (h *GrpcPollHandler) RetractVote(ctx context.Context, req *pollpb.RetractVoteRequest) (*pollpb.RetractVoteResponse, error) {
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+	poll, err := h.pollUseCase.RetractVote(int(req.PollId), userId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+	return &pollpb.RetractVoteResponse{Poll: toProtoPoll(poll)}, nil
+}
+
+func (h *GrpcPollHandler) ClosePoll(ctx context.Context, req *pollpb.ClosePollRequest) (*pollpb.ClosePollResponse, error) {
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+	poll, err := h.pollUseCase.ClosePoll(int(req.PollId), userId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+	return &pollpb.ClosePollResponse{Poll: toProtoPoll(poll)}, nil
+}
+
+func (h *GrpcPollHandler) FindPollResults(ctx context.Context, req *pollpb.FindPollResultsRequest) (*pollpb.FindPollResultsResponse, error) {
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+	poll, mine, err := h.pollUseCase.FindPollResults(int(req.PollId), userId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+
+	myVotes := make([]int32, 0, len(mine))
+	for _, id := range mine {
+		myVotes = append(myVotes, int32(id))
+	}
+	return &pollpb.FindPollResultsResponse{Poll: toProtoPoll(poll), MyVotes: myVotes}, nil
+}
+
+func toProtoPoll(p *entities.Poll) *pollpb.Poll {
+	out := &pollpb.Poll{
+		Id:             int32(p.ID),
+		RoomId:         int32(p.RoomId),
+		MessageId:      int32(p.MessageId),
+		Creator:        p.Creator.String(),
+		Question:       p.Question,
+		MultipleChoice: p.MultipleChoice,
+		Anonymous:      p.Anonymous,
+		ClosesAt:       toProtoTime(p.ClosesAt),
+		ClosedAt:       toProtoTime(p.ClosedAt),
+		TotalVoters:    p.TotalVoters,
+		CreatedAt:      timestamppb.New(p.CreatedAt),
+	}
+	for _, o := range p.Options {
+		option := &pollpb.PollOption{Id: int32(o.ID), Text: o.Text, VoteCount: o.VoteCount}
+		for _, v := range o.Voters {
+			option.Voters = append(option.Voters, v.String())
+		}
+		out.Options = append(out.Options, option)
+	}
+	return out
+}
+
+func toProtoTime(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
@@ -0,0 +1,191 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/MingPV/ChatService/internal/entities"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type MongoPollRepository struct {
+	db    *mongo.Database
+	coll  *mongo.Collection
+	votes *mongo.Collection
+}
+
+func NewMongoPollRepository(db *mongo.Database) PollRepository {
+	return &MongoPollRepository{
+		db:    db,
+		coll:  db.Collection("polls"),
+		votes: db.Collection("poll_ballots"),
+	}
+}
+
+// ballotDoc holds all of one user's choices on a poll, so replacing them is a single write
+type ballotDoc struct {
+	PollId    uint      `bson:"poll_id"`
+	UserId    uuid.UUID `bson:"user_id"`
+	OptionIds []int     `bson:"option_ids"`
+	CreatedAt time.Time `bson:"created_at"`
+	UpdatedAt time.Time `bson:"updated_at"`
+}
+
+type counterDoc struct {
+	ID  string `bson:"_id"`
+	Seq int    `bson:"seq"`
+}
+
+// EnsureIndexes keeps one ballot per user and poll
+func (r *MongoPollRepository) EnsureIndexes() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	_, err := r.votes.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "poll_id", Value: 1}, {Key: "user_id", Value: 1}},
+		Options: options.Index().SetUnique(true).SetName("poll_user_unique"),
+	})
+	return err
+}
+
+func (r *MongoPollRepository) Save(poll *entities.Poll) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	nextID, err := r.getNextSequence(ctx, "polls")
+	if err != nil {
+		return err
+	}
+	poll.ID = uint(nextID)
+
+	_, err = r.coll.InsertOne(ctx, poll)
+	return err
+}
+
+func (r *MongoPollRepository) FindByID(id int) (*entities.Poll, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var poll entities.Poll
+	err := r.coll.FindOne(ctx, bson.M{"_id": id}).Decode(&poll)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return &entities.Poll{}, err
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &poll, nil
+}
+
+func (r *MongoPollRepository) UpdateMessageID(id uint, messageId uint) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := r.coll.UpdateByID(ctx, id, bson.M{"$set": bson.M{"message_id": messageId}})
+	return err
+}
+
+// Close marks the poll closed unless it already is
+func (r *MongoPollRepository) Close(id uint, closedAt time.Time) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := r.coll.UpdateOne(ctx,
+		bson.M{"_id": id, "closed_at": bson.M{"$exists": false}},
+		bson.M{"$set": bson.M{"closed_at": closedAt, "updated_at": closedAt}},
+	)
+	return err
+}
+
+func (r *MongoPollRepository) Delete(id uint) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if _, err := r.votes.DeleteMany(ctx, bson.M{"poll_id": id}); err != nil {
+		return err
+	}
+	_, err := r.coll.DeleteOne(ctx, bson.M{"_id": id})
+	return err
+}
+
+func (r *MongoPollRepository) ReplaceVotes(pollId uint, userId uuid.UUID, optionIds []int) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	now := time.Now().UTC()
+	filter := bson.M{"poll_id": pollId, "user_id": userId}
+	update := bson.M{
+		"$set":         bson.M{"option_ids": optionIds, "updated_at": now},
+		"$setOnInsert": bson.M{"created_at": now},
+	}
+	_, err := r.votes.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	if mongo.IsDuplicateKeyError(err) {
+		// a concurrent first vote inserted the ballot; this one now updates it
+		_, err = r.votes.UpdateOne(ctx, filter, update)
+	}
+	return err
+}
+
+func (r *MongoPollRepository) DeleteVotes(pollId uint, userId uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := r.votes.DeleteOne(ctx, bson.M{"poll_id": pollId, "user_id": userId})
+	return err
+}
+
+func (r *MongoPollRepository) FindAllVotes(pollId uint) ([]*entities.PollVote, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	cur, err := r.votes.Find(ctx, bson.M{"poll_id": pollId}, options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var votes []*entities.PollVote
+	for cur.Next(ctx) {
+		var b ballotDoc
+		if err := cur.Decode(&b); err != nil {
+			return nil, err
+		}
+		for _, optionId := range b.OptionIds {
+			votes = append(votes, &entities.PollVote{PollId: b.PollId, OptionId: optionId, UserId: b.UserId, CreatedAt: b.CreatedAt})
+		}
+	}
+	return votes, cur.Err()
+}
+
+// getNextSequence generates auto-increment ID
+func (r *MongoPollRepository) getNextSequence(ctx context.Context, name string) (int, error) {
+	counters := r.db.Collection("counters")
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+
+	var out counterDoc
+	err := counters.FindOneAndUpdate(
+		ctx,
+		bson.M{"_id": name},
+		bson.M{"$inc": bson.M{"seq": 1}},
+		opts,
+	).Decode(&out)
+
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		_, ierr := counters.InsertOne(ctx, counterDoc{ID: name, Seq: 1})
+		if ierr != nil {
+			return 0, ierr
+		}
+		return 1, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if out.Seq == 0 {
+		return 1, nil
+	}
+	return out.Seq, nil
+}
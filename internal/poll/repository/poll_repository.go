@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/MingPV/ChatService/internal/entities"
+	"github.com/google/uuid"
+)
+
+type PollRepository interface {
+	Save(poll *entities.Poll) error
+	FindByID(id int) (*entities.Poll, error)
+	UpdateMessageID(id uint, messageId uint) error
+	Close(id uint, closedAt time.Time) error
+	Delete(id uint) error
+
+	// ReplaceVotes swaps all of userId's votes on the poll for optionIds in one write,
+	// so concurrent votes never leave a user with a mix of old and new choices.
+	ReplaceVotes(pollId uint, userId uuid.UUID, optionIds []int) error
+	DeleteVotes(pollId uint, userId uuid.UUID) error
+	FindAllVotes(pollId uint) ([]*entities.PollVote, error)
+	EnsureIndexes() error
+}
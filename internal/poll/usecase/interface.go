@@ -0,0 +1,17 @@
+package usecase
+
+import (
+	"github.com/MingPV/ChatService/internal/entities"
+	"github.com/google/uuid"
+)
+
+type PollUseCase interface {
+	// CreatePoll stores the poll and posts it into the room as a message.
+	CreatePoll(poll *entities.Poll) error
+	// CastVote replaces the user's current votes with optionIds.
+	CastVote(pollId int, userId uuid.UUID, optionIds []int) (*entities.Poll, error)
+	RetractVote(pollId int, userId uuid.UUID) (*entities.Poll, error)
+	ClosePoll(pollId int, userId uuid.UUID) (*entities.Poll, error)
+	// FindPollResults returns the poll with counts and the options userId voted for.
+	FindPollResults(pollId int, userId uuid.UUID) (*entities.Poll, []int, error)
+}
@@ -0,0 +1,265 @@
+package usecase
+
+import (
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	chatroomRepo "github.com/MingPV/ChatService/internal/chatroom/repository"
+	"github.com/MingPV/ChatService/internal/entities"
+	messageUseCase "github.com/MingPV/ChatService/internal/message/usecase"
+	pollRepo "github.com/MingPV/ChatService/internal/poll/repository"
+	roommemberRepo "github.com/MingPV/ChatService/internal/room_member/repository"
+	"github.com/MingPV/ChatService/pkg/apperror"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	minPollOptions = 2
+	maxPollOptions = 10
+	maxPollText    = 300
+)
+
+// PollService implements PollUseCase
+type PollService struct {
+	pollRepo       pollRepo.PollRepository
+	roommemberRepo roommemberRepo.RoomMemberRepository
+	chatroomRepo   chatroomRepo.ChatroomRepository
+	messageUseCase messageUseCase.MessageUseCase
+}
+
+func NewPollService(pollRepo pollRepo.PollRepository, roommemberRepo roommemberRepo.RoomMemberRepository, chatroomRepo chatroomRepo.ChatroomRepository, messageUseCase messageUseCase.MessageUseCase) PollUseCase {
+	return &PollService{pollRepo: pollRepo, roommemberRepo: roommemberRepo, chatroomRepo: chatroomRepo, messageUseCase: messageUseCase}
+}
+
+func (s *PollService) CreatePoll(poll *entities.Poll) error {
+	if err := validatePoll(poll); err != nil {
+		return err
+	}
+	if _, err := s.member(poll.RoomId, poll.Creator); err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	poll.CreatedAt = now
+	poll.UpdatedAt = now
+	poll.ClosedAt = nil
+	if err := s.pollRepo.Save(poll); err != nil {
+		return err
+	}
+
+	message := &entities.Message{
+		RoomId:    poll.RoomId,
+		Message:   poll.Question,
+		Sender:    poll.Creator,
+		PollId:    poll.ID,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := s.messageUseCase.CreateMessage(message); err != nil {
+		if derr := s.pollRepo.Delete(poll.ID); derr != nil {
+			log.Printf("failed to remove poll %d after message error: %v", poll.ID, derr)
+		}
+		return err
+	}
+
+	poll.MessageId = message.ID
+	return s.pollRepo.UpdateMessageID(poll.ID, message.ID)
+}
+
+func (s *PollService) CastVote(pollId int, userId uuid.UUID, optionIds []int) (*entities.Poll, error) {
+	poll, err := s.openPollForMember(pollId, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	optionIds = dedupe(optionIds)
+	if len(optionIds) == 0 || (!poll.MultipleChoice && len(optionIds) > 1) {
+		return nil, apperror.ErrInvalidData
+	}
+	valid := make(map[int]bool, len(poll.Options))
+	for _, o := range poll.Options {
+		valid[o.ID] = true
+	}
+	for _, id := range optionIds {
+		if !valid[id] {
+			return nil, apperror.ErrInvalidData
+		}
+	}
+
+	if err := s.pollRepo.ReplaceVotes(poll.ID, userId, optionIds); err != nil {
+		return nil, err
+	}
+	return s.publishResults(poll)
+}
+
+func (s *PollService) RetractVote(pollId int, userId uuid.UUID) (*entities.Poll, error) {
+	poll, err := s.openPollForMember(pollId, userId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.pollRepo.DeleteVotes(poll.ID, userId); err != nil {
+		return nil, err
+	}
+	return s.publishResults(poll)
+}
+
+// ClosePoll is allowed for the poll creator and room moderators
+func (s *PollService) ClosePoll(pollId int, userId uuid.UUID) (*entities.Poll, error) {
+	poll, err := s.pollRepo.FindByID(pollId)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.member(poll.RoomId, userId); err != nil {
+		return nil, err
+	}
+	if poll.Creator != userId {
+		room, err := s.chatroomRepo.FindByID(int(poll.RoomId))
+		if err != nil {
+			return nil, err
+		}
+		if err := roommemberRepo.CheckCanModerate(s.roommemberRepo, room, userId); err != nil {
+			return nil, err
+		}
+	}
+	if poll.ClosedAt != nil {
+		return nil, apperror.ErrNotAvailable
+	}
+
+	now := time.Now().UTC()
+	if err := s.pollRepo.Close(poll.ID, now); err != nil {
+		return nil, err
+	}
+	poll.ClosedAt = &now
+	poll.UpdatedAt = now
+	return s.publishResults(poll)
+}
+
+func (s *PollService) FindPollResults(pollId int, userId uuid.UUID) (*entities.Poll, []int, error) {
+	poll, err := s.pollRepo.FindByID(pollId)
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, err := s.member(poll.RoomId, userId); err != nil {
+		return nil, nil, err
+	}
+
+	votes, err := s.pollRepo.FindAllVotes(poll.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	tally(poll, votes)
+
+	var mine []int
+	for _, v := range votes {
+		if v.UserId == userId {
+			mine = append(mine, v.OptionId)
+		}
+	}
+	return poll, mine, nil
+}
+
+// publishResults recounts the votes and pushes the poll to room subscribers
+func (s *PollService) publishResults(poll *entities.Poll) (*entities.Poll, error) {
+	votes, err := s.pollRepo.FindAllVotes(poll.ID)
+	if err != nil {
+		return nil, err
+	}
+	tally(poll, votes)
+	s.messageUseCase.PublishRoomEvent(&entities.RoomEvent{Type: entities.RoomEventPollUpdated, RoomId: poll.RoomId, Poll: poll})
+	return poll, nil
+}
+
+func (s *PollService) openPollForMember(pollId int, userId uuid.UUID) (*entities.Poll, error) {
+	poll, err := s.pollRepo.FindByID(pollId)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.member(poll.RoomId, userId); err != nil {
+		return nil, err
+	}
+	if !poll.IsOpen(time.Now()) {
+		return nil, apperror.ErrNotAvailable
+	}
+	return poll, nil
+}
+
+// member returns the caller's membership, or ErrForbidden for non-members
+func (s *PollService) member(roomId uint, userId uuid.UUID) (*entities.RoomMember, error) {
+	member, err := s.roommemberRepo.FindAllByRoomIDAndUserID(roomId, userId)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, apperror.ErrForbidden
+	}
+	if err != nil {
+		return nil, err
+	}
+	return member, nil
+}
+
+// tally fills option counts from votes; voters are only listed for non-anonymous polls
+func tally(poll *entities.Poll, votes []*entities.PollVote) {
+	index := make(map[int]int, len(poll.Options))
+	for i := range poll.Options {
+		poll.Options[i].VoteCount = 0
+		poll.Options[i].Voters = nil
+		index[poll.Options[i].ID] = i
+	}
+
+	voters := make(map[uuid.UUID]bool)
+	for _, v := range votes {
+		i, ok := index[v.OptionId]
+		if !ok {
+			continue
+		}
+		poll.Options[i].VoteCount++
+		if !poll.Anonymous {
+			poll.Options[i].Voters = append(poll.Options[i].Voters, v.UserId)
+		}
+		voters[v.UserId] = true
+	}
+	poll.TotalVoters = int64(len(voters))
+}
+
+// validatePoll trims the question and options and numbers the options from 1
+func validatePoll(poll *entities.Poll) error {
+	poll.Question = strings.TrimSpace(poll.Question)
+	if poll.Question == "" {
+		return apperror.ErrRequiredField
+	}
+	if len([]rune(poll.Question)) > maxPollText {
+		return apperror.ErrInvalidData
+	}
+	if len(poll.Options) < minPollOptions || len(poll.Options) > maxPollOptions {
+		return apperror.ErrOutOfRange
+	}
+
+	seen := make(map[string]bool, len(poll.Options))
+	for i := range poll.Options {
+		text := strings.TrimSpace(poll.Options[i].Text)
+		key := strings.ToLower(text)
+		if text == "" || len([]rune(text)) > maxPollText || seen[key] {
+			return apperror.ErrInvalidData
+		}
+		seen[key] = true
+		poll.Options[i] = entities.PollOption{ID: i + 1, Text: text}
+	}
+
+	if poll.ClosesAt != nil && !poll.ClosesAt.After(time.Now()) {
+		return apperror.ErrOutOfRange
+	}
+	return nil
+}
+
+func dedupe(ids []int) []int {
+	seen := make(map[int]bool, len(ids))
+	var out []int
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			out = append(out, id)
+		}
+	}
+	return out
+}
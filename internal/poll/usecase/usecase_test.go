@@ -0,0 +1,167 @@
+package usecase
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MingPV/ChatService/internal/entities"
+	messageUseCase "github.com/MingPV/ChatService/internal/message/usecase"
+	pollRepo "github.com/MingPV/ChatService/internal/poll/repository"
+	"github.com/MingPV/ChatService/internal/testsupport"
+	"github.com/MingPV/ChatService/pkg/apperror"
+	"github.com/google/uuid"
+)
+
+type fakePolls struct {
+	pollRepo.PollRepository
+	poll  *entities.Poll
+	votes []*entities.PollVote
+}
+
+func (f *fakePolls) FindByID(id int) (*entities.Poll, error) {
+	copied := *f.poll
+	copied.Options = append([]entities.PollOption(nil), f.poll.Options...)
+	return &copied, nil
+}
+
+func (f *fakePolls) ReplaceVotes(pollId uint, userId uuid.UUID, optionIds []int) error {
+	kept := f.votes[:0]
+	for _, v := range f.votes {
+		if v.UserId != userId {
+			kept = append(kept, v)
+		}
+	}
+	f.votes = kept
+	for _, id := range optionIds {
+		f.votes = append(f.votes, &entities.PollVote{PollId: pollId, OptionId: id, UserId: userId})
+	}
+	return nil
+}
+
+func (f *fakePolls) FindAllVotes(pollId uint) ([]*entities.PollVote, error) {
+	return f.votes, nil
+}
+
+type fakeMessages struct {
+	messageUseCase.MessageUseCase
+	published int
+}
+
+func (f *fakeMessages) PublishRoomEvent(event *entities.RoomEvent) {
+	f.published++
+}
+
+func options(texts ...string) []entities.PollOption {
+	out := make([]entities.PollOption, len(texts))
+	for i, t := range texts {
+		out[i] = entities.PollOption{Text: t}
+	}
+	return out
+}
+
+func TestValidatePoll(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	tests := []struct {
+		name    string
+		poll    *entities.Poll
+		wantErr error
+	}{
+		{"valid", &entities.Poll{Question: " Lunch? ", Options: options(" pizza ", "sushi")}, nil},
+		{"missing question", &entities.Poll{Question: "  ", Options: options("a", "b")}, apperror.ErrRequiredField},
+		{"question too long", &entities.Poll{Question: strings.Repeat("q", maxPollText+1), Options: options("a", "b")}, apperror.ErrInvalidData},
+		{"one option", &entities.Poll{Question: "q", Options: options("a")}, apperror.ErrOutOfRange},
+		{"duplicate options", &entities.Poll{Question: "q", Options: options("Yes", "yes")}, apperror.ErrInvalidData},
+		{"blank option", &entities.Poll{Question: "q", Options: options("a", " ")}, apperror.ErrInvalidData},
+		{"closes in the past", &entities.Poll{Question: "q", Options: options("a", "b"), ClosesAt: &past}, apperror.ErrOutOfRange},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validatePoll(tt.poll)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("validatePoll = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if tt.poll.Question != "Lunch?" || tt.poll.Options[0].ID != 1 || tt.poll.Options[0].Text != "pizza" || tt.poll.Options[1].ID != 2 {
+				t.Fatalf("poll not normalized: %+v", tt.poll)
+			}
+		})
+	}
+}
+
+func TestTally(t *testing.T) {
+	a, b := uuid.New(), uuid.New()
+	votes := []*entities.PollVote{
+		{OptionId: 1, UserId: a},
+		{OptionId: 2, UserId: a},
+		{OptionId: 2, UserId: b},
+		{OptionId: 9, UserId: b}, // option no longer exists
+	}
+	tests := []struct {
+		name       string
+		anonymous  bool
+		wantVoters int
+	}{
+		{"named", false, 2},
+		{"anonymous", true, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			poll := &entities.Poll{Anonymous: tt.anonymous, Options: []entities.PollOption{{ID: 1}, {ID: 2, VoteCount: 7}}}
+			tally(poll, votes)
+
+			if poll.Options[0].VoteCount != 1 || poll.Options[1].VoteCount != 2 || poll.TotalVoters != 2 {
+				t.Fatalf("counts = %d, %d, total %d, want 1, 2, total 2", poll.Options[0].VoteCount, poll.Options[1].VoteCount, poll.TotalVoters)
+			}
+			if len(poll.Options[1].Voters) != tt.wantVoters {
+				t.Fatalf("voters = %v, want %d listed", poll.Options[1].Voters, tt.wantVoters)
+			}
+		})
+	}
+}
+
+func TestCastVote(t *testing.T) {
+	member := uuid.New()
+	closed := time.Now().Add(-time.Minute)
+	tests := []struct {
+		name      string
+		multiple  bool
+		closedAt  *time.Time
+		userId    uuid.UUID
+		optionIds []int
+		wantErr   error
+	}{
+		{"single choice", false, nil, member, []int{2}, nil},
+		{"repeated option counts once", false, nil, member, []int{2, 2}, nil},
+		{"two options on a single choice poll", false, nil, member, []int{1, 2}, apperror.ErrInvalidData},
+		{"two options on a multiple choice poll", true, nil, member, []int{1, 2}, nil},
+		{"unknown option", true, nil, member, []int{3}, apperror.ErrInvalidData},
+		{"no option", true, nil, member, nil, apperror.ErrInvalidData},
+		{"closed poll", false, &closed, member, []int{1}, apperror.ErrNotAvailable},
+		{"non-member", false, nil, uuid.New(), []int{1}, apperror.ErrForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			polls := &fakePolls{poll: &entities.Poll{ID: 1, RoomId: 4, MultipleChoice: tt.multiple, ClosedAt: tt.closedAt, Options: []entities.PollOption{{ID: 1}, {ID: 2}}}}
+			messages := &fakeMessages{}
+			s := NewPollService(polls, testsupport.NewRoomMembers(4, map[uuid.UUID]entities.RoomRole{member: entities.RoomRoleMember}), nil, messages)
+
+			poll, err := s.CastVote(1, tt.userId, tt.optionIds)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CastVote = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if len(polls.votes) != 0 || messages.published != 0 {
+					t.Fatalf("rejected vote was stored or published")
+				}
+				return
+			}
+			if int(poll.TotalVoters) != 1 || messages.published != 1 {
+				t.Fatalf("total voters = %d, published = %d, want 1 and 1", poll.TotalVoters, messages.published)
+			}
+		})
+	}
+}
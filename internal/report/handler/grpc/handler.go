@@ -0,0 +1,98 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/MingPV/ChatService/internal/entities"
+	"github.com/MingPV/ChatService/internal/report/usecase"
+	"github.com/MingPV/ChatService/pkg/apperror"
+	reportpb "github.com/MingPV/ChatService/proto/report"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type GrpcReportHandler struct {
+	reportUseCase usecase.ReportUseCase
+	reportpb.UnimplementedReportServiceServer
+}
+
+func NewGrpcReportHandler(uc usecase.ReportUseCase) *GrpcReportHandler {
+	return &GrpcReportHandler{reportUseCase: uc}
+}
+
+func (h *GrpcReportHandler) ReportMessage(ctx context.Context, req *reportpb.ReportMessageRequest) (*reportpb.ReportMessageResponse, error) {
+	reporterId, err := uuid.Parse(req.ReporterId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+	report, err := h.reportUseCase.ReportMessage(uint(req.MessageId), reporterId, req.Reason)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+	return &reportpb.ReportMessageResponse{Report: toProtoReport(report)}, nil
+}
+
+func (h *GrpcReportHandler) FindReportQueue(ctx context.Context, req *reportpb.FindReportQueueRequest) (*reportpb.FindReportQueueResponse, error) {
+	actorId, err := uuid.Parse(req.ActorId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+	reports, total, err := h.reportUseCase.FindReportQueue(uint(req.RoomId), actorId, int(req.Page), int(req.PageSize))
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+
+	protoReports := make([]*reportpb.MessageReport, 0, len(reports))
+	for _, r := range reports {
+		protoReports = append(protoReports, toProtoReport(r))
+	}
+	return &reportpb.FindReportQueueResponse{Reports: protoReports, Total: total}, nil
+}
+
+func (h *GrpcReportHandler) ResolveReport(ctx context.Context, req *reportpb.ResolveReportRequest) (*reportpb.ResolveReportResponse, error) {
+	actorId, err := uuid.Parse(req.ActorId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+	report, err := h.reportUseCase.ResolveReport(int(req.Id), actorId, entities.ReportAction(req.Action), time.Duration(req.DurationSeconds)*time.Second)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+	return &reportpb.ResolveReportResponse{Report: toProtoReport(report)}, nil
+}
+
+// ---- Helper functions ----
+
+func toProtoReport(r *entities.MessageReport) *reportpb.MessageReport {
+	out := &reportpb.MessageReport{
+		Id:         int32(r.ID),
+		MessageId:  int32(r.MessageId),
+		RoomId:     int32(r.RoomId),
+		ReporterId: r.ReporterId.String(),
+		SenderId:   r.SenderId.String(),
+		Reason:     r.Reason,
+		Status:     string(r.Status),
+		Action:     string(r.Action),
+		CreatedAt:  timestamppb.New(r.CreatedAt),
+		UpdatedAt:  timestamppb.New(r.UpdatedAt),
+	}
+	if r.ResolvedBy != nil {
+		out.ResolvedBy = r.ResolvedBy.String()
+	}
+	if r.ResolvedAt != nil {
+		out.ResolvedAt = timestamppb.New(*r.ResolvedAt)
+	}
+	if r.Message != nil {
+		out.Message = &reportpb.Message{
+			Id:             int32(r.Message.ID),
+			RoomId:         int32(r.Message.RoomId),
+			Message:        r.Message.Message,
+			Sender:         r.Message.Sender.String(),
+			HasAttachments: len(r.Message.Attachments) > 0,
+			CreatedAt:      timestamppb.New(r.Message.CreatedAt),
+		}
+	}
+	return out
+}
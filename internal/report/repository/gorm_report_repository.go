@@ -0,0 +1,250 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/MingPV/ChatService/internal/entities"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type MongoReportRepository struct {
+	db   *mongo.Database
+	coll *mongo.Collection
+}
+
+func NewMongoReportRepository(db *mongo.Database) ReportRepository {
+	return &MongoReportRepository{
+		db:   db,
+		coll: db.Collection("message_reports"),
+	}
+}
+
+type reportDoc struct {
+	ID         int                   `bson:"_id,omitempty"`
+	MessageId  uint                  `bson:"message_id"`
+	RoomId     uint                  `bson:"room_id"`
+	ReporterId uuid.UUID             `bson:"reporter_id"`
+	SenderId   uuid.UUID             `bson:"sender_id"`
+	Reason     string                `bson:"reason"`
+	Status     entities.ReportStatus `bson:"status"`
+	Action     entities.ReportAction `bson:"action,omitempty"`
+	ResolvedBy *uuid.UUID            `bson:"resolved_by,omitempty"`
+	ResolvedAt *time.Time            `bson:"resolved_at,omitempty"`
+	CreatedAt  time.Time             `bson:"created_at"`
+	UpdatedAt  time.Time             `bson:"updated_at"`
+
+	Message *entities.Message `bson:"message,omitempty"` // joined in by FindOpenByRoomID
+}
+
+type counterDoc struct {
+	ID  string `bson:"_id"`
+	Seq int    `bson:"seq"`
+}
+
+// EnsureIndexes allows one open report per reporter and message, and serves the per-room queue
+func (r *MongoReportRepository) EnsureIndexes() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := r.coll.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "message_id", Value: 1}, {Key: "reporter_id", Value: 1}},
+			Options: options.Index().
+				SetName("open_message_reporter").
+				SetUnique(true).
+				SetPartialFilterExpression(bson.M{"status": entities.ReportOpen}),
+		},
+		{
+			Keys:    bson.D{{Key: "room_id", Value: 1}, {Key: "status", Value: 1}, {Key: "created_at", Value: 1}},
+			Options: options.Index().SetName("room_status_created"),
+		},
+	})
+	return err
+}
+
+func (r *MongoReportRepository) Save(report *entities.MessageReport) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	nextID, err := r.getNextSequence(ctx, "message_reports")
+	if err != nil {
+		return err
+	}
+
+	_, err = r.coll.InsertOne(ctx, reportDoc{
+		ID:         nextID,
+		MessageId:  report.MessageId,
+		RoomId:     report.RoomId,
+		ReporterId: report.ReporterId,
+		SenderId:   report.SenderId,
+		Reason:     report.Reason,
+		Status:     report.Status,
+		CreatedAt:  report.CreatedAt,
+		UpdatedAt:  report.UpdatedAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	report.ID = uint(nextID)
+	return nil
+}
+
+func (r *MongoReportRepository) FindByID(id int) (*entities.MessageReport, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var d reportDoc
+	err := r.coll.FindOne(ctx, bson.M{"_id": id}).Decode(&d)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return &entities.MessageReport{}, err
+	}
+	if err != nil {
+		return nil, err
+	}
+	return r.toEntity(d), nil
+}
+
+func (r *MongoReportRepository) FindOpenByRoomID(roomId uint, offset, limit int) ([]*entities.MessageReport, int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	match := bson.M{"room_id": roomId, "status": entities.ReportOpen}
+	total, err := r.coll.CountDocuments(ctx, match)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$sort", Value: bson.D{{Key: "created_at", Value: 1}, {Key: "_id", Value: 1}}}},
+		{{Key: "$skip", Value: offset}},
+		{{Key: "$limit", Value: limit}},
+		{{Key: "$lookup", Value: bson.M{
+			"from":         "messages",
+			"localField":   "message_id",
+			"foreignField": "_id",
+			"as":           "message",
+		}}},
+		{{Key: "$unwind", Value: bson.M{
+			"path":                       "$message",
+			"preserveNullAndEmptyArrays": true,
+		}}},
+	}
+	cur, err := r.coll.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer cur.Close(ctx)
+
+	var results []*entities.MessageReport
+	for cur.Next(ctx) {
+		var d reportDoc
+		if err := cur.Decode(&d); err != nil {
+			return nil, 0, err
+		}
+		results = append(results, r.toEntity(d))
+	}
+	return results, total, cur.Err()
+}
+
+func (r *MongoReportRepository) Resolve(id uint, status entities.ReportStatus, action entities.ReportAction, resolvedBy uuid.UUID, at time.Time) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	res, err := r.coll.UpdateOne(ctx,
+		bson.M{"_id": id, "status": entities.ReportOpen},
+		resolution(status, action, resolvedBy, at),
+	)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+func (r *MongoReportRepository) ResolveAllOpenByMessageID(messageId uint, status entities.ReportStatus, action entities.ReportAction, resolvedBy uuid.UUID, at time.Time) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := r.coll.UpdateMany(ctx,
+		bson.M{"message_id": messageId, "status": entities.ReportOpen},
+		resolution(status, action, resolvedBy, at),
+	)
+	return err
+}
+
+func (r *MongoReportRepository) Reopen(id uint) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := r.coll.UpdateByID(ctx, id, bson.M{
+		"$set":   bson.M{"status": entities.ReportOpen, "updated_at": time.Now().UTC()},
+		"$unset": bson.M{"action": "", "resolved_by": "", "resolved_at": ""},
+	})
+	return err
+}
+
+func resolution(status entities.ReportStatus, action entities.ReportAction, resolvedBy uuid.UUID, at time.Time) bson.M {
+	return bson.M{"$set": bson.M{
+		"status":      status,
+		"action":      action,
+		"resolved_by": resolvedBy,
+		"resolved_at": at,
+		"updated_at":  at,
+	}}
+}
+
+func (r *MongoReportRepository) toEntity(d reportDoc) *entities.MessageReport {
+	return &entities.MessageReport{
+		ID:         uint(d.ID),
+		MessageId:  d.MessageId,
+		RoomId:     d.RoomId,
+		ReporterId: d.ReporterId,
+		SenderId:   d.SenderId,
+		Reason:     d.Reason,
+		Status:     d.Status,
+		Action:     d.Action,
+		ResolvedBy: d.ResolvedBy,
+		ResolvedAt: d.ResolvedAt,
+		CreatedAt:  d.CreatedAt,
+		UpdatedAt:  d.UpdatedAt,
+		Message:    d.Message,
+	}
+}
+
+// getNextSequence generates auto-increment ID
+func (r *MongoReportRepository) getNextSequence(ctx context.Context, name string) (int, error) {
+	counters := r.db.Collection("counters")
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+
+	var out counterDoc
+	err := counters.FindOneAndUpdate(
+		ctx,
+		bson.M{"_id": name},
+		bson.M{"$inc": bson.M{"seq": 1}},
+		opts,
+	).Decode(&out)
+
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		_, ierr := counters.InsertOne(ctx, counterDoc{ID: name, Seq: 1})
+		if ierr != nil {
+			return 0, ierr
+		}
+		return 1, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if out.Seq == 0 {
+		return 1, nil
+	}
+	return out.Seq, nil
+}
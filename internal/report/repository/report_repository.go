@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/MingPV/ChatService/internal/entities"
+	"github.com/google/uuid"
+)
+
+type ReportRepository interface {
+	Save(report *entities.MessageReport) error
+	FindByID(id int) (*entities.MessageReport, error)
+	// FindOpenByRoomID pages through a room's open reports, oldest first, with the reported message joined in.
+	FindOpenByRoomID(roomId uint, offset, limit int) ([]*entities.MessageReport, int64, error)
+	// Resolve closes an open report, failing with ErrNoDocuments if it is no longer open.
+	Resolve(id uint, status entities.ReportStatus, action entities.ReportAction, resolvedBy uuid.UUID, at time.Time) error
+	// ResolveAllOpenByMessageID closes every other open report on the same message.
+	ResolveAllOpenByMessageID(messageId uint, status entities.ReportStatus, action entities.ReportAction, resolvedBy uuid.UUID, at time.Time) error
+	// Reopen undoes Resolve when the resolution's action could not be carried out.
+	Reopen(id uint) error
+	EnsureIndexes() error
+}
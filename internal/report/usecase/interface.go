@@ -0,0 +1,18 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/MingPV/ChatService/internal/entities"
+	"github.com/google/uuid"
+)
+
+type ReportUseCase interface {
+	// ReportMessage files a complaint about a message in a group room the reporter belongs to.
+	ReportMessage(messageId uint, reporterId uuid.UUID, reason string) (*entities.MessageReport, error)
+	// FindReportQueue lists the room's open reports, oldest first, with the reported messages; moderators only.
+	FindReportQueue(roomId uint, actorId uuid.UUID, page, pageSize int) ([]*entities.MessageReport, int64, error)
+	// ResolveReport closes an open report by carrying out action. Mutes and bans apply to the
+	// message's sender for duration, or until lifted when duration is 0.
+	ResolveReport(id int, actorId uuid.UUID, action entities.ReportAction, duration time.Duration) (*entities.MessageReport, error)
+}
@@ -0,0 +1,184 @@
+package usecase
+
+import (
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	chatroomRepo "github.com/MingPV/ChatService/internal/chatroom/repository"
+	"github.com/MingPV/ChatService/internal/entities"
+	messageRepo "github.com/MingPV/ChatService/internal/message/repository"
+	messageUseCase "github.com/MingPV/ChatService/internal/message/usecase"
+	reportRepo "github.com/MingPV/ChatService/internal/report/repository"
+	roommemberRepo "github.com/MingPV/ChatService/internal/room_member/repository"
+	roommemberUseCase "github.com/MingPV/ChatService/internal/room_member/usecase"
+	"github.com/MingPV/ChatService/pkg/apperror"
+	"github.com/MingPV/ChatService/pkg/pagination"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const maxReportReason = 500
+
+// ReportService implements ReportUseCase
+type ReportService struct {
+	reportRepo        reportRepo.ReportRepository
+	messageRepo       messageRepo.MessageRepository
+	roommemberRepo    roommemberRepo.RoomMemberRepository
+	chatroomRepo      chatroomRepo.ChatroomRepository
+	messageUseCase    messageUseCase.MessageUseCase
+	roommemberUseCase roommemberUseCase.RoomMemberUseCase
+}
+
+func NewReportService(reportRepo reportRepo.ReportRepository, messageRepo messageRepo.MessageRepository, roommemberRepo roommemberRepo.RoomMemberRepository, chatroomRepo chatroomRepo.ChatroomRepository, messageUseCase messageUseCase.MessageUseCase, roommemberUseCase roommemberUseCase.RoomMemberUseCase) ReportUseCase {
+	return &ReportService{reportRepo: reportRepo, messageRepo: messageRepo, roommemberRepo: roommemberRepo, chatroomRepo: chatroomRepo, messageUseCase: messageUseCase, roommemberUseCase: roommemberUseCase}
+}
+
+func (s *ReportService) ReportMessage(messageId uint, reporterId uuid.UUID, reason string) (*entities.MessageReport, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, apperror.ErrRequiredField
+	}
+	if len([]rune(reason)) > maxReportReason {
+		return nil, apperror.ErrOutOfRange
+	}
+
+	message, err := s.messageRepo.FindByID(int(messageId))
+	if err != nil {
+		return nil, err
+	}
+	if message.System != nil || message.Sender == reporterId {
+		return nil, apperror.ErrInvalidData
+	}
+	room, err := s.chatroomRepo.FindByID(int(message.RoomId))
+	if err != nil {
+		return nil, err
+	}
+	// direct chats have no moderators; blocking covers them
+	if !room.IsGroup {
+		return nil, apperror.ErrOperationDenied
+	}
+	if _, err := s.roommemberRepo.FindAllByRoomIDAndUserID(message.RoomId, reporterId); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, apperror.ErrForbidden
+		}
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	report := &entities.MessageReport{
+		MessageId:  message.ID,
+		RoomId:     message.RoomId,
+		ReporterId: reporterId,
+		SenderId:   message.Sender,
+		Reason:     reason,
+		Status:     entities.ReportOpen,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	if err := s.reportRepo.Save(report); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+func (s *ReportService) FindReportQueue(roomId uint, actorId uuid.UUID, page, pageSize int) ([]*entities.MessageReport, int64, error) {
+	if err := s.checkCanModerate(roomId, actorId); err != nil {
+		return nil, 0, err
+	}
+
+	offset, limit := pagination.OffsetLimit(page, pageSize)
+	reports, total, err := s.reportRepo.FindOpenByRoomID(roomId, offset, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+	now := time.Now()
+	for _, r := range reports {
+		// removed or disappearing messages are hidden as soon as they expire, even before the reaper runs
+		if r.Message != nil && r.Message.ExpiresAt != nil && !r.Message.ExpiresAt.After(now) {
+			r.Message = nil
+		}
+	}
+	return reports, total, nil
+}
+
+func (s *ReportService) ResolveReport(id int, actorId uuid.UUID, action entities.ReportAction, duration time.Duration) (*entities.MessageReport, error) {
+	if !action.IsValid() {
+		return nil, apperror.ErrInvalidData
+	}
+	if duration < 0 {
+		return nil, apperror.ErrOutOfRange
+	}
+	report, err := s.reportRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkCanModerate(report.RoomId, actorId); err != nil {
+		return nil, err
+	}
+	if report.Status != entities.ReportOpen {
+		return nil, apperror.ErrNotAvailable
+	}
+
+	status := entities.ReportActioned
+	if action == entities.ReportActionDismiss {
+		status = entities.ReportDismissed
+	}
+	// claim the report first so two moderators cannot act on it twice
+	now := time.Now().UTC()
+	if err := s.reportRepo.Resolve(report.ID, status, action, actorId, now); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, apperror.ErrNotAvailable
+		}
+		return nil, err
+	}
+	if err := s.apply(report, action, actorId, duration); err != nil {
+		if rerr := s.reportRepo.Reopen(report.ID); rerr != nil {
+			log.Printf("failed to reopen report %d: %v", report.ID, rerr)
+		}
+		return nil, err
+	}
+
+	// one review settles every report on the message
+	if err := s.reportRepo.ResolveAllOpenByMessageID(report.MessageId, status, action, actorId, now); err != nil {
+		log.Printf("failed to resolve other reports on message %d: %v", report.MessageId, err)
+	}
+
+	report.Status = status
+	report.Action = action
+	report.ResolvedBy = &actorId
+	report.ResolvedAt = &now
+	report.UpdatedAt = now
+	return report, nil
+}
+
+// apply carries out a resolution; mutes and bans go through the room's usual moderation rules
+func (s *ReportService) apply(report *entities.MessageReport, action entities.ReportAction, actorId uuid.UUID, duration time.Duration) error {
+	switch action {
+	case entities.ReportActionDeleteMessage:
+		// a message that already disappeared needs no removal
+		if _, err := s.messageUseCase.RemoveMessage(report.MessageId); err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+			return err
+		}
+	case entities.ReportActionMuteSender:
+		if _, err := s.roommemberUseCase.MuteMember(report.RoomId, actorId, report.SenderId, duration, report.Reason); err != nil {
+			return err
+		}
+	case entities.ReportActionBanSender:
+		if _, err := s.roommemberUseCase.BanMember(report.RoomId, actorId, report.SenderId, duration, report.Reason); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkCanModerate requires userId to be an owner or admin of the room
+func (s *ReportService) checkCanModerate(roomId uint, userId uuid.UUID) error {
+	room, err := s.chatroomRepo.FindByID(int(roomId))
+	if err != nil {
+		return err
+	}
+	return roommemberRepo.CheckCanModerate(s.roommemberRepo, room, userId)
+}
+
@@ -0,0 +1,226 @@
+package usecase
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MingPV/ChatService/internal/entities"
+	messageRepo "github.com/MingPV/ChatService/internal/message/repository"
+	messageUseCase "github.com/MingPV/ChatService/internal/message/usecase"
+	reportRepo "github.com/MingPV/ChatService/internal/report/repository"
+	roommemberUseCase "github.com/MingPV/ChatService/internal/room_member/usecase"
+	"github.com/MingPV/ChatService/internal/testsupport"
+	"github.com/MingPV/ChatService/pkg/apperror"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+var errStoreFailed = errors.New("store failed")
+
+type fakeReports struct {
+	reportRepo.ReportRepository
+	reports map[uint]*entities.MessageReport
+	// raced makes Resolve fail as if another moderator claimed the report first
+	raced bool
+}
+
+func (f *fakeReports) Save(report *entities.MessageReport) error {
+	report.ID = uint(len(f.reports) + 1)
+	f.reports[report.ID] = report
+	return nil
+}
+
+func (f *fakeReports) FindByID(id int) (*entities.MessageReport, error) {
+	if r, ok := f.reports[uint(id)]; ok {
+		copied := *r
+		return &copied, nil
+	}
+	return &entities.MessageReport{}, mongo.ErrNoDocuments
+}
+
+func (f *fakeReports) Resolve(id uint, status entities.ReportStatus, action entities.ReportAction, resolvedBy uuid.UUID, at time.Time) error {
+	r, ok := f.reports[id]
+	if f.raced || !ok || r.Status != entities.ReportOpen {
+		return mongo.ErrNoDocuments
+	}
+	r.Status, r.Action = status, action
+	return nil
+}
+
+func (f *fakeReports) ResolveAllOpenByMessageID(messageId uint, status entities.ReportStatus, action entities.ReportAction, resolvedBy uuid.UUID, at time.Time) error {
+	for _, r := range f.reports {
+		if r.MessageId == messageId && r.Status == entities.ReportOpen {
+			r.Status, r.Action = status, action
+		}
+	}
+	return nil
+}
+
+func (f *fakeReports) Reopen(id uint) error {
+	r := f.reports[id]
+	r.Status, r.Action = entities.ReportOpen, ""
+	return nil
+}
+
+type fakeMessages struct {
+	messageRepo.MessageRepository
+	messages map[uint]*entities.Message
+}
+
+func (f *fakeMessages) FindByID(id int) (*entities.Message, error) {
+	if m, ok := f.messages[uint(id)]; ok {
+		return m, nil
+	}
+	return &entities.Message{}, mongo.ErrNoDocuments
+}
+
+// fakeRemover stands in for the message use case
+type fakeRemover struct {
+	messageUseCase.MessageUseCase
+	removed   []uint
+	removeErr error
+}
+
+func (f *fakeRemover) RemoveMessage(messageId uint) (*entities.Message, error) {
+	if f.removeErr != nil {
+		return nil, f.removeErr
+	}
+	f.removed = append(f.removed, messageId)
+	return &entities.Message{ID: messageId}, nil
+}
+
+// fakeModeration stands in for the room member use case
+type fakeModeration struct {
+	roommemberUseCase.RoomMemberUseCase
+	restricted []entities.RestrictionKind
+	err        error
+}
+
+func (f *fakeModeration) MuteMember(roomId uint, actorId uuid.UUID, userId uuid.UUID, duration time.Duration, reason string) (*entities.RoomRestriction, error) {
+	return f.restrict(entities.RestrictionMute)
+}
+
+func (f *fakeModeration) BanMember(roomId uint, actorId uuid.UUID, userId uuid.UUID, duration time.Duration, reason string) (*entities.RoomRestriction, error) {
+	return f.restrict(entities.RestrictionBan)
+}
+
+func (f *fakeModeration) restrict(kind entities.RestrictionKind) (*entities.RoomRestriction, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.restricted = append(f.restricted, kind)
+	return &entities.RoomRestriction{Kind: kind}, nil
+}
+
+func TestReportMessage(t *testing.T) {
+	reporter, sender, outsider := uuid.New(), uuid.New(), uuid.New()
+
+	tests := []struct {
+		name      string
+		messageId uint
+		reporter  uuid.UUID
+		reason    string
+		group     bool
+		wantErr   error
+	}{
+		{name: "member reports a message", messageId: 1, reporter: reporter, reason: " spam ", group: true},
+		{name: "reason is required", messageId: 1, reporter: reporter, reason: "  ", group: true, wantErr: apperror.ErrRequiredField},
+		{name: "reason too long", messageId: 1, reporter: reporter, reason: strings.Repeat("x", maxReportReason+1), group: true, wantErr: apperror.ErrOutOfRange},
+		{name: "own message", messageId: 1, reporter: sender, reason: "oops", group: true, wantErr: apperror.ErrInvalidData},
+		{name: "system message", messageId: 2, reporter: reporter, reason: "spam", group: true, wantErr: apperror.ErrInvalidData},
+		{name: "direct room", messageId: 1, reporter: reporter, reason: "spam", wantErr: apperror.ErrOperationDenied},
+		{name: "reporter must be a member", messageId: 1, reporter: outsider, reason: "spam", group: true, wantErr: apperror.ErrForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reports := &fakeReports{reports: make(map[uint]*entities.MessageReport)}
+			messages := &fakeMessages{messages: map[uint]*entities.Message{
+				1: {ID: 1, RoomId: 1, Sender: sender},
+				2: {ID: 2, RoomId: 1, Sender: sender, System: &entities.SystemEvent{}},
+			}}
+			members := testsupport.NewRoomMembers(1, map[uuid.UUID]entities.RoomRole{reporter: entities.RoomRoleMember, sender: entities.RoomRoleMember})
+			rooms := testsupport.NewChatrooms(&entities.Chatroom{ID: 1, IsGroup: tt.group})
+			s := NewReportService(reports, messages, members, rooms, &fakeRemover{}, &fakeModeration{})
+
+			report, err := s.ReportMessage(tt.messageId, tt.reporter, tt.reason)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ReportMessage() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				if len(reports.reports) != 0 {
+					t.Fatalf("stored %d reports, want none", len(reports.reports))
+				}
+				return
+			}
+			if report.Status != entities.ReportOpen || report.SenderId != sender || report.Reason != "spam" {
+				t.Fatalf("report = %+v", report)
+			}
+		})
+	}
+}
+
+func TestResolveReport(t *testing.T) {
+	admin, member, sender := uuid.New(), uuid.New(), uuid.New()
+
+	tests := []struct {
+		name        string
+		actor       uuid.UUID
+		status      entities.ReportStatus
+		action      entities.ReportAction
+		duration    time.Duration
+		raced       bool
+		removeErr   error
+		moderateErr error
+		wantErr     error
+		wantStatus  entities.ReportStatus // of the report and its sibling afterwards
+	}{
+		{name: "dismiss", actor: admin, action: entities.ReportActionDismiss, wantStatus: entities.ReportDismissed},
+		{name: "delete the message", actor: admin, action: entities.ReportActionDeleteMessage, wantStatus: entities.ReportActioned},
+		{name: "message already gone", actor: admin, action: entities.ReportActionDeleteMessage, removeErr: mongo.ErrNoDocuments, wantStatus: entities.ReportActioned},
+		{name: "ban the sender", actor: admin, action: entities.ReportActionBanSender, duration: time.Hour, wantStatus: entities.ReportActioned},
+		{name: "failed mute reopens the report", actor: admin, action: entities.ReportActionMuteSender, moderateErr: apperror.ErrForbidden, wantErr: apperror.ErrForbidden, wantStatus: entities.ReportOpen},
+		{name: "failed removal reopens the report", actor: admin, action: entities.ReportActionDeleteMessage, removeErr: errStoreFailed, wantErr: errStoreFailed, wantStatus: entities.ReportOpen},
+		{name: "claimed by another moderator", actor: admin, action: entities.ReportActionDismiss, raced: true, wantErr: apperror.ErrNotAvailable, wantStatus: entities.ReportOpen},
+		{name: "already resolved", actor: admin, status: entities.ReportDismissed, action: entities.ReportActionBanSender, wantErr: apperror.ErrNotAvailable, wantStatus: entities.ReportDismissed},
+		{name: "members cannot resolve", actor: member, action: entities.ReportActionDismiss, wantErr: apperror.ErrForbidden, wantStatus: entities.ReportOpen},
+		{name: "unknown action", actor: admin, action: "shrug", wantErr: apperror.ErrInvalidData, wantStatus: entities.ReportOpen},
+		{name: "negative duration", actor: admin, action: entities.ReportActionMuteSender, duration: -time.Hour, wantErr: apperror.ErrOutOfRange, wantStatus: entities.ReportOpen},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status := tt.status
+			if status == "" {
+				status = entities.ReportOpen
+			}
+			reports := &fakeReports{raced: tt.raced, reports: map[uint]*entities.MessageReport{
+				1: {ID: 1, MessageId: 9, RoomId: 1, SenderId: sender, Status: status},
+				2: {ID: 2, MessageId: 9, RoomId: 1, SenderId: sender, Status: status},
+			}}
+			members := testsupport.NewRoomMembers(1, map[uuid.UUID]entities.RoomRole{admin: entities.RoomRoleAdmin, member: entities.RoomRoleMember})
+			rooms := testsupport.NewChatrooms(&entities.Chatroom{ID: 1, IsGroup: true})
+			remover := &fakeRemover{removeErr: tt.removeErr}
+			moderation := &fakeModeration{err: tt.moderateErr}
+			s := NewReportService(reports, &fakeMessages{}, members, rooms, remover, moderation)
+
+			report, err := s.ResolveReport(1, tt.actor, tt.action, tt.duration)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ResolveReport() error = %v, want %v", err, tt.wantErr)
+			}
+			for id, r := range reports.reports {
+				if r.Status != tt.wantStatus {
+					t.Fatalf("report %d status = %q, want %q", id, r.Status, tt.wantStatus)
+				}
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if report.Status != tt.wantStatus || report.Action != tt.action || report.ResolvedBy == nil || *report.ResolvedBy != admin {
+				t.Fatalf("report = %+v", report)
+			}
+		})
+	}
+}
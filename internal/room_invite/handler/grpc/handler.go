@@ -15,11 +15,13 @@ import (
 
 type GrpcRoomInviteHandler struct {
 	roomInviteUseCase usecase.RoomInviteUseCase
+	inviteLinkUseCase usecase.InviteLinkUseCase
+	joinRequestUseCase usecase.JoinRequestUseCase
 	roominvitepb.UnimplementedRoomInviteServiceServer
 }
 
-func NewGrpcRoomInviteHandler(uc usecase.RoomInviteUseCase) *GrpcRoomInviteHandler {
-	return &GrpcRoomInviteHandler{roomInviteUseCase: uc}
+func NewGrpcRoomInviteHandler(uc usecase.RoomInviteUseCase, linkUc usecase.InviteLinkUseCase, joinUc usecase.JoinRequestUseCase) *GrpcRoomInviteHandler {
+	return &GrpcRoomInviteHandler{roomInviteUseCase: uc, inviteLinkUseCase: linkUc, joinRequestUseCase: joinUc}
 }
 
 // ------------------ Handlers ------------------
@@ -108,8 +110,12 @@ func (h *GrpcRoomInviteHandler) PatchRoomInvite(ctx context.Context, req *roomin
 	if err := h.roomInviteUseCase.PatchInvite(int(req.Id), updated); err != nil {
 		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
 	}
+	invite, err := h.roomInviteUseCase.FindByID(int(req.Id))
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
 
-	return &roominvitepb.PatchRoomInviteResponse{Invite: toProtoRoomInvite(updated)}, nil
+	return &roominvitepb.PatchRoomInviteResponse{Invite: toProtoRoomInvite(invite)}, nil
 }
 
 func (h *GrpcRoomInviteHandler) DeleteRoomInvite(ctx context.Context, req *roominvitepb.DeleteRoomInviteRequest) (*roominvitepb.DeleteRoomInviteResponse, error) {
@@ -120,24 +126,237 @@ func (h *GrpcRoomInviteHandler) DeleteRoomInvite(ctx context.Context, req *roomi
 }
 
 func (h *GrpcRoomInviteHandler) AcceptedRoomInvite(ctx context.Context, req *roominvitepb.AcceptedRoomInviteRequest) (*roominvitepb.AcceptedRoomInviteResponse, error) {
-	if err := h.roomInviteUseCase.AcceptedInvite(int(req.Id)); err != nil {
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+	if err := h.roomInviteUseCase.AcceptedInvite(int(req.Id), userId); err != nil {
 		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
 	}
 	return &roominvitepb.AcceptedRoomInviteResponse{Message: "room invite accepted"}, nil
 }
 
+func (h *GrpcRoomInviteHandler) DenyRoomInvite(ctx context.Context, req *roominvitepb.DenyRoomInviteRequest) (*roominvitepb.DenyRoomInviteResponse, error) {
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+	if err := h.roomInviteUseCase.DenyInvite(int(req.Id), userId); err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+	return &roominvitepb.DenyRoomInviteResponse{Message: "room invite denied"}, nil
+}
+
+func (h *GrpcRoomInviteHandler) RevokeRoomInvite(ctx context.Context, req *roominvitepb.RevokeRoomInviteRequest) (*roominvitepb.RevokeRoomInviteResponse, error) {
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+	if err := h.roomInviteUseCase.RevokeInvite(int(req.Id), userId); err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+	return &roominvitepb.RevokeRoomInviteResponse{Message: "room invite revoked"}, nil
+}
+
+func (h *GrpcRoomInviteHandler) CreateInviteLink(ctx context.Context, req *roominvitepb.CreateInviteLinkRequest) (*roominvitepb.CreateInviteLinkResponse, error) {
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+	link := &entities.InviteLink{
+		RoomId:    uint(req.RoomId),
+		CreatedBy: userId,
+		Role:      entities.RoomRole(req.Role),
+		MaxUses:   int(req.MaxUses),
+	}
+	if req.ExpiresAt != nil {
+		expiresAt := req.ExpiresAt.AsTime()
+		link.ExpiresAt = &expiresAt
+	}
+
+	if err := h.inviteLinkUseCase.CreateInviteLink(link); err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+	return &roominvitepb.CreateInviteLinkResponse{Link: toProtoInviteLink(link)}, nil
+}
+
+func (h *GrpcRoomInviteHandler) RedeemInviteLink(ctx context.Context, req *roominvitepb.RedeemInviteLinkRequest) (*roominvitepb.RedeemInviteLinkResponse, error) {
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+	member, err := h.inviteLinkUseCase.RedeemInviteLink(req.Token, userId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+	return &roominvitepb.RedeemInviteLinkResponse{
+		RoomId:   int32(member.RoomId),
+		MemberId: int32(member.ID),
+		Role:     string(member.Role),
+	}, nil
+}
+
+func (h *GrpcRoomInviteHandler) FindActiveInviteLinks(ctx context.Context, req *roominvitepb.FindActiveInviteLinksRequest) (*roominvitepb.FindActiveInviteLinksResponse, error) {
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+	links, err := h.inviteLinkUseCase.FindActiveInviteLinks(uint(req.RoomId), userId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+
+	var protoLinks []*roominvitepb.InviteLink
+	for _, l := range links {
+		protoLinks = append(protoLinks, toProtoInviteLink(l))
+	}
+	return &roominvitepb.FindActiveInviteLinksResponse{Links: protoLinks}, nil
+}
+
+func (h *GrpcRoomInviteHandler) RevokeInviteLink(ctx context.Context, req *roominvitepb.RevokeInviteLinkRequest) (*roominvitepb.RevokeInviteLinkResponse, error) {
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+	if err := h.inviteLinkUseCase.RevokeInviteLink(int(req.Id), userId); err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+	return &roominvitepb.RevokeInviteLinkResponse{Message: "invite link revoked"}, nil
+}
+
+func (h *GrpcRoomInviteHandler) FindInviteLinkRedemptions(ctx context.Context, req *roominvitepb.FindInviteLinkRedemptionsRequest) (*roominvitepb.FindInviteLinkRedemptionsResponse, error) {
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+	redemptions, err := h.inviteLinkUseCase.FindInviteLinkRedemptions(int(req.Id), userId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+
+	var protoRedemptions []*roominvitepb.InviteLinkRedemption
+	for _, r := range redemptions {
+		protoRedemptions = append(protoRedemptions, &roominvitepb.InviteLinkRedemption{
+			Id:        int32(r.ID),
+			LinkId:    int32(r.LinkId),
+			RoomId:    int32(r.RoomId),
+			UserId:    r.UserId.String(),
+			CreatedAt: timestamppb.New(r.CreatedAt),
+		})
+	}
+	return &roominvitepb.FindInviteLinkRedemptionsResponse{Redemptions: protoRedemptions}, nil
+}
+
+func (h *GrpcRoomInviteHandler) RequestToJoin(ctx context.Context, req *roominvitepb.RequestToJoinRequest) (*roominvitepb.RequestToJoinResponse, error) {
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+	request, err := h.joinRequestUseCase.RequestToJoin(uint(req.RoomId), userId, req.Message)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+	return &roominvitepb.RequestToJoinResponse{Request: toProtoJoinRequest(request)}, nil
+}
+
+func (h *GrpcRoomInviteHandler) FindPendingJoinRequests(ctx context.Context, req *roominvitepb.FindPendingJoinRequestsRequest) (*roominvitepb.FindPendingJoinRequestsResponse, error) {
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+	requests, err := h.joinRequestUseCase.FindPendingJoinRequests(uint(req.RoomId), userId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+
+	var protoRequests []*roominvitepb.JoinRequest
+	for _, r := range requests {
+		protoRequests = append(protoRequests, toProtoJoinRequest(r))
+	}
+	return &roominvitepb.FindPendingJoinRequestsResponse{Requests: protoRequests}, nil
+}
+
+func (h *GrpcRoomInviteHandler) ApproveJoinRequest(ctx context.Context, req *roominvitepb.DecideJoinRequestRequest) (*roominvitepb.DecideJoinRequestResponse, error) {
+	return h.decideJoinRequest(req, h.joinRequestUseCase.ApproveJoinRequest)
+}
+
+func (h *GrpcRoomInviteHandler) RejectJoinRequest(ctx context.Context, req *roominvitepb.DecideJoinRequestRequest) (*roominvitepb.DecideJoinRequestResponse, error) {
+	return h.decideJoinRequest(req, h.joinRequestUseCase.RejectJoinRequest)
+}
+
+func (h *GrpcRoomInviteHandler) CancelJoinRequest(ctx context.Context, req *roominvitepb.DecideJoinRequestRequest) (*roominvitepb.DecideJoinRequestResponse, error) {
+	return h.decideJoinRequest(req, h.joinRequestUseCase.CancelJoinRequest)
+}
+
+func (h *GrpcRoomInviteHandler) decideJoinRequest(req *roominvitepb.DecideJoinRequestRequest, decide func(int, uuid.UUID) (*entities.JoinRequest, error)) (*roominvitepb.DecideJoinRequestResponse, error) {
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+	request, err := decide(int(req.Id), userId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+	return &roominvitepb.DecideJoinRequestResponse{Request: toProtoJoinRequest(request)}, nil
+}
 
 // ------------------ Helpers ------------------
 
 func toProtoRoomInvite(inv *entities.RoomInvite) *roominvitepb.RoomInvite {
-	return &roominvitepb.RoomInvite{
+	out := &roominvitepb.RoomInvite{
 		Id:         int32(inv.ID),
 		RoomId:     int32(inv.RoomId),
 		Sender:     inv.Sender.String(),
 		InviteTo:   inv.InviteTo.String(),
 		IsAccepted: inv.IsAccepted,
 		IsDenied:   inv.IsDenied,
+		Status:     string(inv.Status),
 		CreatedAt:  timestamppb.New(inv.CreatedAt),
 		UpdatedAt:  timestamppb.New(inv.UpdatedAt),
 	}
+	if inv.ExpiresAt != nil {
+		out.ExpiresAt = timestamppb.New(*inv.ExpiresAt)
+	}
+	if inv.RespondedAt != nil {
+		out.RespondedAt = timestamppb.New(*inv.RespondedAt)
+	}
+	return out
+}
+
+func toProtoInviteLink(l *entities.InviteLink) *roominvitepb.InviteLink {
+	out := &roominvitepb.InviteLink{
+		Id:        int32(l.ID),
+		RoomId:    int32(l.RoomId),
+		Token:     l.Token,
+		CreatedBy: l.CreatedBy.String(),
+		Role:      string(l.Role),
+		MaxUses:   int32(l.MaxUses),
+		Uses:      int32(l.Uses),
+		CreatedAt: timestamppb.New(l.CreatedAt),
+	}
+	if l.ExpiresAt != nil {
+		out.ExpiresAt = timestamppb.New(*l.ExpiresAt)
+	}
+	if l.RevokedAt != nil {
+		out.RevokedAt = timestamppb.New(*l.RevokedAt)
+	}
+	return out
+}
+
+func toProtoJoinRequest(r *entities.JoinRequest) *roominvitepb.JoinRequest {
+	out := &roominvitepb.JoinRequest{
+		Id:        int32(r.ID),
+		RoomId:    int32(r.RoomId),
+		UserId:    r.UserId.String(),
+		Message:   r.Message,
+		Status:    string(r.Status),
+		CreatedAt: timestamppb.New(r.CreatedAt),
+	}
+	if r.DecidedBy != nil {
+		out.DecidedBy = r.DecidedBy.String()
+	}
+	if r.DecidedAt != nil {
+		out.DecidedAt = timestamppb.New(*r.DecidedAt)
+	}
+	return out
 }
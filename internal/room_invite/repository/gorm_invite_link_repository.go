@@ -0,0 +1,297 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/MingPV/ChatService/internal/entities"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type MongoInviteLinkRepository struct {
+	db          *mongo.Database
+	coll        *mongo.Collection
+	redemptions *mongo.Collection
+}
+
+func NewMongoInviteLinkRepository(db *mongo.Database) InviteLinkRepository {
+	return &MongoInviteLinkRepository{
+		db:          db,
+		coll:        db.Collection("invite_links"),
+		redemptions: db.Collection("invite_link_redemptions"),
+	}
+}
+
+type inviteLinkDoc struct {
+	ID        int               `bson:"_id,omitempty"`
+	RoomId    uint              `bson:"room_id"`
+	Token     string            `bson:"token"`
+	CreatedBy uuid.UUID         `bson:"created_by"`
+	Role      entities.RoomRole `bson:"role"`
+	MaxUses   int               `bson:"max_uses"`
+	Uses      int               `bson:"uses"`
+	ExpiresAt *time.Time        `bson:"expires_at,omitempty"`
+	RevokedAt *time.Time        `bson:"revoked_at,omitempty"`
+	CreatedAt time.Time         `bson:"created_at"`
+	UpdatedAt time.Time         `bson:"updated_at"`
+}
+
+type inviteLinkRedemptionDoc struct {
+	ID        int       `bson:"_id,omitempty"`
+	LinkId    uint      `bson:"link_id"`
+	RoomId    uint      `bson:"room_id"`
+	UserId    uuid.UUID `bson:"user_id"`
+	CreatedAt time.Time `bson:"created_at"`
+}
+
+func (r *MongoInviteLinkRepository) EnsureIndexes() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if _, err := r.coll.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "token", Value: 1}}, Options: options.Index().SetName("token").SetUnique(true)},
+		{Keys: bson.D{{Key: "room_id", Value: 1}}, Options: options.Index().SetName("room_id")},
+	}); err != nil {
+		return err
+	}
+	_, err := r.redemptions.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "link_id", Value: 1}, {Key: "created_at", Value: -1}},
+		Options: options.Index().SetName("link_created"),
+	})
+	return err
+}
+
+// activeFilter matches links that are not revoked, not expired and have uses left
+func activeFilter(now time.Time) bson.M {
+	return bson.M{
+		"revoked_at": bson.M{"$exists": false},
+		"expires_at": bson.M{"$not": bson.M{"$lte": now}},
+		"$expr": bson.M{"$or": bson.A{
+			bson.M{"$eq": bson.A{"$max_uses", 0}},
+			bson.M{"$lt": bson.A{"$uses", "$max_uses"}},
+		}},
+	}
+}
+
+func (r *MongoInviteLinkRepository) Save(link *entities.InviteLink) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	nextID, err := r.getNextSequence(ctx, "invite_links")
+	if err != nil {
+		return err
+	}
+
+	_, err = r.coll.InsertOne(ctx, inviteLinkDoc{
+		ID:        nextID,
+		RoomId:    link.RoomId,
+		Token:     link.Token,
+		CreatedBy: link.CreatedBy,
+		Role:      link.Role,
+		MaxUses:   link.MaxUses,
+		Uses:      link.Uses,
+		ExpiresAt: link.ExpiresAt,
+		CreatedAt: link.CreatedAt,
+		UpdatedAt: link.UpdatedAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	link.ID = uint(nextID)
+	return nil
+}
+
+func (r *MongoInviteLinkRepository) FindByID(id int) (*entities.InviteLink, error) {
+	return r.findOne(bson.M{"_id": id})
+}
+
+func (r *MongoInviteLinkRepository) FindByToken(token string) (*entities.InviteLink, error) {
+	return r.findOne(bson.M{"token": token})
+}
+
+func (r *MongoInviteLinkRepository) findOne(filter bson.M) (*entities.InviteLink, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var d inviteLinkDoc
+	err := r.coll.FindOne(ctx, filter).Decode(&d)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return &entities.InviteLink{}, err
+	}
+	if err != nil {
+		return nil, err
+	}
+	return r.toEntity(d), nil
+}
+
+func (r *MongoInviteLinkRepository) FindAllActiveByRoomID(roomId uint, now time.Time) ([]*entities.InviteLink, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	filter := activeFilter(now)
+	filter["room_id"] = roomId
+	cur, err := r.coll.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}}))
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var results []*entities.InviteLink
+	for cur.Next(ctx) {
+		var d inviteLinkDoc
+		if err := cur.Decode(&d); err != nil {
+			return nil, err
+		}
+		results = append(results, r.toEntity(d))
+	}
+	return results, cur.Err()
+}
+
+func (r *MongoInviteLinkRepository) Revoke(id uint, revokedAt time.Time) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	res, err := r.coll.UpdateOne(ctx,
+		bson.M{"_id": id, "revoked_at": bson.M{"$exists": false}},
+		bson.M{"$set": bson.M{"revoked_at": revokedAt, "updated_at": revokedAt}},
+	)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+func (r *MongoInviteLinkRepository) ClaimUse(id uint, now time.Time) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	filter := activeFilter(now)
+	filter["_id"] = id
+	res, err := r.coll.UpdateOne(ctx, filter, bson.M{
+		"$inc": bson.M{"uses": 1},
+		"$set": bson.M{"updated_at": now},
+	})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+func (r *MongoInviteLinkRepository) ReleaseUse(id uint) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := r.coll.UpdateOne(ctx,
+		bson.M{"_id": id, "uses": bson.M{"$gt": 0}},
+		bson.M{"$inc": bson.M{"uses": -1}},
+	)
+	return err
+}
+
+func (r *MongoInviteLinkRepository) SaveRedemption(redemption *entities.InviteLinkRedemption) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	nextID, err := r.getNextSequence(ctx, "invite_link_redemptions")
+	if err != nil {
+		return err
+	}
+
+	_, err = r.redemptions.InsertOne(ctx, inviteLinkRedemptionDoc{
+		ID:        nextID,
+		LinkId:    redemption.LinkId,
+		RoomId:    redemption.RoomId,
+		UserId:    redemption.UserId,
+		CreatedAt: redemption.CreatedAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	redemption.ID = uint(nextID)
+	return nil
+}
+
+func (r *MongoInviteLinkRepository) FindAllRedemptionsByLinkID(linkId uint) ([]*entities.InviteLinkRedemption, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	cur, err := r.redemptions.Find(ctx, bson.M{"link_id": linkId}, options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}}))
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var results []*entities.InviteLinkRedemption
+	for cur.Next(ctx) {
+		var d inviteLinkRedemptionDoc
+		if err := cur.Decode(&d); err != nil {
+			return nil, err
+		}
+		results = append(results, &entities.InviteLinkRedemption{
+			ID:        uint(d.ID),
+			LinkId:    d.LinkId,
+			RoomId:    d.RoomId,
+			UserId:    d.UserId,
+			CreatedAt: d.CreatedAt,
+		})
+	}
+	return results, cur.Err()
+}
+
+func (r *MongoInviteLinkRepository) toEntity(d inviteLinkDoc) *entities.InviteLink {
+	return &entities.InviteLink{
+		ID:        uint(d.ID),
+		RoomId:    d.RoomId,
+		Token:     d.Token,
+		CreatedBy: d.CreatedBy,
+		Role:      d.Role,
+		MaxUses:   d.MaxUses,
+		Uses:      d.Uses,
+		ExpiresAt: d.ExpiresAt,
+		RevokedAt: d.RevokedAt,
+		CreatedAt: d.CreatedAt,
+		UpdatedAt: d.UpdatedAt,
+	}
+}
+
+func (r *MongoInviteLinkRepository) getNextSequence(ctx context.Context, name string) (int, error) {
+	counters := r.db.Collection("counters")
+	opts := options.FindOneAndUpdate().
+		SetUpsert(true).
+		SetReturnDocument(options.After)
+
+	var out counterDoc
+	err := counters.FindOneAndUpdate(
+		ctx,
+		bson.M{"_id": name},
+		bson.M{"$inc": bson.M{"seq": 1}},
+		opts,
+	).Decode(&out)
+
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		_, ierr := counters.InsertOne(ctx, counterDoc{ID: name, Seq: 1})
+		if ierr != nil {
+			return 0, ierr
+		}
+		return 1, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if out.Seq == 0 {
+		return 1, nil
+	}
+	return out.Seq, nil
+}
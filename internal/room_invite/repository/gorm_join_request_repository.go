@@ -0,0 +1,193 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/MingPV/ChatService/internal/entities"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type MongoJoinRequestRepository struct {
+	db   *mongo.Database
+	coll *mongo.Collection
+}
+
+func NewMongoJoinRequestRepository(db *mongo.Database) JoinRequestRepository {
+	return &MongoJoinRequestRepository{
+		db:   db,
+		coll: db.Collection("join_requests"),
+	}
+}
+
+type joinRequestDoc struct {
+	ID        int                        `bson:"_id,omitempty"`
+	RoomId    uint                       `bson:"room_id"`
+	UserId    uuid.UUID                  `bson:"user_id"`
+	Message   string                     `bson:"message,omitempty"`
+	Status    entities.JoinRequestStatus `bson:"status"`
+	DecidedBy *uuid.UUID                 `bson:"decided_by,omitempty"`
+	DecidedAt *time.Time                 `bson:"decided_at,omitempty"`
+	CreatedAt time.Time                  `bson:"created_at"`
+	UpdatedAt time.Time                  `bson:"updated_at"`
+}
+
+// EnsureIndexes allows a single pending request per user and room
+func (r *MongoJoinRequestRepository) EnsureIndexes() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := r.coll.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "room_id", Value: 1}, {Key: "user_id", Value: 1}},
+		Options: options.Index().
+			SetName("pending_room_user").
+			SetUnique(true).
+			SetPartialFilterExpression(bson.M{"status": entities.JoinRequestPending}),
+	})
+	return err
+}
+
+func (r *MongoJoinRequestRepository) Save(request *entities.JoinRequest) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	nextID, err := r.getNextSequence(ctx, "join_requests")
+	if err != nil {
+		return err
+	}
+
+	_, err = r.coll.InsertOne(ctx, joinRequestDoc{
+		ID:        nextID,
+		RoomId:    request.RoomId,
+		UserId:    request.UserId,
+		Message:   request.Message,
+		Status:    request.Status,
+		CreatedAt: request.CreatedAt,
+		UpdatedAt: request.UpdatedAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	request.ID = uint(nextID)
+	return nil
+}
+
+func (r *MongoJoinRequestRepository) FindByID(id int) (*entities.JoinRequest, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var d joinRequestDoc
+	err := r.coll.FindOne(ctx, bson.M{"_id": id}).Decode(&d)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return &entities.JoinRequest{}, err
+	}
+	if err != nil {
+		return nil, err
+	}
+	return r.toEntity(d), nil
+}
+
+func (r *MongoJoinRequestRepository) FindAllPendingByRoomID(roomId uint) ([]*entities.JoinRequest, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	cur, err := r.coll.Find(ctx,
+		bson.M{"room_id": roomId, "status": entities.JoinRequestPending},
+		options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var results []*entities.JoinRequest
+	for cur.Next(ctx) {
+		var d joinRequestDoc
+		if err := cur.Decode(&d); err != nil {
+			return nil, err
+		}
+		results = append(results, r.toEntity(d))
+	}
+	return results, cur.Err()
+}
+
+func (r *MongoJoinRequestRepository) Decide(id uint, status entities.JoinRequestStatus, decidedBy uuid.UUID, at time.Time) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	res, err := r.coll.UpdateOne(ctx,
+		bson.M{"_id": id, "status": entities.JoinRequestPending},
+		bson.M{"$set": bson.M{"status": status, "decided_by": decidedBy, "decided_at": at, "updated_at": at}},
+	)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+func (r *MongoJoinRequestRepository) FindPendingByRoomIDAndUserID(roomId uint, userId uuid.UUID) (*entities.JoinRequest, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var d joinRequestDoc
+	err := r.coll.FindOne(ctx, bson.M{"room_id": roomId, "user_id": userId, "status": entities.JoinRequestPending}).Decode(&d)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return &entities.JoinRequest{}, err
+	}
+	if err != nil {
+		return nil, err
+	}
+	return r.toEntity(d), nil
+}
+
+func (r *MongoJoinRequestRepository) toEntity(d joinRequestDoc) *entities.JoinRequest {
+	return &entities.JoinRequest{
+		ID:        uint(d.ID),
+		RoomId:    d.RoomId,
+		UserId:    d.UserId,
+		Message:   d.Message,
+		Status:    d.Status,
+		DecidedBy: d.DecidedBy,
+		DecidedAt: d.DecidedAt,
+		CreatedAt: d.CreatedAt,
+		UpdatedAt: d.UpdatedAt,
+	}
+}
+
+func (r *MongoJoinRequestRepository) getNextSequence(ctx context.Context, name string) (int, error) {
+	counters := r.db.Collection("counters")
+	opts := options.FindOneAndUpdate().
+		SetUpsert(true).
+		SetReturnDocument(options.After)
+
+	var out counterDoc
+	err := counters.FindOneAndUpdate(
+		ctx,
+		bson.M{"_id": name},
+		bson.M{"$inc": bson.M{"seq": 1}},
+		opts,
+	).Decode(&out)
+
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		_, ierr := counters.InsertOne(ctx, counterDoc{ID: name, Seq: 1})
+		if ierr != nil {
+			return 0, ierr
+		}
+		return 1, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if out.Seq == 0 {
+		return 1, nil
+	}
+	return out.Seq, nil
+}
@@ -30,8 +30,11 @@ type roomInviteDoc struct {
 	RoomId    uint      `bson:"room_id"`
 	Sender    uuid.UUID `bson:"sender"`
 	InviteTo  uuid.UUID `bson:"invite_to"`
+	Status     entities.InviteStatus `bson:"status,omitempty"` // empty on invites stored before statuses existed
 	IsAccepted bool     `bson:"is_accepted"`
 	IsDenied   bool     `bson:"is_denied"`
+	ExpiresAt   *time.Time `bson:"expires_at,omitempty"`
+	RespondedAt *time.Time `bson:"responded_at,omitempty"`
 	CreatedAt time.Time `bson:"created_at"`
 	UpdatedAt time.Time `bson:"updated_at"`
 }
@@ -56,8 +59,10 @@ func (r *MongoRoomInviteRepository) Save(invite *entities.RoomInvite) error {
 		RoomId:     invite.RoomId,
 		Sender:     invite.Sender,
 		InviteTo:   invite.InviteTo,
-		IsAccepted: invite.IsAccepted,
-		IsDenied:   invite.IsDenied,
+		Status:     invite.Status,
+		IsAccepted: invite.Status == entities.InviteStatusAccepted,
+		IsDenied:   invite.Status == entities.InviteStatusDenied,
+		ExpiresAt:  invite.ExpiresAt,
 		CreatedAt:  invite.CreatedAt,
 		UpdatedAt:  invite.UpdatedAt,
 	})
@@ -162,20 +167,123 @@ func (r *MongoRoomInviteRepository) Patch(id int, invite *entities.RoomInvite) e
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
+	// room, sender and invitee are fixed once the invite is sent
 	update := bson.M{}
-	if invite.RoomId != 0 {
-		update["room_id"] = invite.RoomId
-	}
-	update["sender"] = invite.Sender
-	update["invite_to"] = invite.InviteTo
-	update["is_accepted"] = invite.IsAccepted
-	update["is_denied"] = invite.IsDenied
 	update["updated_at"] = invite.UpdatedAt
 
 	_, err := r.coll.UpdateByID(ctx, id, bson.M{"$set": update})
 	return err
 }
 
+// pendingFilter matches pending invites, including ones stored before statuses existed
+func pendingFilter() bson.M {
+	return bson.M{"$or": bson.A{
+		bson.M{"status": entities.InviteStatusPending},
+		bson.M{"status": bson.M{"$exists": false}, "is_accepted": false, "is_denied": false},
+	}}
+}
+
+// EnsureIndexes keeps a single pending invite per room and invitee
+func (r *MongoRoomInviteRepository) EnsureIndexes() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := r.coll.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "room_id", Value: 1}, {Key: "invite_to", Value: 1}},
+			Options: options.Index().
+				SetName("pending_room_invitee").
+				SetUnique(true).
+				SetPartialFilterExpression(bson.M{"status": entities.InviteStatusPending}),
+		},
+		{
+			Keys:    bson.D{{Key: "status", Value: 1}, {Key: "expires_at", Value: 1}},
+			Options: options.Index().SetName("status_expires_at"),
+		},
+	})
+	return err
+}
+
+func (r *MongoRoomInviteRepository) FindPendingByRoomIDAndInviteTo(roomId uint, inviteTo uuid.UUID) (*entities.RoomInvite, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	filter := pendingFilter()
+	filter["room_id"] = roomId
+	filter["invite_to"] = inviteTo
+
+	var d roomInviteDoc
+	err := r.coll.FindOne(ctx, filter).Decode(&d)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return &entities.RoomInvite{}, err
+	}
+	if err != nil {
+		return nil, err
+	}
+	return r.toEntity(d), nil
+}
+
+func (r *MongoRoomInviteRepository) FindLatestDeniedByRoomIDAndInviteTo(roomId uint, inviteTo uuid.UUID) (*entities.RoomInvite, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	filter := bson.M{
+		"room_id":   roomId,
+		"invite_to": inviteTo,
+		"$or": bson.A{
+			bson.M{"status": entities.InviteStatusDenied},
+			bson.M{"status": bson.M{"$exists": false}, "is_denied": true},
+		},
+	}
+	opts := options.FindOne().SetSort(bson.D{{Key: "updated_at", Value: -1}})
+
+	var d roomInviteDoc
+	err := r.coll.FindOne(ctx, filter, opts).Decode(&d)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return &entities.RoomInvite{}, err
+	}
+	if err != nil {
+		return nil, err
+	}
+	return r.toEntity(d), nil
+}
+
+func (r *MongoRoomInviteRepository) Transition(id uint, to entities.InviteStatus, at time.Time) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	filter := pendingFilter()
+	filter["_id"] = id
+	res, err := r.coll.UpdateOne(ctx, filter, bson.M{"$set": bson.M{
+		"status":       to,
+		"is_accepted":  to == entities.InviteStatusAccepted,
+		"is_denied":    to == entities.InviteStatusDenied,
+		"responded_at": at,
+		"updated_at":   at,
+	}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+func (r *MongoRoomInviteRepository) ExpireAllPending(now time.Time) (int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	res, err := r.coll.UpdateMany(ctx,
+		bson.M{"status": entities.InviteStatusPending, "expires_at": bson.M{"$lte": now}},
+		bson.M{"$set": bson.M{"status": entities.InviteStatusExpired, "responded_at": now, "updated_at": now}},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return res.ModifiedCount, nil
+}
+
 func (r *MongoRoomInviteRepository) Delete(id int) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -216,14 +324,28 @@ func (r *MongoRoomInviteRepository) getNextSequence(ctx context.Context, name st
 }
 
 func (r *MongoRoomInviteRepository) toEntity(d roomInviteDoc) *entities.RoomInvite {
+	status := d.Status
+	if status == "" {
+		switch {
+		case d.IsAccepted:
+			status = entities.InviteStatusAccepted
+		case d.IsDenied:
+			status = entities.InviteStatusDenied
+		default:
+			status = entities.InviteStatusPending
+		}
+	}
 	return &entities.RoomInvite{
-		ID:         uint(d.ID),
-		RoomId:     d.RoomId,
-		Sender:     d.Sender,
-		InviteTo:   d.InviteTo,
-		IsAccepted: d.IsAccepted,
-		IsDenied:   d.IsDenied,
-		CreatedAt:  d.CreatedAt,
-		UpdatedAt:  d.UpdatedAt,
+		ID:          uint(d.ID),
+		RoomId:      d.RoomId,
+		Sender:      d.Sender,
+		InviteTo:    d.InviteTo,
+		Status:      status,
+		IsAccepted:  status == entities.InviteStatusAccepted,
+		IsDenied:    status == entities.InviteStatusDenied,
+		ExpiresAt:   d.ExpiresAt,
+		RespondedAt: d.RespondedAt,
+		CreatedAt:   d.CreatedAt,
+		UpdatedAt:   d.UpdatedAt,
 	}
 }
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/MingPV/ChatService/internal/entities"
+)
+
+type InviteLinkRepository interface {
+	Save(link *entities.InviteLink) error
+	FindByID(id int) (*entities.InviteLink, error)
+	FindByToken(token string) (*entities.InviteLink, error)
+	FindAllActiveByRoomID(roomId uint, now time.Time) ([]*entities.InviteLink, error)
+	Revoke(id uint, revokedAt time.Time) error
+	// ClaimUse takes one use of an active link, failing with ErrNoDocuments when none is left.
+	ClaimUse(id uint, now time.Time) error
+	// ReleaseUse gives back a use claimed for a join that did not complete.
+	ReleaseUse(id uint) error
+
+	SaveRedemption(redemption *entities.InviteLinkRedemption) error
+	FindAllRedemptionsByLinkID(linkId uint) ([]*entities.InviteLinkRedemption, error)
+	EnsureIndexes() error
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/MingPV/ChatService/internal/entities"
+	"github.com/google/uuid"
+)
+
+type JoinRequestRepository interface {
+	Save(request *entities.JoinRequest) error
+	FindByID(id int) (*entities.JoinRequest, error)
+	FindPendingByRoomIDAndUserID(roomId uint, userId uuid.UUID) (*entities.JoinRequest, error)
+	FindAllPendingByRoomID(roomId uint) ([]*entities.JoinRequest, error)
+	// Decide moves a pending request to status, failing with ErrNoDocuments if it is no longer pending.
+	Decide(id uint, status entities.JoinRequestStatus, decidedBy uuid.UUID, at time.Time) error
+	EnsureIndexes() error
+}
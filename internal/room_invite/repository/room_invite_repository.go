@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"time"
+
 	"github.com/MingPV/ChatService/internal/entities"
 	"github.com/google/uuid"
 )
@@ -13,4 +15,12 @@ type RoomInviteRepository interface {
 	FindAllByInviteTo(inviteTo uuid.UUID) ([]*entities.RoomInvite, error)
 	Patch(id int, invite *entities.RoomInvite) error
 	Delete(id int) error
+
+	FindPendingByRoomIDAndInviteTo(roomId uint, inviteTo uuid.UUID) (*entities.RoomInvite, error)
+	FindLatestDeniedByRoomIDAndInviteTo(roomId uint, inviteTo uuid.UUID) (*entities.RoomInvite, error)
+	// Transition moves a pending invite to status to, failing with ErrNoDocuments if it is no longer pending.
+	Transition(id uint, to entities.InviteStatus, at time.Time) error
+	// ExpireAllPending marks pending invites past their expiry as expired and returns how many changed.
+	ExpireAllPending(now time.Time) (int64, error)
+	EnsureIndexes() error
 }
\ No newline at end of file
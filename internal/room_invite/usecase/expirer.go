@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"context"
+	"log"
+	"time"
+
+	roominviteRepo "github.com/MingPV/ChatService/internal/room_invite/repository"
+)
+
+// InviteExpirer moves pending invites past their expiry to the expired state
+type InviteExpirer struct {
+	repo     roominviteRepo.RoomInviteRepository
+	interval time.Duration
+}
+
+func NewInviteExpirer(repo roominviteRepo.RoomInviteRepository, interval time.Duration) *InviteExpirer {
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	return &InviteExpirer{repo: repo, interval: interval}
+}
+
+func (e *InviteExpirer) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(e.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if _, err := e.repo.ExpireAllPending(time.Now().UTC()); err != nil {
+					log.Printf("invite expirer: %v", err)
+				}
+			}
+		}
+	}()
+}
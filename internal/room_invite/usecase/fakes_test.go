@@ -0,0 +1,12 @@
+package usecase
+
+import (
+	"errors"
+	"time"
+)
+
+var errSaveFailed = errors.New("save failed")
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
@@ -13,5 +13,30 @@ type RoomInviteUseCase interface {
 	FindAllByRoomId(roomId int) ([]*entities.RoomInvite, error)
 	PatchInvite(id int, invite *entities.RoomInvite) error
 	DeleteInvite(id int) error
-	AcceptedInvite(id int) error
+	// AcceptedInvite, DenyInvite and RevokeInvite move a pending invite to its final state.
+	AcceptedInvite(id int, userId uuid.UUID) error
+	DenyInvite(id int, userId uuid.UUID) error
+	RevokeInvite(id int, userId uuid.UUID) error
+}
+
+type InviteLinkUseCase interface {
+	// CreateInviteLink issues a shareable token for link.RoomId; link.CreatedBy must moderate the room.
+	CreateInviteLink(link *entities.InviteLink) error
+	// RedeemInviteLink makes userId a member of the link's room.
+	RedeemInviteLink(token string, userId uuid.UUID) (*entities.RoomMember, error)
+	FindActiveInviteLinks(roomId uint, userId uuid.UUID) ([]*entities.InviteLink, error)
+	RevokeInviteLink(id int, userId uuid.UUID) error
+	// FindInviteLinkRedemptions lists who joined through the link, newest first.
+	FindInviteLinkRedemptions(id int, userId uuid.UUID) ([]*entities.InviteLinkRedemption, error)
+}
+
+type JoinRequestUseCase interface {
+	// RequestToJoin files a pending request for an approval-gated group room and alerts its moderators.
+	RequestToJoin(roomId uint, userId uuid.UUID, message string) (*entities.JoinRequest, error)
+	FindPendingJoinRequests(roomId uint, userId uuid.UUID) ([]*entities.JoinRequest, error)
+	// ApproveJoinRequest adds the requester to the room; userId must moderate it.
+	ApproveJoinRequest(id int, userId uuid.UUID) (*entities.JoinRequest, error)
+	RejectJoinRequest(id int, userId uuid.UUID) (*entities.JoinRequest, error)
+	// CancelJoinRequest withdraws the caller's own pending request.
+	CancelJoinRequest(id int, userId uuid.UUID) (*entities.JoinRequest, error)
 }
@@ -0,0 +1,188 @@
+package usecase
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"log"
+	"time"
+
+	chatroomRepo "github.com/MingPV/ChatService/internal/chatroom/repository"
+	"github.com/MingPV/ChatService/internal/entities"
+	roominviteRepo "github.com/MingPV/ChatService/internal/room_invite/repository"
+	roommemberRepo "github.com/MingPV/ChatService/internal/room_member/repository"
+	"github.com/MingPV/ChatService/pkg/apperror"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// InviteLinkService implements InviteLinkUseCase
+type InviteLinkService struct {
+	linkRepo       roominviteRepo.InviteLinkRepository
+	roommemberRepo roommemberRepo.RoomMemberRepository
+	chatroomRepo   chatroomRepo.ChatroomRepository
+	restrictionRepo roommemberRepo.RestrictionRepository
+}
+
+func NewInviteLinkService(linkRepo roominviteRepo.InviteLinkRepository, roommemberRepo roommemberRepo.RoomMemberRepository, chatroomRepo chatroomRepo.ChatroomRepository, restrictionRepo roommemberRepo.RestrictionRepository) InviteLinkUseCase {
+	return &InviteLinkService{linkRepo: linkRepo, roommemberRepo: roommemberRepo, chatroomRepo: chatroomRepo, restrictionRepo: restrictionRepo}
+}
+
+func (s *InviteLinkService) CreateInviteLink(link *entities.InviteLink) error {
+	room, role, err := s.roleIn(link.RoomId, link.CreatedBy)
+	if err != nil {
+		return err
+	}
+	if !room.IsGroup || !role.CanModerate() {
+		return apperror.ErrForbidden
+	}
+
+	if link.Role == "" {
+		link.Role = entities.RoomRoleMember
+	}
+	switch link.Role {
+	case entities.RoomRoleMember:
+	case entities.RoomRoleAdmin:
+		// only the owner hands out admin rights
+		if role != entities.RoomRoleOwner {
+			return apperror.ErrForbidden
+		}
+	default:
+		return apperror.ErrInvalidData
+	}
+	if link.MaxUses < 0 {
+		return apperror.ErrOutOfRange
+	}
+	now := time.Now().UTC()
+	if link.ExpiresAt != nil && !link.ExpiresAt.After(now) {
+		return apperror.ErrOutOfRange
+	}
+
+	token, err := newInviteToken()
+	if err != nil {
+		return err
+	}
+	link.Token = token
+	link.Uses = 0
+	link.RevokedAt = nil
+	link.CreatedAt = now
+	link.UpdatedAt = now
+	return s.linkRepo.Save(link)
+}
+
+func (s *InviteLinkService) RedeemInviteLink(token string, userId uuid.UUID) (*entities.RoomMember, error) {
+	link, err := s.linkRepo.FindByToken(token)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	if !link.IsActive(now) {
+		return nil, apperror.ErrNotAvailable
+	}
+	if err := checkNotBanned(s.restrictionRepo, link.RoomId, userId); err != nil {
+		return nil, err
+	}
+
+	_, err = s.roommemberRepo.FindAllByRoomIDAndUserID(link.RoomId, userId)
+	if err == nil {
+		return nil, apperror.ErrAlreadyExists
+	}
+	if !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, err
+	}
+
+	// the use is claimed first so concurrent redemptions cannot exceed MaxUses
+	if err := s.linkRepo.ClaimUse(link.ID, now); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, apperror.ErrNotAvailable
+		}
+		return nil, err
+	}
+	if err := s.roommemberRepo.Save(link.RoomId, []uuid.UUID{userId}); err != nil {
+		if rerr := s.linkRepo.ReleaseUse(link.ID); rerr != nil {
+			log.Printf("failed to release use of invite link %d: %v", link.ID, rerr)
+		}
+		return nil, err
+	}
+	if link.Role != entities.RoomRoleMember {
+		if err := s.roommemberRepo.UpdateRole(link.RoomId, userId, link.Role); err != nil {
+			return nil, err
+		}
+	}
+
+	redemption := &entities.InviteLinkRedemption{LinkId: link.ID, RoomId: link.RoomId, UserId: userId, CreatedAt: now}
+	if err := s.linkRepo.SaveRedemption(redemption); err != nil {
+		log.Printf("failed to record redemption of invite link %d: %v", link.ID, err)
+	}
+	return s.roommemberRepo.FindAllByRoomIDAndUserID(link.RoomId, userId)
+}
+
+func (s *InviteLinkService) FindActiveInviteLinks(roomId uint, userId uuid.UUID) ([]*entities.InviteLink, error) {
+	if _, role, err := s.roleIn(roomId, userId); err != nil {
+		return nil, err
+	} else if !role.CanModerate() {
+		return nil, apperror.ErrForbidden
+	}
+	return s.linkRepo.FindAllActiveByRoomID(roomId, time.Now().UTC())
+}
+
+// RevokeInviteLink is allowed for the link's creator and room moderators
+func (s *InviteLinkService) RevokeInviteLink(id int, userId uuid.UUID) error {
+	link, err := s.moderatedLink(id, userId, true)
+	if err != nil {
+		return err
+	}
+	if err := s.linkRepo.Revoke(link.ID, time.Now().UTC()); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return apperror.ErrNotAvailable
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *InviteLinkService) FindInviteLinkRedemptions(id int, userId uuid.UUID) ([]*entities.InviteLinkRedemption, error) {
+	link, err := s.moderatedLink(id, userId, false)
+	if err != nil {
+		return nil, err
+	}
+	return s.linkRepo.FindAllRedemptionsByLinkID(link.ID)
+}
+
+// moderatedLink loads the link if userId moderates its room, or created it when allowCreator is set
+func (s *InviteLinkService) moderatedLink(id int, userId uuid.UUID, allowCreator bool) (*entities.InviteLink, error) {
+	link, err := s.linkRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+	_, role, err := s.roleIn(link.RoomId, userId)
+	if err != nil {
+		return nil, err
+	}
+	if !role.CanModerate() && !(allowCreator && link.CreatedBy == userId) {
+		return nil, apperror.ErrForbidden
+	}
+	return link, nil
+}
+
+// roleIn returns the room and the caller's role in it, or ErrForbidden for non-members
+func (s *InviteLinkService) roleIn(roomId uint, userId uuid.UUID) (*entities.Chatroom, entities.RoomRole, error) {
+	room, err := s.chatroomRepo.FindByID(int(roomId))
+	if err != nil {
+		return nil, "", err
+	}
+	role, err := roommemberRepo.RoleIn(s.roommemberRepo, room, userId)
+	if err != nil {
+		return nil, "", err
+	}
+	return room, role, nil
+}
+
+// newInviteToken returns 128 random bits, URL-safe encoded
+func newInviteToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
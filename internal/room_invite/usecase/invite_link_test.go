@@ -0,0 +1,147 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/MingPV/ChatService/internal/entities"
+	roominviteRepo "github.com/MingPV/ChatService/internal/room_invite/repository"
+	"github.com/MingPV/ChatService/internal/testsupport"
+	"github.com/MingPV/ChatService/pkg/apperror"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type fakeLinks struct {
+	roominviteRepo.InviteLinkRepository
+	link        *entities.InviteLink
+	claimErr    error
+	saved       *entities.InviteLink
+	released    int
+	redemptions int
+}
+
+func (f *fakeLinks) Save(link *entities.InviteLink) error {
+	f.saved = link
+	return nil
+}
+
+func (f *fakeLinks) FindByToken(token string) (*entities.InviteLink, error) {
+	if f.link == nil || f.link.Token != token {
+		return &entities.InviteLink{}, mongo.ErrNoDocuments
+	}
+	return f.link, nil
+}
+
+func (f *fakeLinks) ClaimUse(id uint, now time.Time) error {
+	if f.claimErr != nil {
+		return f.claimErr
+	}
+	f.link.Uses++
+	return nil
+}
+
+func (f *fakeLinks) ReleaseUse(id uint) error {
+	f.released++
+	f.link.Uses--
+	return nil
+}
+
+func (f *fakeLinks) SaveRedemption(redemption *entities.InviteLinkRedemption) error {
+	f.redemptions++
+	return nil
+}
+
+func TestCreateInviteLink(t *testing.T) {
+	owner, admin, member := uuid.New(), uuid.New(), uuid.New()
+	tests := []struct {
+		name    string
+		by      uuid.UUID
+		link    entities.InviteLink
+		wantErr error
+	}{
+		{"admin creates a member link", admin, entities.InviteLink{}, nil},
+		{"owner creates an admin link", owner, entities.InviteLink{Role: entities.RoomRoleAdmin}, nil},
+		{"admin cannot hand out admin", admin, entities.InviteLink{Role: entities.RoomRoleAdmin}, apperror.ErrForbidden},
+		{"member cannot create links", member, entities.InviteLink{}, apperror.ErrForbidden},
+		{"non-member", uuid.New(), entities.InviteLink{}, apperror.ErrForbidden},
+		{"unknown role", owner, entities.InviteLink{Role: "guest"}, apperror.ErrInvalidData},
+		{"negative use limit", owner, entities.InviteLink{MaxUses: -1}, apperror.ErrOutOfRange},
+		{"already expired", owner, entities.InviteLink{ExpiresAt: timePtr(time.Now().Add(-time.Minute))}, apperror.ErrOutOfRange},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			links := &fakeLinks{}
+			members := testsupport.NewRoomMembers(1, map[uuid.UUID]entities.RoomRole{admin: entities.RoomRoleAdmin, member: entities.RoomRoleMember})
+			members.Add(&entities.RoomMember{RoomId: 1, UserId: owner})
+			s := NewInviteLinkService(links, members, testsupport.NewChatrooms(&entities.Chatroom{ID: 1, IsGroup: true, Owner: owner}), &testsupport.Restrictions{})
+
+			link := tt.link
+			link.RoomId = 1
+			link.CreatedBy = tt.by
+			err := s.CreateInviteLink(&link)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CreateInviteLink = %v, want %v", err, tt.wantErr)
+			}
+			if (links.saved != nil) != (tt.wantErr == nil) {
+				t.Fatalf("saved = %v", links.saved)
+			}
+			if err == nil && (link.Token == "" || link.Role == "") {
+				t.Fatalf("link = %+v, want a token and a role", link)
+			}
+		})
+	}
+}
+
+func TestRedeemInviteLink(t *testing.T) {
+	member, banned, joiner := uuid.New(), uuid.New(), uuid.New()
+	past := time.Now().Add(-time.Minute)
+	tests := []struct {
+		name         string
+		link         entities.InviteLink
+		userId       uuid.UUID
+		claimErr     error
+		saveErr      error
+		wantErr      error
+		wantRole     entities.RoomRole
+		wantReleased int
+	}{
+		{"joins as member", entities.InviteLink{Role: entities.RoomRoleMember}, joiner, nil, nil, nil, entities.RoomRoleMember, 0},
+		{"joins as admin", entities.InviteLink{Role: entities.RoomRoleAdmin}, joiner, nil, nil, nil, entities.RoomRoleAdmin, 0},
+		{"expired link", entities.InviteLink{Role: entities.RoomRoleMember, ExpiresAt: &past}, joiner, nil, nil, apperror.ErrNotAvailable, "", 0},
+		{"revoked link", entities.InviteLink{Role: entities.RoomRoleMember, RevokedAt: &past}, joiner, nil, nil, apperror.ErrNotAvailable, "", 0},
+		{"no uses left", entities.InviteLink{Role: entities.RoomRoleMember, MaxUses: 2, Uses: 2}, joiner, nil, nil, apperror.ErrNotAvailable, "", 0},
+		{"last use taken concurrently", entities.InviteLink{Role: entities.RoomRoleMember, MaxUses: 2, Uses: 1}, joiner, mongo.ErrNoDocuments, nil, apperror.ErrNotAvailable, "", 0},
+		{"banned user", entities.InviteLink{Role: entities.RoomRoleMember}, banned, nil, nil, apperror.ErrForbidden, "", 0},
+		{"already a member", entities.InviteLink{Role: entities.RoomRoleMember}, member, nil, nil, apperror.ErrAlreadyExists, "", 0},
+		{"failed join gives the use back", entities.InviteLink{Role: entities.RoomRoleMember, MaxUses: 1}, joiner, nil, errSaveFailed, errSaveFailed, "", 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			link := tt.link
+			link.ID, link.RoomId, link.Token = 3, 1, "token"
+			links := &fakeLinks{link: &link, claimErr: tt.claimErr}
+			members := testsupport.NewRoomMembers(1, map[uuid.UUID]entities.RoomRole{member: entities.RoomRoleMember})
+			members.SaveErr = tt.saveErr
+			s := NewInviteLinkService(links, members, nil, testsupport.NewRestrictions(1, entities.RestrictionBan, banned))
+
+			got, err := s.RedeemInviteLink("token", tt.userId)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("RedeemInviteLink = %v, want %v", err, tt.wantErr)
+			}
+			if links.released != tt.wantReleased {
+				t.Fatalf("released %d uses, want %d", links.released, tt.wantReleased)
+			}
+			if err != nil {
+				if links.redemptions != 0 {
+					t.Fatal("failed redemption was recorded")
+				}
+				return
+			}
+			if got.Role != tt.wantRole || links.redemptions != 1 || link.Uses != tt.link.Uses+1 {
+				t.Fatalf("role = %s, redemptions = %d, uses = %d", got.Role, links.redemptions, link.Uses)
+			}
+		})
+	}
+}
@@ -0,0 +1,209 @@
+package usecase
+
+import (
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	chatroomRepo "github.com/MingPV/ChatService/internal/chatroom/repository"
+	"github.com/MingPV/ChatService/internal/entities"
+	messageUseCase "github.com/MingPV/ChatService/internal/message/usecase"
+	roominviteRepo "github.com/MingPV/ChatService/internal/room_invite/repository"
+	roommemberRepo "github.com/MingPV/ChatService/internal/room_member/repository"
+	"github.com/MingPV/ChatService/pkg/apperror"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const maxJoinRequestMessage = 500
+
+// JoinRequestService implements JoinRequestUseCase
+type JoinRequestService struct {
+	requestRepo     roominviteRepo.JoinRequestRepository
+	roommemberRepo  roommemberRepo.RoomMemberRepository
+	chatroomRepo    chatroomRepo.ChatroomRepository
+	restrictionRepo roommemberRepo.RestrictionRepository
+	messageUseCase  messageUseCase.MessageUseCase
+}
+
+func NewJoinRequestService(requestRepo roominviteRepo.JoinRequestRepository, roommemberRepo roommemberRepo.RoomMemberRepository, chatroomRepo chatroomRepo.ChatroomRepository, restrictionRepo roommemberRepo.RestrictionRepository, messageUseCase messageUseCase.MessageUseCase) JoinRequestUseCase {
+	return &JoinRequestService{requestRepo: requestRepo, roommemberRepo: roommemberRepo, chatroomRepo: chatroomRepo, restrictionRepo: restrictionRepo, messageUseCase: messageUseCase}
+}
+
+func (s *JoinRequestService) RequestToJoin(roomId uint, userId uuid.UUID, message string) (*entities.JoinRequest, error) {
+	message = strings.TrimSpace(message)
+	if len([]rune(message)) > maxJoinRequestMessage {
+		return nil, apperror.ErrOutOfRange
+	}
+	room, err := s.chatroomRepo.FindByID(int(roomId))
+	if err != nil {
+		return nil, err
+	}
+	if !room.IsGroup || room.JoinPolicy != entities.JoinPolicyApproval {
+		return nil, apperror.ErrOperationDenied
+	}
+	if err := checkNotBanned(s.restrictionRepo, roomId, userId); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.roommemberRepo.FindAllByRoomIDAndUserID(roomId, userId); err == nil {
+		return nil, apperror.ErrAlreadyExists
+	} else if !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, err
+	}
+	if _, err := s.requestRepo.FindPendingByRoomIDAndUserID(roomId, userId); err == nil {
+		return nil, apperror.ErrAlreadyExists
+	} else if !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	request := &entities.JoinRequest{
+		RoomId:    roomId,
+		UserId:    userId,
+		Message:   message,
+		Status:    entities.JoinRequestPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := s.requestRepo.Save(request); err != nil {
+		// a concurrent request from the same user got in first
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, apperror.ErrAlreadyExists
+		}
+		return nil, err
+	}
+	s.notifyModerators(room, request)
+	return request, nil
+}
+
+func (s *JoinRequestService) FindPendingJoinRequests(roomId uint, userId uuid.UUID) ([]*entities.JoinRequest, error) {
+	if err := s.checkCanModerate(roomId, userId); err != nil {
+		return nil, err
+	}
+	return s.requestRepo.FindAllPendingByRoomID(roomId)
+}
+
+func (s *JoinRequestService) ApproveJoinRequest(id int, userId uuid.UUID) (*entities.JoinRequest, error) {
+	request, err := s.pending(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkCanModerate(request.RoomId, userId); err != nil {
+		return nil, err
+	}
+	// the requester may have been banned while the request was pending
+	if err := checkNotBanned(s.restrictionRepo, request.RoomId, request.UserId); err != nil {
+		return nil, err
+	}
+
+	// join first so a failed join leaves the request pending
+	added := false
+	if _, err := s.roommemberRepo.FindAllByRoomIDAndUserID(request.RoomId, request.UserId); errors.Is(err, mongo.ErrNoDocuments) {
+		if err := s.roommemberRepo.Save(request.RoomId, []uuid.UUID{request.UserId}); err != nil {
+			return nil, err
+		}
+		added = true
+	} else if err != nil {
+		return nil, err
+	}
+
+	if err := s.decide(request, entities.JoinRequestApproved, userId); err != nil {
+		if added {
+			if rerr := s.roommemberRepo.DeleteByRoomIDAndUserID(request.RoomId, request.UserId); rerr != nil {
+				log.Printf("failed to undo membership for join request %d: %v", request.ID, rerr)
+			}
+		}
+		return nil, err
+	}
+	return request, nil
+}
+
+func (s *JoinRequestService) RejectJoinRequest(id int, userId uuid.UUID) (*entities.JoinRequest, error) {
+	request, err := s.pending(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkCanModerate(request.RoomId, userId); err != nil {
+		return nil, err
+	}
+	if err := s.decide(request, entities.JoinRequestRejected, userId); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func (s *JoinRequestService) CancelJoinRequest(id int, userId uuid.UUID) (*entities.JoinRequest, error) {
+	request, err := s.pending(id)
+	if err != nil {
+		return nil, err
+	}
+	if request.UserId != userId {
+		return nil, apperror.ErrForbidden
+	}
+	now := time.Now().UTC()
+	if err := s.requestRepo.Decide(request.ID, entities.JoinRequestCancelled, userId, now); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, apperror.ErrNotAvailable
+		}
+		return nil, err
+	}
+	request.Status = entities.JoinRequestCancelled
+	request.DecidedBy = &userId
+	request.DecidedAt = &now
+	request.UpdatedAt = now
+	return request, nil
+}
+
+func (s *JoinRequestService) pending(id int) (*entities.JoinRequest, error) {
+	request, err := s.requestRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if request.Status != entities.JoinRequestPending {
+		return nil, apperror.ErrNotAvailable
+	}
+	return request, nil
+}
+
+// decide records a moderator's decision and tells the requester about it
+func (s *JoinRequestService) decide(request *entities.JoinRequest, status entities.JoinRequestStatus, moderatorId uuid.UUID) error {
+	now := time.Now().UTC()
+	if err := s.requestRepo.Decide(request.ID, status, moderatorId, now); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return apperror.ErrNotAvailable
+		}
+		return err
+	}
+	request.Status = status
+	request.DecidedBy = &moderatorId
+	request.DecidedAt = &now
+	request.UpdatedAt = now
+
+	s.messageUseCase.NotifyUser(request.UserId, &entities.RoomEvent{Type: entities.RoomEventJoinRequestDecided, RoomId: request.RoomId, JoinRequest: request})
+	return nil
+}
+
+// notifyModerators pushes a new request to every owner and admin with an open stream
+func (s *JoinRequestService) notifyModerators(room *entities.Chatroom, request *entities.JoinRequest) {
+	members, err := s.roommemberRepo.FindAllByRoomID(room.ID)
+	if err != nil {
+		log.Printf("failed to load moderators of room %d: %v", room.ID, err)
+		return
+	}
+	event := &entities.RoomEvent{Type: entities.RoomEventJoinRequested, RoomId: room.ID, JoinRequest: request}
+	for _, m := range members {
+		if m.EffectiveRole(room).CanModerate() {
+			s.messageUseCase.NotifyUser(m.UserId, event)
+		}
+	}
+}
+
+func (s *JoinRequestService) checkCanModerate(roomId uint, userId uuid.UUID) error {
+	room, err := s.chatroomRepo.FindByID(int(roomId))
+	if err != nil {
+		return err
+	}
+	return roommemberRepo.CheckCanModerate(s.roommemberRepo, room, userId)
+}
@@ -0,0 +1,203 @@
+package usecase
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MingPV/ChatService/internal/entities"
+	messageUseCase "github.com/MingPV/ChatService/internal/message/usecase"
+	roominviteRepo "github.com/MingPV/ChatService/internal/room_invite/repository"
+	"github.com/MingPV/ChatService/internal/testsupport"
+	"github.com/MingPV/ChatService/pkg/apperror"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type fakeJoinRequests struct {
+	roominviteRepo.JoinRequestRepository
+	requests  []*entities.JoinRequest
+	saveErr   error
+	decideErr error
+}
+
+func (f *fakeJoinRequests) Save(request *entities.JoinRequest) error {
+	if f.saveErr != nil {
+		return f.saveErr
+	}
+	request.ID = uint(len(f.requests) + 1)
+	f.requests = append(f.requests, request)
+	return nil
+}
+
+func (f *fakeJoinRequests) FindByID(id int) (*entities.JoinRequest, error) {
+	for _, r := range f.requests {
+		if r.ID == uint(id) {
+			copied := *r
+			return &copied, nil
+		}
+	}
+	return &entities.JoinRequest{}, mongo.ErrNoDocuments
+}
+
+func (f *fakeJoinRequests) FindPendingByRoomIDAndUserID(roomId uint, userId uuid.UUID) (*entities.JoinRequest, error) {
+	for _, r := range f.requests {
+		if r.RoomId == roomId && r.UserId == userId && r.Status == entities.JoinRequestPending {
+			return r, nil
+		}
+	}
+	return &entities.JoinRequest{}, mongo.ErrNoDocuments
+}
+
+func (f *fakeJoinRequests) Decide(id uint, status entities.JoinRequestStatus, decidedBy uuid.UUID, at time.Time) error {
+	if f.decideErr != nil {
+		return f.decideErr
+	}
+	for _, r := range f.requests {
+		if r.ID == id && r.Status == entities.JoinRequestPending {
+			r.Status = status
+			return nil
+		}
+	}
+	return mongo.ErrNoDocuments
+}
+
+// notifications records NotifyUser calls by user
+type notifications struct {
+	messageUseCase.MessageUseCase
+	events map[uuid.UUID][]entities.RoomEventType
+}
+
+func (n *notifications) NotifyUser(userId uuid.UUID, event *entities.RoomEvent) {
+	if n.events == nil {
+		n.events = make(map[uuid.UUID][]entities.RoomEventType)
+	}
+	n.events[userId] = append(n.events[userId], event.Type)
+}
+
+func TestRequestToJoin(t *testing.T) {
+	owner, admin, member, requester, banned := uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	approvalRoom := &entities.Chatroom{ID: 1, IsGroup: true, Owner: owner, JoinPolicy: entities.JoinPolicyApproval}
+
+	tests := []struct {
+		name     string
+		room     *entities.Chatroom
+		userId   uuid.UUID
+		message  string
+		existing []*entities.JoinRequest
+		wantErr  error
+	}{
+		{"files a request", approvalRoom, requester, " let me in ", nil, nil},
+		{"open room", &entities.Chatroom{ID: 1, IsGroup: true, JoinPolicy: entities.JoinPolicyOpen}, requester, "", nil, apperror.ErrOperationDenied},
+		{"direct room", &entities.Chatroom{ID: 1, JoinPolicy: entities.JoinPolicyApproval}, requester, "", nil, apperror.ErrOperationDenied},
+		{"message too long", approvalRoom, requester, strings.Repeat("x", maxJoinRequestMessage+1), nil, apperror.ErrOutOfRange},
+		{"banned user", approvalRoom, banned, "", nil, apperror.ErrForbidden},
+		{"already a member", approvalRoom, member, "", nil, apperror.ErrAlreadyExists},
+		{"request already pending", approvalRoom, requester, "", []*entities.JoinRequest{
+			{ID: 1, RoomId: 1, UserId: requester, Status: entities.JoinRequestPending},
+		}, apperror.ErrAlreadyExists},
+		{"earlier request was rejected", approvalRoom, requester, "", []*entities.JoinRequest{
+			{ID: 1, RoomId: 1, UserId: requester, Status: entities.JoinRequestRejected},
+		}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := &fakeJoinRequests{requests: tt.existing}
+			members := testsupport.NewRoomMembers(1, map[uuid.UUID]entities.RoomRole{owner: entities.RoomRoleMember, admin: entities.RoomRoleAdmin, member: entities.RoomRoleMember})
+			notified := &notifications{}
+			s := NewJoinRequestService(requests, members, testsupport.NewChatrooms(tt.room), testsupport.NewRestrictions(1, entities.RestrictionBan, banned), notified)
+
+			request, err := s.RequestToJoin(1, tt.userId, tt.message)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("RequestToJoin = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if len(notified.events) != 0 {
+					t.Fatalf("rejected request notified %v", notified.events)
+				}
+				return
+			}
+			if request.Status != entities.JoinRequestPending || request.Message != strings.TrimSpace(tt.message) {
+				t.Fatalf("request = %+v", request)
+			}
+			if len(notified.events[owner]) != 1 || len(notified.events[admin]) != 1 || len(notified.events[member]) != 0 {
+				t.Fatalf("notified %v, want the owner and the admin only", notified.events)
+			}
+		})
+	}
+}
+
+func TestRequestToJoinLosesRace(t *testing.T) {
+	owner, requester := uuid.New(), uuid.New()
+	room := &entities.Chatroom{ID: 1, IsGroup: true, Owner: owner, JoinPolicy: entities.JoinPolicyApproval}
+	requests := &fakeJoinRequests{saveErr: mongo.WriteException{WriteErrors: mongo.WriteErrors{{Code: 11000}}}}
+	notified := &notifications{}
+	s := NewJoinRequestService(requests, &testsupport.Members{}, testsupport.NewChatrooms(room), &testsupport.Restrictions{}, notified)
+
+	if _, err := s.RequestToJoin(1, requester, ""); !errors.Is(err, apperror.ErrAlreadyExists) {
+		t.Fatalf("RequestToJoin = %v, want %v", err, apperror.ErrAlreadyExists)
+	}
+	if len(notified.events) != 0 {
+		t.Fatalf("lost request notified %v", notified.events)
+	}
+}
+
+func TestDecideJoinRequest(t *testing.T) {
+	owner, member, requester := uuid.New(), uuid.New(), uuid.New()
+	decide := map[string]func(s JoinRequestUseCase, userId uuid.UUID) (*entities.JoinRequest, error){
+		"approve": func(s JoinRequestUseCase, userId uuid.UUID) (*entities.JoinRequest, error) {
+			return s.ApproveJoinRequest(1, userId)
+		},
+		"reject": func(s JoinRequestUseCase, userId uuid.UUID) (*entities.JoinRequest, error) {
+			return s.RejectJoinRequest(1, userId)
+		},
+		"cancel": func(s JoinRequestUseCase, userId uuid.UUID) (*entities.JoinRequest, error) {
+			return s.CancelJoinRequest(1, userId)
+		},
+	}
+
+	tests := []struct {
+		name         string
+		action       string
+		userId       uuid.UUID
+		status       entities.JoinRequestStatus
+		decideErr    error
+		wantErr      error
+		wantStatus   entities.JoinRequestStatus
+		wantMember   bool
+		wantNotified bool
+	}{
+		{"owner approves", "approve", owner, entities.JoinRequestPending, nil, nil, entities.JoinRequestApproved, true, true},
+		{"member cannot approve", "approve", member, entities.JoinRequestPending, nil, apperror.ErrForbidden, entities.JoinRequestPending, false, false},
+		{"approving a decided request", "approve", owner, entities.JoinRequestRejected, nil, apperror.ErrNotAvailable, entities.JoinRequestRejected, false, false},
+		{"lost race undoes the join", "approve", owner, entities.JoinRequestPending, mongo.ErrNoDocuments, apperror.ErrNotAvailable, entities.JoinRequestPending, false, false},
+		{"owner rejects", "reject", owner, entities.JoinRequestPending, nil, nil, entities.JoinRequestRejected, false, true},
+		{"requester cancels", "cancel", requester, entities.JoinRequestPending, nil, nil, entities.JoinRequestCancelled, false, false},
+		{"someone else cancels", "cancel", member, entities.JoinRequestPending, nil, apperror.ErrForbidden, entities.JoinRequestPending, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stored := &entities.JoinRequest{ID: 1, RoomId: 1, UserId: requester, Status: tt.status}
+			requests := &fakeJoinRequests{requests: []*entities.JoinRequest{stored}, decideErr: tt.decideErr}
+			members := testsupport.NewRoomMembers(1, map[uuid.UUID]entities.RoomRole{owner: entities.RoomRoleMember, member: entities.RoomRoleMember})
+			notified := &notifications{}
+			room := &entities.Chatroom{ID: 1, IsGroup: true, Owner: owner, JoinPolicy: entities.JoinPolicyApproval}
+			s := NewJoinRequestService(requests, members, testsupport.NewChatrooms(room), &testsupport.Restrictions{}, notified)
+
+			_, err := decide[tt.action](s, tt.userId)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("%s = %v, want %v", tt.action, err, tt.wantErr)
+			}
+			if stored.Status != tt.wantStatus {
+				t.Fatalf("status = %s, want %s", stored.Status, tt.wantStatus)
+			}
+			if joined := members.Find(1, requester) != nil; joined != tt.wantMember {
+				t.Fatalf("requester joined = %v, want %v", joined, tt.wantMember)
+			}
+			if got := len(notified.events[requester]) == 1; got != tt.wantNotified {
+				t.Fatalf("requester notified = %v, want %v", got, tt.wantNotified)
+			}
+		})
+	}
+}
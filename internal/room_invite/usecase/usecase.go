@@ -1,28 +1,119 @@
 package usecase
 
 import (
+	"errors"
+	"log"
+	"time"
+
+	chatroomRepo "github.com/MingPV/ChatService/internal/chatroom/repository"
 	"github.com/MingPV/ChatService/internal/entities"
+	friendRepo "github.com/MingPV/ChatService/internal/friend/repository"
 	roominviteRepo "github.com/MingPV/ChatService/internal/room_invite/repository"
 	roommemberRepo "github.com/MingPV/ChatService/internal/room_member/repository"
+	"github.com/MingPV/ChatService/pkg/apperror"
+	"github.com/MingPV/ChatService/pkg/config"
 	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/mongo"
 )
 
+// InvitePolicy holds the invite lifetime rules enforced by RoomInviteService
+type InvitePolicy struct {
+	TTL          time.Duration // 0 keeps invites until answered
+	DenyCooldown time.Duration // wait before the same user can be re-invited after denying
+}
+
+func NewInvitePolicy(cfg *config.Config) InvitePolicy {
+	return InvitePolicy{
+		TTL:          time.Duration(cfg.InviteTTL) * time.Second,
+		DenyCooldown: time.Duration(cfg.InviteDenyCooldown) * time.Second,
+	}
+}
+
 // RoomInviteService implements RoomInviteUseCase
 type RoomInviteService struct {
 	roominviteRepo roominviteRepo.RoomInviteRepository
 	roommemberRepo roommemberRepo.RoomMemberRepository
+	chatroomRepo   chatroomRepo.ChatroomRepository
+	blockRepo      friendRepo.BlockRepository
+	restrictionRepo roommemberRepo.RestrictionRepository
+	policy         InvitePolicy
 }
 
 // Init RoomInviteService
-func NewRoomInviteService(roominviteRepo roominviteRepo.RoomInviteRepository, roommemberRepo roommemberRepo.RoomMemberRepository) RoomInviteUseCase {
-	return &RoomInviteService{roominviteRepo: roominviteRepo, roommemberRepo: roommemberRepo}
+func NewRoomInviteService(roominviteRepo roominviteRepo.RoomInviteRepository, roommemberRepo roommemberRepo.RoomMemberRepository, chatroomRepo chatroomRepo.ChatroomRepository, blockRepo friendRepo.BlockRepository, restrictionRepo roommemberRepo.RestrictionRepository, policy InvitePolicy) RoomInviteUseCase {
+	return &RoomInviteService{roominviteRepo: roominviteRepo, roommemberRepo: roommemberRepo, chatroomRepo: chatroomRepo, blockRepo: blockRepo, restrictionRepo: restrictionRepo, policy: policy}
 }
 
 // 1. Create a new invite
 func (s *RoomInviteService) CreateRoomInvite(invite *entities.RoomInvite) error {
+	if invite.Sender == invite.InviteTo {
+		return apperror.ErrInvalidData
+	}
+	if _, err := s.roommemberRepo.FindAllByRoomIDAndUserID(invite.RoomId, invite.Sender); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return apperror.ErrForbidden
+		}
+		return err
+	}
+	if blocked, err := s.blockRepo.ExistsBetween(invite.Sender, invite.InviteTo); err != nil {
+		return err
+	} else if blocked {
+		return apperror.ErrForbidden
+	}
+	if err := checkNotBanned(s.restrictionRepo, invite.RoomId, invite.InviteTo); err != nil {
+		return err
+	}
+	if _, err := s.roommemberRepo.FindAllByRoomIDAndUserID(invite.RoomId, invite.InviteTo); err == nil {
+		return apperror.ErrAlreadyExists
+	} else if !errors.Is(err, mongo.ErrNoDocuments) {
+		return err
+	}
+
+	now := time.Now().UTC()
+	pending, err := s.roominviteRepo.FindPendingByRoomIDAndInviteTo(invite.RoomId, invite.InviteTo)
+	switch {
+	case err == nil && !pending.IsExpired(now):
+		return apperror.ErrAlreadyExists
+	case err == nil:
+		// an expired invite the background expirer has not reached yet
+		if err := s.roominviteRepo.Transition(pending.ID, entities.InviteStatusExpired, now); err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+			return err
+		}
+	case !errors.Is(err, mongo.ErrNoDocuments):
+		return err
+	}
+
+	denied, err := s.roominviteRepo.FindLatestDeniedByRoomIDAndInviteTo(invite.RoomId, invite.InviteTo)
+	if err == nil {
+		deniedAt := denied.UpdatedAt
+		if denied.RespondedAt != nil {
+			deniedAt = *denied.RespondedAt
+		}
+		if now.Before(deniedAt.Add(s.policy.DenyCooldown)) {
+			return apperror.ErrLimitExceeded
+		}
+	} else if !errors.Is(err, mongo.ErrNoDocuments) {
+		return err
+	}
+
+	invite.Status = entities.InviteStatusPending
+	invite.ExpiresAt = nil
+	if s.policy.TTL > 0 {
+		expiresAt := now.Add(s.policy.TTL)
+		invite.ExpiresAt = &expiresAt
+	}
+	invite.RespondedAt = nil
+	invite.CreatedAt = now
+	invite.UpdatedAt = now
 	if err := s.roominviteRepo.Save(invite); err != nil {
+		// a concurrent invite to the same user got in first
+		if mongo.IsDuplicateKeyError(err) {
+			return apperror.ErrAlreadyExists
+		}
 		return err
 	}
+	invite.IsAccepted = false
+	invite.IsDenied = false
 	return nil
 }
 
@@ -32,7 +123,7 @@ func (s *RoomInviteService) FindByID(id int) (*entities.RoomInvite, error) {
 	if err != nil {
 		return nil, err
 	}
-	return invite, nil
+	return present(invite, time.Now()), nil
 }
 
 // 3. Get all invites by sender
@@ -41,7 +132,7 @@ func (s *RoomInviteService) FindAllBySender(sender uuid.UUID) ([]*entities.RoomI
 	if err != nil {
 		return nil, err
 	}
-	return invites, nil
+	return presentAll(invites), nil
 }
 
 // 4. Get all invites by invite_to (receiver)
@@ -50,7 +141,7 @@ func (s *RoomInviteService) FindAllByInviteTo(inviteTo uuid.UUID) ([]*entities.R
 	if err != nil {
 		return nil, err
 	}
-	return invites, nil
+	return presentAll(invites), nil
 }
 
 func (s *RoomInviteService) FindAllByRoomId(roomId int) ([]*entities.RoomInvite, error) {
@@ -58,11 +149,24 @@ func (s *RoomInviteService) FindAllByRoomId(roomId int) ([]*entities.RoomInvite,
 	if err != nil {
 		return nil, err
 	}
-	return invites, nil
+	return presentAll(invites), nil
 }
 
-// 5. Update invite (accept/deny etc.)
+// 5. Update a pending invite; its status only changes through accept, deny and revoke.
+// Room, sender and invitee were checked when the invite was sent, so they cannot be changed.
 func (s *RoomInviteService) PatchInvite(id int, invite *entities.RoomInvite) error {
+	current, err := s.roominviteRepo.FindByID(id)
+	if err != nil {
+		return err
+	}
+	if current.Status != entities.InviteStatusPending || current.IsExpired(time.Now()) {
+		return apperror.ErrNotAvailable
+	}
+	if (invite.RoomId != 0 && invite.RoomId != current.RoomId) ||
+		(invite.Sender != uuid.Nil && invite.Sender != current.Sender) ||
+		(invite.InviteTo != uuid.Nil && invite.InviteTo != current.InviteTo) {
+		return apperror.ErrInvalidData
+	}
 	if err := s.roominviteRepo.Patch(id, invite); err != nil {
 		return err
 	}
@@ -77,18 +181,136 @@ func (s *RoomInviteService) DeleteInvite(id int) error {
 	return nil
 }
 
-func (s *RoomInviteService) AcceptedInvite(id int) error {
+// AcceptedInvite adds the invitee to the room; only the invitee may accept
+func (s *RoomInviteService) AcceptedInvite(id int, userId uuid.UUID) error {
+	invite, err := s.pendingFor(id, inviteeOnly(userId))
+	if err != nil {
+		return err
+	}
+	// the invite may predate a ban
+	if err := checkNotBanned(s.restrictionRepo, invite.RoomId, invite.InviteTo); err != nil {
+		return err
+	}
+
+	// join first so a failed join leaves the invite answerable
+	added := false
+	if _, err := s.roommemberRepo.FindAllByRoomIDAndUserID(invite.RoomId, invite.InviteTo); errors.Is(err, mongo.ErrNoDocuments) {
+		if err := s.roommemberRepo.Save(invite.RoomId, []uuid.UUID{invite.InviteTo}); err != nil {
+			return err
+		}
+		added = true
+	} else if err != nil {
+		return err
+	}
+
+	if err := s.transition(invite, entities.InviteStatusAccepted); err != nil {
+		if added {
+			if rerr := s.roommemberRepo.DeleteByRoomIDAndUserID(invite.RoomId, invite.InviteTo); rerr != nil {
+				log.Printf("failed to undo membership for invite %d: %v", invite.ID, rerr)
+			}
+		}
+		return err
+	}
+	return nil
+}
+
+// DenyInvite declines a pending invite; only the invitee may deny
+func (s *RoomInviteService) DenyInvite(id int, userId uuid.UUID) error {
+	invite, err := s.pendingFor(id, inviteeOnly(userId))
+	if err != nil {
+		return err
+	}
+	return s.transition(invite, entities.InviteStatusDenied)
+}
+
+// RevokeInvite withdraws a pending invite; allowed for its sender and room moderators
+func (s *RoomInviteService) RevokeInvite(id int, userId uuid.UUID) error {
+	invite, err := s.pendingFor(id, func(inv *entities.RoomInvite) error {
+		if inv.Sender == userId {
+			return nil
+		}
+		room, err := s.chatroomRepo.FindByID(int(inv.RoomId))
+		if err != nil {
+			return err
+		}
+		return roommemberRepo.CheckCanModerate(s.roommemberRepo, room, userId)
+	})
+	if err != nil {
+		return err
+	}
+	return s.transition(invite, entities.InviteStatusRevoked)
+}
+
+// pendingFor loads a pending invite that allowed permits; expired invites are
+// moved to expired on the way and reported as not available
+func (s *RoomInviteService) pendingFor(id int, allowed func(*entities.RoomInvite) error) (*entities.RoomInvite, error) {
 	invite, err := s.roominviteRepo.FindByID(id)
 	if err != nil {
-		return err;
+		return nil, err
+	}
+	if err := allowed(invite); err != nil {
+		return nil, err
+	}
+	if invite.IsExpired(time.Now()) {
+		if err := s.transition(invite, entities.InviteStatusExpired); err != nil {
+			return nil, err
+		}
+		return nil, apperror.ErrNotAvailable
+	}
+	if invite.Status != entities.InviteStatusPending {
+		return nil, apperror.ErrNotAvailable
 	}
+	return invite, nil
+}
 
-	if err := s.roommemberRepo.Save(invite.RoomId, []uuid.UUID{invite.InviteTo}); err != nil {
-        return err
-    }
+// transition applies a state change, reporting a lost race as not available
+func (s *RoomInviteService) transition(invite *entities.RoomInvite, to entities.InviteStatus) error {
+	if !invite.Status.CanTransitionTo(to) {
+		return apperror.ErrNotAvailable
+	}
+	if err := s.roominviteRepo.Transition(invite.ID, to, time.Now().UTC()); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return apperror.ErrNotAvailable
+		}
+		return err
+	}
+	return nil
+}
 
-	if err := s.roominviteRepo.Delete(id); err != nil {
+// inviteeOnly permits only the user the invite was sent to
+func inviteeOnly(userId uuid.UUID) func(*entities.RoomInvite) error {
+	return func(inv *entities.RoomInvite) error {
+		if inv.InviteTo != userId {
+			return apperror.ErrForbidden
+		}
+		return nil
+	}
+}
+
+// checkNotBanned returns ErrForbidden while userId is banned from roomId
+func checkNotBanned(restrictionRepo roommemberRepo.RestrictionRepository, roomId uint, userId uuid.UUID) error {
+	banned, err := restrictionRepo.ExistsActive(roomId, userId, entities.RestrictionBan)
+	if err != nil {
 		return err
 	}
+	if banned {
+		return apperror.ErrForbidden
+	}
 	return nil
 }
+
+// present reports pending invites past their expiry as expired, even before the expirer runs
+func present(invite *entities.RoomInvite, now time.Time) *entities.RoomInvite {
+	if invite.IsExpired(now) {
+		invite.Status = entities.InviteStatusExpired
+	}
+	return invite
+}
+
+func presentAll(invites []*entities.RoomInvite) []*entities.RoomInvite {
+	now := time.Now()
+	for _, inv := range invites {
+		present(inv, now)
+	}
+	return invites
+}
@@ -0,0 +1,271 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/MingPV/ChatService/internal/entities"
+	roominviteRepo "github.com/MingPV/ChatService/internal/room_invite/repository"
+	"github.com/MingPV/ChatService/internal/testsupport"
+	"github.com/MingPV/ChatService/pkg/apperror"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type fakeInvites struct {
+	roominviteRepo.RoomInviteRepository
+	invites       []*entities.RoomInvite
+	transitionErr error
+	saveErr       error
+	patched       int
+}
+
+func (f *fakeInvites) Save(invite *entities.RoomInvite) error {
+	if f.saveErr != nil {
+		return f.saveErr
+	}
+	invite.ID = uint(len(f.invites) + 1)
+	f.invites = append(f.invites, invite)
+	return nil
+}
+
+func (f *fakeInvites) FindByID(id int) (*entities.RoomInvite, error) {
+	for _, inv := range f.invites {
+		if inv.ID == uint(id) {
+			copied := *inv
+			return &copied, nil
+		}
+	}
+	return &entities.RoomInvite{}, mongo.ErrNoDocuments
+}
+
+func (f *fakeInvites) FindPendingByRoomIDAndInviteTo(roomId uint, inviteTo uuid.UUID) (*entities.RoomInvite, error) {
+	for _, inv := range f.invites {
+		if inv.RoomId == roomId && inv.InviteTo == inviteTo && inv.Status == entities.InviteStatusPending {
+			return inv, nil
+		}
+	}
+	return &entities.RoomInvite{}, mongo.ErrNoDocuments
+}
+
+func (f *fakeInvites) FindLatestDeniedByRoomIDAndInviteTo(roomId uint, inviteTo uuid.UUID) (*entities.RoomInvite, error) {
+	for i := len(f.invites) - 1; i >= 0; i-- {
+		inv := f.invites[i]
+		if inv.RoomId == roomId && inv.InviteTo == inviteTo && inv.Status == entities.InviteStatusDenied {
+			return inv, nil
+		}
+	}
+	return &entities.RoomInvite{}, mongo.ErrNoDocuments
+}
+
+func (f *fakeInvites) Patch(id int, invite *entities.RoomInvite) error {
+	f.patched++
+	return nil
+}
+
+func (f *fakeInvites) Transition(id uint, to entities.InviteStatus, at time.Time) error {
+	if f.transitionErr != nil {
+		return f.transitionErr
+	}
+	for _, inv := range f.invites {
+		if inv.ID == id && inv.Status == entities.InviteStatusPending {
+			inv.Status = to
+			inv.RespondedAt = &at
+			return nil
+		}
+	}
+	return mongo.ErrNoDocuments
+}
+
+func TestInviteStatusTransitions(t *testing.T) {
+	statuses := []entities.InviteStatus{
+		entities.InviteStatusPending,
+		entities.InviteStatusAccepted,
+		entities.InviteStatusDenied,
+		entities.InviteStatusExpired,
+		entities.InviteStatusRevoked,
+	}
+	for _, from := range statuses {
+		for _, to := range statuses {
+			want := from == entities.InviteStatusPending && to != entities.InviteStatusPending
+			if got := from.CanTransitionTo(to); got != want {
+				t.Errorf("%s -> %s = %v, want %v", from, to, got, want)
+			}
+		}
+	}
+}
+
+func TestCreateRoomInvite(t *testing.T) {
+	sender, member, invitee := uuid.New(), uuid.New(), uuid.New()
+	blocked, banned := uuid.New(), uuid.New()
+	now := time.Now().UTC()
+	policy := InvitePolicy{TTL: time.Hour, DenyCooldown: 24 * time.Hour}
+
+	tests := []struct {
+		name     string
+		from, to uuid.UUID
+		existing []*entities.RoomInvite
+		wantErr  error
+	}{
+		{"new invite", sender, invitee, nil, nil},
+		{"self invite", sender, sender, nil, apperror.ErrInvalidData},
+		{"sender not in the room", uuid.New(), invitee, nil, apperror.ErrForbidden},
+		{"blocked pair", sender, blocked, nil, apperror.ErrForbidden},
+		{"banned invitee", sender, banned, nil, apperror.ErrForbidden},
+		{"invitee already a member", sender, member, nil, apperror.ErrAlreadyExists},
+		{"pending invite exists", sender, invitee, []*entities.RoomInvite{
+			{ID: 1, RoomId: 1, InviteTo: invitee, Status: entities.InviteStatusPending, ExpiresAt: timePtr(now.Add(time.Hour))},
+		}, apperror.ErrAlreadyExists},
+		{"stale pending invite is expired first", sender, invitee, []*entities.RoomInvite{
+			{ID: 1, RoomId: 1, InviteTo: invitee, Status: entities.InviteStatusPending, ExpiresAt: timePtr(now.Add(-time.Minute))},
+		}, nil},
+		{"denied within the cooldown", sender, invitee, []*entities.RoomInvite{
+			{ID: 1, RoomId: 1, InviteTo: invitee, Status: entities.InviteStatusDenied, RespondedAt: timePtr(now.Add(-time.Hour))},
+		}, apperror.ErrLimitExceeded},
+		{"denied before the cooldown", sender, invitee, []*entities.RoomInvite{
+			{ID: 1, RoomId: 1, InviteTo: invitee, Status: entities.InviteStatusDenied, RespondedAt: timePtr(now.Add(-48 * time.Hour))},
+		}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			invites := &fakeInvites{invites: tt.existing}
+			members := testsupport.NewRoomMembers(1, map[uuid.UUID]entities.RoomRole{sender: entities.RoomRoleMember, member: entities.RoomRoleMember})
+			blocks := testsupport.NewBlocks()
+			blocks.Save(&entities.Block{UserID: blocked, BlockedID: sender})
+			s := NewRoomInviteService(invites, members, nil, blocks, testsupport.NewRestrictions(1, entities.RestrictionBan, banned), policy)
+
+			invite := &entities.RoomInvite{RoomId: 1, Sender: tt.from, InviteTo: tt.to}
+			err := s.CreateRoomInvite(invite)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CreateRoomInvite = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if invite.Status != entities.InviteStatusPending || invite.ExpiresAt == nil || !invite.ExpiresAt.After(now) {
+				t.Fatalf("invite = %+v, want pending with an expiry", invite)
+			}
+			for _, old := range tt.existing {
+				if old.Status == entities.InviteStatusPending {
+					t.Fatalf("stale invite %d left pending", old.ID)
+				}
+			}
+		})
+	}
+}
+
+func TestCreateRoomInviteLosesRace(t *testing.T) {
+	sender, invitee := uuid.New(), uuid.New()
+	invites := &fakeInvites{saveErr: mongo.WriteException{WriteErrors: mongo.WriteErrors{{Code: 11000}}}}
+	members := testsupport.NewRoomMembers(1, map[uuid.UUID]entities.RoomRole{sender: entities.RoomRoleMember})
+	s := NewRoomInviteService(invites, members, nil, testsupport.NewBlocks(), &testsupport.Restrictions{}, InvitePolicy{TTL: time.Hour})
+
+	err := s.CreateRoomInvite(&entities.RoomInvite{RoomId: 1, Sender: sender, InviteTo: invitee})
+	if !errors.Is(err, apperror.ErrAlreadyExists) {
+		t.Fatalf("CreateRoomInvite = %v, want %v", err, apperror.ErrAlreadyExists)
+	}
+}
+
+func TestRespondToInvite(t *testing.T) {
+	owner, sender, invitee, other := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	respond := map[string]func(s RoomInviteUseCase, userId uuid.UUID) error{
+		"accept": func(s RoomInviteUseCase, userId uuid.UUID) error { return s.AcceptedInvite(1, userId) },
+		"deny":   func(s RoomInviteUseCase, userId uuid.UUID) error { return s.DenyInvite(1, userId) },
+		"revoke": func(s RoomInviteUseCase, userId uuid.UUID) error { return s.RevokeInvite(1, userId) },
+	}
+
+	tests := []struct {
+		name          string
+		action        string
+		userId        uuid.UUID
+		status        entities.InviteStatus
+		expired       bool
+		transitionErr error
+		wantErr       error
+		wantStatus    entities.InviteStatus
+		wantMember    bool
+	}{
+		{"invitee accepts", "accept", invitee, entities.InviteStatusPending, false, nil, nil, entities.InviteStatusAccepted, true},
+		{"someone else accepts", "accept", other, entities.InviteStatusPending, false, nil, apperror.ErrForbidden, entities.InviteStatusPending, false},
+		{"accepting twice", "accept", invitee, entities.InviteStatusAccepted, false, nil, apperror.ErrNotAvailable, entities.InviteStatusAccepted, false},
+		{"accepting after expiry", "accept", invitee, entities.InviteStatusPending, true, nil, apperror.ErrNotAvailable, entities.InviteStatusExpired, false},
+		{"lost race undoes the join", "accept", invitee, entities.InviteStatusPending, false, mongo.ErrNoDocuments, apperror.ErrNotAvailable, entities.InviteStatusPending, false},
+		{"invitee denies", "deny", invitee, entities.InviteStatusPending, false, nil, nil, entities.InviteStatusDenied, false},
+		{"denying a revoked invite", "deny", invitee, entities.InviteStatusRevoked, false, nil, apperror.ErrNotAvailable, entities.InviteStatusRevoked, false},
+		{"sender revokes", "revoke", sender, entities.InviteStatusPending, false, nil, nil, entities.InviteStatusRevoked, false},
+		{"room owner revokes", "revoke", owner, entities.InviteStatusPending, false, nil, nil, entities.InviteStatusRevoked, false},
+		{"invitee cannot revoke", "revoke", invitee, entities.InviteStatusPending, false, nil, apperror.ErrForbidden, entities.InviteStatusPending, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			invite := &entities.RoomInvite{ID: 1, RoomId: 1, Sender: sender, InviteTo: invitee, Status: tt.status}
+			if tt.expired {
+				invite.ExpiresAt = timePtr(time.Now().Add(-time.Minute))
+			}
+			invites := &fakeInvites{invites: []*entities.RoomInvite{invite}}
+			members := testsupport.NewRoomMembers(1, map[uuid.UUID]entities.RoomRole{owner: entities.RoomRoleMember, sender: entities.RoomRoleMember})
+			s := NewRoomInviteService(invites, members, testsupport.NewChatrooms(&entities.Chatroom{ID: 1, IsGroup: true, Owner: owner}), testsupport.NewBlocks(), &testsupport.Restrictions{}, InvitePolicy{})
+			invites.transitionErr = tt.transitionErr
+
+			err := respond[tt.action](s, tt.userId)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("%s = %v, want %v", tt.action, err, tt.wantErr)
+			}
+			if invite.Status != tt.wantStatus {
+				t.Fatalf("status = %s, want %s", invite.Status, tt.wantStatus)
+			}
+			if joined := members.Find(1, invitee) != nil; joined != tt.wantMember {
+				t.Fatalf("invitee joined = %v, want %v", joined, tt.wantMember)
+			}
+		})
+	}
+}
+
+func TestRevokeInviteReportsLookupErrors(t *testing.T) {
+	sender, invitee, moderator := uuid.New(), uuid.New(), uuid.New()
+	invite := &entities.RoomInvite{ID: 1, RoomId: 1, Sender: sender, InviteTo: invitee, Status: entities.InviteStatusPending}
+	lookupErr := errors.New("lookup failed")
+	members := &testsupport.Members{}
+	members.FindErr = lookupErr
+	s := NewRoomInviteService(&fakeInvites{invites: []*entities.RoomInvite{invite}}, members, testsupport.NewChatrooms(&entities.Chatroom{ID: 1, IsGroup: true}), testsupport.NewBlocks(), &testsupport.Restrictions{}, InvitePolicy{})
+
+	if err := s.RevokeInvite(1, moderator); !errors.Is(err, lookupErr) {
+		t.Fatalf("RevokeInvite = %v, want the lookup error", err)
+	}
+	if invite.Status != entities.InviteStatusPending {
+		t.Fatalf("status = %s, want pending", invite.Status)
+	}
+}
+
+func TestPatchInvite(t *testing.T) {
+	sender, invitee, other := uuid.New(), uuid.New(), uuid.New()
+
+	tests := []struct {
+		name    string
+		status  entities.InviteStatus
+		patch   entities.RoomInvite
+		wantErr error
+	}{
+		{"same target", entities.InviteStatusPending, entities.RoomInvite{RoomId: 1, Sender: sender, InviteTo: invitee}, nil},
+		{"fields left out", entities.InviteStatusPending, entities.RoomInvite{}, nil},
+		{"another room", entities.InviteStatusPending, entities.RoomInvite{RoomId: 2, Sender: sender, InviteTo: invitee}, apperror.ErrInvalidData},
+		{"another sender", entities.InviteStatusPending, entities.RoomInvite{RoomId: 1, Sender: other, InviteTo: invitee}, apperror.ErrInvalidData},
+		{"another invitee", entities.InviteStatusPending, entities.RoomInvite{RoomId: 1, Sender: sender, InviteTo: other}, apperror.ErrInvalidData},
+		{"decided invite", entities.InviteStatusAccepted, entities.RoomInvite{RoomId: 1, Sender: sender, InviteTo: invitee}, apperror.ErrNotAvailable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			invites := &fakeInvites{invites: []*entities.RoomInvite{{ID: 1, RoomId: 1, Sender: sender, InviteTo: invitee, Status: tt.status}}}
+			s := NewRoomInviteService(invites, &testsupport.Members{}, testsupport.NewChatrooms(), testsupport.NewBlocks(), &testsupport.Restrictions{}, InvitePolicy{})
+
+			err := s.PatchInvite(1, &tt.patch)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("PatchInvite() error = %v, want %v", err, tt.wantErr)
+			}
+			if wantPatched := tt.wantErr == nil; (invites.patched == 1) != wantPatched {
+				t.Fatalf("patched = %d, want patched %v", invites.patched, wantPatched)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"time"
 
 	"github.com/MingPV/ChatService/internal/entities"
 	"github.com/MingPV/ChatService/internal/room_member/usecase"
@@ -55,7 +56,7 @@ func (h *GrpcRoomMemberHandler) FindAllByRoomID(ctx context.Context, req *roomme
 }
 
 func (h *GrpcRoomMemberHandler) FindAllByUserID(ctx context.Context, req *roommemberpb.FindAllByUserIDRequest) (*roommemberpb.FindAllByUserIDResponse, error) {
-	chatrooms, err := h.roomMemberUseCase.FindAllByUserID(toUUID(req.UserId))
+	chatrooms, err := h.roomMemberUseCase.FindAllByUserID(toUUID(req.UserId), req.IncludeArchived)
 	if err != nil {
 		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
 	}
@@ -68,6 +69,34 @@ func (h *GrpcRoomMemberHandler) FindAllByUserID(ctx context.Context, req *roomme
 	return &roommemberpb.FindAllByUserIDResponse{Chatrooms: protoChatrooms}, nil
 }
 
+func (h *GrpcRoomMemberHandler) FindInbox(ctx context.Context, req *roommemberpb.FindInboxRequest) (*roommemberpb.FindInboxResponse, error) {
+	userUUID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+	entries, total, err := h.roomMemberUseCase.FindInbox(userUUID, req.IncludeArchived, int(req.Page), int(req.PageSize))
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+
+	protoEntries := make([]*roommemberpb.InboxEntry, 0, len(entries))
+	for _, e := range entries {
+		pe := &roommemberpb.InboxEntry{Member: toProtoRoomMember(&e.RoomMember), Unread: e.Unread}
+		if m := e.LastMessage; m != nil {
+			pe.LastMessage = &roommemberpb.LastMessage{
+				Id:             int32(m.ID),
+				Sender:         m.Sender.String(),
+				Text:           m.Message,
+				HasAttachments: len(m.Attachments) > 0,
+				CreatedAt:      timestamppb.New(m.CreatedAt),
+				System:         m.System != nil,
+			}
+		}
+		protoEntries = append(protoEntries, pe)
+	}
+	return &roommemberpb.FindInboxResponse{Entries: protoEntries, Total: total}, nil
+}
+
 func (h *GrpcRoomMemberHandler) FindByRoomIDAndUserID(ctx context.Context, req *roommemberpb.FindByRoomIDAndUserIDRequest) (*roommemberpb.FindByRoomIDAndUserIDResponse, error) {
 	member, err := h.roomMemberUseCase.FindByRoomIDAndUserID(uint(req.RoomId), toUUID(req.UserId))
 	if err != nil {
@@ -76,6 +105,136 @@ func (h *GrpcRoomMemberHandler) FindByRoomIDAndUserID(ctx context.Context, req *
 	return &roommemberpb.FindByRoomIDAndUserIDResponse{Member: toProtoRoomMember(member)}, nil
 }
 
+func (h *GrpcRoomMemberHandler) UpdateMemberRole(ctx context.Context, req *roommemberpb.UpdateMemberRoleRequest) (*roommemberpb.UpdateMemberRoleResponse, error) {
+	member, err := h.roomMemberUseCase.UpdateMemberRole(uint(req.RoomId), toUUID(req.ActorId), toUUID(req.UserId), entities.RoomRole(req.Role))
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+	return &roommemberpb.UpdateMemberRoleResponse{Member: toProtoRoomMember(member)}, nil
+}
+
+func (h *GrpcRoomMemberHandler) MuteRoom(ctx context.Context, req *roommemberpb.MuteRoomRequest) (*roommemberpb.RoomPreferencesResponse, error) {
+	userUUID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+	member, err := h.roomMemberUseCase.MuteRoom(uint(req.RoomId), userUUID, time.Duration(req.DurationSeconds)*time.Second)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+	return &roommemberpb.RoomPreferencesResponse{Member: toProtoRoomMember(member)}, nil
+}
+
+func (h *GrpcRoomMemberHandler) UnmuteRoom(ctx context.Context, req *roommemberpb.UnmuteRoomRequest) (*roommemberpb.RoomPreferencesResponse, error) {
+	userUUID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+	member, err := h.roomMemberUseCase.UnmuteRoom(uint(req.RoomId), userUUID)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+	return &roommemberpb.RoomPreferencesResponse{Member: toProtoRoomMember(member)}, nil
+}
+
+func (h *GrpcRoomMemberHandler) ArchiveRoom(ctx context.Context, req *roommemberpb.ArchiveRoomRequest) (*roommemberpb.RoomPreferencesResponse, error) {
+	userUUID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+	member, err := h.roomMemberUseCase.ArchiveRoom(uint(req.RoomId), userUUID, req.Archived)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+	return &roommemberpb.RoomPreferencesResponse{Member: toProtoRoomMember(member)}, nil
+}
+
+func (h *GrpcRoomMemberHandler) PinRoom(ctx context.Context, req *roommemberpb.PinRoomRequest) (*roommemberpb.RoomPreferencesResponse, error) {
+	userUUID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+	member, err := h.roomMemberUseCase.PinRoom(uint(req.RoomId), userUUID, req.Pinned)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+	return &roommemberpb.RoomPreferencesResponse{Member: toProtoRoomMember(member)}, nil
+}
+
+func (h *GrpcRoomMemberHandler) KickMember(ctx context.Context, req *roommemberpb.KickMemberRequest) (*roommemberpb.KickMemberResponse, error) {
+	actorUUID, userUUID, err := parseActorAndUser(req.ActorId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.roomMemberUseCase.KickMember(uint(req.RoomId), actorUUID, userUUID); err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+	return &roommemberpb.KickMemberResponse{Message: "removed user from chatroom"}, nil
+}
+
+func (h *GrpcRoomMemberHandler) BanMember(ctx context.Context, req *roommemberpb.RestrictMemberRequest) (*roommemberpb.RestrictMemberResponse, error) {
+	actorUUID, userUUID, err := parseActorAndUser(req.ActorId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	restriction, err := h.roomMemberUseCase.BanMember(uint(req.RoomId), actorUUID, userUUID, time.Duration(req.DurationSeconds)*time.Second, req.Reason)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+	return &roommemberpb.RestrictMemberResponse{Restriction: toProtoRestriction(restriction)}, nil
+}
+
+func (h *GrpcRoomMemberHandler) UnbanMember(ctx context.Context, req *roommemberpb.LiftRestrictionRequest) (*roommemberpb.LiftRestrictionResponse, error) {
+	actorUUID, userUUID, err := parseActorAndUser(req.ActorId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.roomMemberUseCase.UnbanMember(uint(req.RoomId), actorUUID, userUUID); err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+	return &roommemberpb.LiftRestrictionResponse{Message: "ban lifted"}, nil
+}
+
+func (h *GrpcRoomMemberHandler) MuteMember(ctx context.Context, req *roommemberpb.RestrictMemberRequest) (*roommemberpb.RestrictMemberResponse, error) {
+	actorUUID, userUUID, err := parseActorAndUser(req.ActorId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	restriction, err := h.roomMemberUseCase.MuteMember(uint(req.RoomId), actorUUID, userUUID, time.Duration(req.DurationSeconds)*time.Second, req.Reason)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+	return &roommemberpb.RestrictMemberResponse{Restriction: toProtoRestriction(restriction)}, nil
+}
+
+func (h *GrpcRoomMemberHandler) UnmuteMember(ctx context.Context, req *roommemberpb.LiftRestrictionRequest) (*roommemberpb.LiftRestrictionResponse, error) {
+	actorUUID, userUUID, err := parseActorAndUser(req.ActorId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.roomMemberUseCase.UnmuteMember(uint(req.RoomId), actorUUID, userUUID); err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+	return &roommemberpb.LiftRestrictionResponse{Message: "mute lifted"}, nil
+}
+
+func (h *GrpcRoomMemberHandler) FindRestrictions(ctx context.Context, req *roommemberpb.FindRestrictionsRequest) (*roommemberpb.FindRestrictionsResponse, error) {
+	actorUUID, err := uuid.Parse(req.ActorId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+	restrictions, err := h.roomMemberUseCase.FindRestrictions(uint(req.RoomId), actorUUID)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+
+	protoRestrictions := make([]*roommemberpb.RoomRestriction, 0, len(restrictions))
+	for _, r := range restrictions {
+		protoRestrictions = append(protoRestrictions, toProtoRestriction(r))
+	}
+	return &roommemberpb.FindRestrictionsResponse{Restrictions: protoRestrictions}, nil
+}
+
 func (h *GrpcRoomMemberHandler) DeleteByRoomIDAndUserID(ctx context.Context, req *roommemberpb.DeleteByRoomIDAndUserIDRequest) (*roommemberpb.DeleteByRoomIDAndUserIDResponse, error) {
 	if err := h.roomMemberUseCase.DeleteByRoomIDAndUserID(uint(req.RoomId), toUUID(req.UserId)); err != nil {
 		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
@@ -99,10 +258,11 @@ func (h *GrpcRoomMemberHandler) DeleteRoomMember(ctx context.Context, req *roomm
 // ---- Helper functions ----
 
 func toProtoRoomMember(m *entities.RoomMember) *roommemberpb.RoomMember {
-	return &roommemberpb.RoomMember{
+	pm := &roommemberpb.RoomMember{
 		Id:        int32(m.ID),
 		RoomId:    int32(m.RoomId),
 		UserId:    m.UserId.String(),
+		Role:      string(m.Role),
 		Chatroom: 	&roommemberpb.Chatroom{
 			Id: int32(m.Chatroom.ID),
 			RoomName: m.Chatroom.RoomName,
@@ -110,14 +270,56 @@ func toProtoRoomMember(m *entities.RoomMember) *roommemberpb.RoomMember {
 			Owner: m.Chatroom.Owner.String(),
 			CreatedAt: timestamppb.New(m.Chatroom.CreatedAt),
 			UpdatedAt: timestamppb.New(m.Chatroom.UpdatedAt),
+			Type: string(m.Chatroom.Type()),
+			DisplayName: m.Chatroom.DisplayName,
+			DisplayAvatarUrl: m.Chatroom.DisplayAvatarURL,
     	},
+		Muted:     m.Muted,
+		Archived:  m.Archived,
 		CreatedAt: timestamppb.New(m.CreatedAt),
 		UpdatedAt: timestamppb.New(m.UpdatedAt),
-		
 	}
+	if m.MutedUntil != nil {
+		pm.MutedUntil = timestamppb.New(*m.MutedUntil)
+	}
+	if m.PinnedAt != nil {
+		pm.PinnedAt = timestamppb.New(*m.PinnedAt)
+	}
+	if !m.LastActivity.IsZero() {
+		pm.LastActivity = timestamppb.New(m.LastActivity)
+	}
+	return pm
 }
 
 
+func toProtoRestriction(r *entities.RoomRestriction) *roommemberpb.RoomRestriction {
+	pr := &roommemberpb.RoomRestriction{
+		Id:        int32(r.ID),
+		RoomId:    int32(r.RoomId),
+		UserId:    r.UserId.String(),
+		Kind:      string(r.Kind),
+		Reason:    r.Reason,
+		CreatedBy: r.CreatedBy.String(),
+		CreatedAt: timestamppb.New(r.CreatedAt),
+	}
+	if r.ExpiresAt != nil {
+		pr.ExpiresAt = timestamppb.New(*r.ExpiresAt)
+	}
+	return pr
+}
+
+// parseActorAndUser parses the moderator and target ids of a moderation request
+func parseActorAndUser(actorId string, userId string) (uuid.UUID, uuid.UUID, error) {
+	actorUUID, err := uuid.Parse(actorId)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+	userUUID, err := uuid.Parse(userId)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+	return actorUUID, userUUID, nil
+}
 
 func toUUIDs(ids []string) []uuid.UUID {
 	var uuids []uuid.UUID
@@ -0,0 +1,190 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/MingPV/ChatService/internal/entities"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type MongoRestrictionRepository struct {
+	db   *mongo.Database
+	coll *mongo.Collection
+}
+
+func NewMongoRestrictionRepository(db *mongo.Database) RestrictionRepository {
+	return &MongoRestrictionRepository{
+		db:   db,
+		coll: db.Collection("room_restrictions"),
+	}
+}
+
+type restrictionDoc struct {
+	ID        int                      `bson:"_id,omitempty"`
+	RoomId    uint                     `bson:"room_id"`
+	UserId    uuid.UUID                `bson:"user_id"`
+	Kind      entities.RestrictionKind `bson:"kind"`
+	Reason    string                   `bson:"reason,omitempty"`
+	CreatedBy uuid.UUID                `bson:"created_by"`
+	ExpiresAt *time.Time               `bson:"expires_at,omitempty"`
+	CreatedAt time.Time                `bson:"created_at"`
+}
+
+// EnsureIndexes keeps one restriction per kind for a user in a room and
+// lets MongoDB drop timed restrictions once they run out
+func (r *MongoRestrictionRepository) EnsureIndexes() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := r.coll.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "room_id", Value: 1}, {Key: "user_id", Value: 1}, {Key: "kind", Value: 1}},
+			Options: options.Index().SetName("room_user_kind").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "expires_at", Value: 1}},
+			Options: options.Index().SetName("expires_at_ttl").SetExpireAfterSeconds(0),
+		},
+	})
+	return err
+}
+
+// active leaves out restrictions whose time is up but that the TTL monitor has not removed yet
+func active(filter bson.M) bson.M {
+	filter["expires_at"] = bson.M{"$not": bson.M{"$lte": time.Now()}}
+	return filter
+}
+
+func (r *MongoRestrictionRepository) Save(restriction *entities.RoomRestriction) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	nextID, err := r.getNextSequence(ctx, "room_restrictions")
+	if err != nil {
+		return err
+	}
+
+	set := bson.M{
+		"reason":     restriction.Reason,
+		"created_by": restriction.CreatedBy,
+		"created_at": restriction.CreatedAt,
+	}
+	update := bson.M{
+		"$set":         set,
+		"$setOnInsert": bson.M{"_id": nextID},
+	}
+	if restriction.ExpiresAt != nil {
+		set["expires_at"] = restriction.ExpiresAt
+	} else {
+		update["$unset"] = bson.M{"expires_at": ""}
+	}
+
+	var d restrictionDoc
+	err = r.coll.FindOneAndUpdate(ctx,
+		bson.M{"room_id": restriction.RoomId, "user_id": restriction.UserId, "kind": restriction.Kind},
+		update,
+		options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After),
+	).Decode(&d)
+	if err != nil {
+		return err
+	}
+
+	restriction.ID = uint(d.ID)
+	return nil
+}
+
+func (r *MongoRestrictionRepository) ExistsActive(roomId uint, userId uuid.UUID, kinds ...entities.RestrictionKind) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	filter := active(bson.M{"room_id": roomId, "user_id": userId, "kind": bson.M{"$in": kinds}})
+	n, err := r.coll.CountDocuments(ctx, filter, options.Count().SetLimit(1))
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+func (r *MongoRestrictionRepository) FindAllActiveByRoomID(roomId uint) ([]*entities.RoomRestriction, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	cur, err := r.coll.Find(ctx,
+		active(bson.M{"room_id": roomId}),
+		options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var results []*entities.RoomRestriction
+	for cur.Next(ctx) {
+		var d restrictionDoc
+		if err := cur.Decode(&d); err != nil {
+			return nil, err
+		}
+		results = append(results, r.toEntity(d))
+	}
+	return results, cur.Err()
+}
+
+func (r *MongoRestrictionRepository) Delete(roomId uint, userId uuid.UUID, kind entities.RestrictionKind) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	res, err := r.coll.DeleteOne(ctx, bson.M{"room_id": roomId, "user_id": userId, "kind": kind})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+func (r *MongoRestrictionRepository) toEntity(d restrictionDoc) *entities.RoomRestriction {
+	return &entities.RoomRestriction{
+		ID:        uint(d.ID),
+		RoomId:    d.RoomId,
+		UserId:    d.UserId,
+		Kind:      d.Kind,
+		Reason:    d.Reason,
+		CreatedBy: d.CreatedBy,
+		ExpiresAt: d.ExpiresAt,
+		CreatedAt: d.CreatedAt,
+	}
+}
+
+func (r *MongoRestrictionRepository) getNextSequence(ctx context.Context, name string) (int, error) {
+	counters := r.db.Collection("counters")
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+
+	var out counterDoc
+	err := counters.FindOneAndUpdate(
+		ctx,
+		bson.M{"_id": name},
+		bson.M{"$inc": bson.M{"seq": 1}},
+		opts,
+	).Decode(&out)
+
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		_, ierr := counters.InsertOne(ctx, counterDoc{ID: name, Seq: 1})
+		if ierr != nil {
+			return 0, ierr
+		}
+		return 1, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if out.Seq == 0 {
+		return 1, nil
+	}
+	return out.Seq, nil
+}
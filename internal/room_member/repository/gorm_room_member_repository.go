@@ -13,14 +13,16 @@ import (
 )
 
 type MongoRoomMemberRepository struct {
-	db   *mongo.Database
-	coll *mongo.Collection
+	db    *mongo.Database
+	coll  *mongo.Collection
+	rooms *mongo.Collection
 }
 
 func NewMongoRoomMemberRepository(db *mongo.Database) RoomMemberRepository {
 	return &MongoRoomMemberRepository{
-		db:   db,
-		coll: db.Collection("room_members"),
+		db:    db,
+		coll:  db.Collection("room_members"),
+		rooms: db.Collection("chatrooms"),
 	}
 }
 
@@ -28,6 +30,11 @@ type roomMemberDoc struct {
 	ID        int       `bson:"_id,omitempty"`
 	RoomId    uint      `bson:"room_id"`
 	UserId    uuid.UUID `bson:"user_id"`
+	Role      entities.RoomRole `bson:"role,omitempty"`
+	Muted      bool       `bson:"muted,omitempty"`
+	MutedUntil *time.Time `bson:"muted_until,omitempty"`
+	Archived   bool       `bson:"archived,omitempty"`
+	PinnedAt   *time.Time `bson:"pinned_at,omitempty"`
 	CreatedAt time.Time `bson:"created_at"`
 	UpdatedAt time.Time `bson:"updated_at"`
 }
@@ -54,15 +61,58 @@ func (r *MongoRoomMemberRepository) Save(roomId uint, userIDs []uuid.UUID) error
 			ID:        nextID,
 			RoomId:    roomId,
 			UserId:    userID,
+			Role:      entities.RoomRoleMember,
 			CreatedAt: now,
 			UpdatedAt: now,
 		})
 	}
 
-	_, err := r.coll.InsertMany(ctx, docs)
+	if _, err := r.coll.InsertMany(ctx, docs); err != nil {
+		// some members may have been added before the failure, so count them
+		if cerr := r.recountMembers(ctx, roomId); cerr != nil {
+			return errors.Join(err, cerr)
+		}
+		return err
+	}
+	return r.addToMemberCount(ctx, roomId, int64(len(docs)))
+}
+
+// addToMemberCount keeps the chatroom's denormalised member_count in step with joins and leaves
+func (r *MongoRoomMemberRepository) addToMemberCount(ctx context.Context, roomId uint, delta int64) error {
+	if delta == 0 {
+		return nil
+	}
+	_, err := r.rooms.UpdateByID(ctx, roomId, bson.M{"$inc": bson.M{"member_count": delta}})
+	return err
+}
+
+// recountMembers sets member_count from the members actually stored
+func (r *MongoRoomMemberRepository) recountMembers(ctx context.Context, roomId uint) error {
+	count, err := r.coll.CountDocuments(ctx, bson.M{"room_id": roomId})
+	if err != nil {
+		return err
+	}
+	_, err = r.rooms.UpdateByID(ctx, roomId, bson.M{"$set": bson.M{"member_count": count}})
 	return err
 }
 
+// FindByID returns a single membership by its id
+func (r *MongoRoomMemberRepository) FindByID(id int) (*entities.RoomMember, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var d roomMemberDoc
+	err := r.coll.FindOne(ctx, bson.M{"_id": id}).Decode(&d)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return &entities.RoomMember{}, err
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return r.toEntity(d), nil
+}
+
 // FindAllByRoomID returns all members of a room
 func (r *MongoRoomMemberRepository) FindAllByRoomID(roomId uint) ([]*entities.RoomMember, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
@@ -83,13 +133,7 @@ func (r *MongoRoomMemberRepository) FindAllByRoomID(roomId uint) ([]*entities.Ro
 		if err := cur.Decode(&d); err != nil {
 			return nil, err
 		}
-		results = append(results, &entities.RoomMember{
-			ID:        uint(d.ID),
-			RoomId:    d.RoomId,
-			UserId:    d.UserId,
-			CreatedAt: d.CreatedAt,
-			UpdatedAt: d.UpdatedAt,
-		})
+		results = append(results, r.toEntity(d))
 	}
 	if err := cur.Err(); err != nil {
 		return nil, err
@@ -160,13 +204,202 @@ func (r *MongoRoomMemberRepository) FindAllByRoomIDAndUserID(roomId uint, userId
 		return nil, err
 	}
 
-	return &entities.RoomMember{
-		ID:        uint(d.ID),
-		RoomId:    d.RoomId,
-		UserId:    d.UserId,
-		CreatedAt: d.CreatedAt,
-		UpdatedAt: d.UpdatedAt,
-	}, nil
+	return r.toEntity(d), nil
+}
+
+// UpdateRole changes the role of a member in a room
+func (r *MongoRoomMemberRepository) UpdateRole(roomId uint, userId uuid.UUID, role entities.RoomRole) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	res, err := r.coll.UpdateOne(ctx,
+		bson.M{"room_id": roomId, "user_id": userId},
+		bson.M{"$set": bson.M{"role": role, "updated_at": time.Now()}},
+	)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// roomListStages matches userId's memberships with their rooms, pinned rooms first and
+// then by the room's last activity, leaving archived rooms out unless includeArchived is set
+func roomListStages(userId uuid.UUID, includeArchived bool) mongo.Pipeline {
+	match := bson.M{"user_id": userId}
+	if !includeArchived {
+		match["archived"] = bson.M{"$ne": true}
+	}
+	return mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$lookup", Value: bson.M{
+			"from":         "chatrooms",
+			"localField":   "room_id",
+			"foreignField": "_id",
+			"as":           "room",
+		}}},
+		{{Key: "$unwind", Value: bson.M{"path": "$room", "preserveNullAndEmptyArrays": true}}},
+		// rooms without messages since last_activity_at was introduced count as active when created
+		{{Key: "$addFields", Value: bson.M{
+			"last_activity": bson.M{"$ifNull": bson.A{
+				"$room.last_activity_at",
+				bson.M{"$ifNull": bson.A{"$room.created_at", "$created_at"}},
+			}},
+		}}},
+		// unpinned rooms have no pinned_at and sort after pinned ones
+		{{Key: "$sort", Value: bson.D{{Key: "pinned_at", Value: -1}, {Key: "last_activity", Value: -1}, {Key: "_id", Value: 1}}}},
+	}
+}
+
+func (r *MongoRoomMemberRepository) FindRoomList(userId uuid.UUID, includeArchived bool) ([]*entities.RoomMember, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	cur, err := r.coll.Aggregate(ctx, roomListStages(userId, includeArchived))
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var results []*entities.RoomMember
+	for cur.Next(ctx) {
+		var d entities.RoomMember
+		if err := cur.Decode(&d); err != nil {
+			return nil, err
+		}
+		results = append(results, &d)
+	}
+	return results, cur.Err()
+}
+
+// FindInbox pages through the room list, attaching each room's newest message
+// and the number of messages from others since the user's last visit
+func (r *MongoRoomMemberRepository) FindInbox(userId uuid.UUID, includeArchived bool, offset, limit int) ([]*entities.InboxEntry, int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	now := time.Now()
+	page := bson.A{
+		bson.M{"$skip": offset},
+		bson.M{"$limit": limit},
+		bson.M{"$lookup": bson.M{
+			"from": "messages",
+			"let":  bson.M{"mid": "$room.last_message_id"},
+			"pipeline": bson.A{
+				bson.M{"$match": bson.M{
+					"$expr":      bson.M{"$eq": bson.A{"$_id", "$$mid"}},
+					"expires_at": bson.M{"$not": bson.M{"$lte": now}},
+				}},
+			},
+			"as": "last_message",
+		}},
+		bson.M{"$unwind": bson.M{"path": "$last_message", "preserveNullAndEmptyArrays": true}},
+		bson.M{"$lookup": bson.M{
+			"from": "lastvisits",
+			"let":  bson.M{"rid": "$room_id", "uid": "$user_id"},
+			"pipeline": bson.A{
+				bson.M{"$match": bson.M{"$expr": bson.M{"$and": bson.A{
+					bson.M{"$eq": bson.A{"$room_id", "$$rid"}},
+					bson.M{"$eq": bson.A{"$user_id", "$$uid"}},
+				}}}},
+				bson.M{"$project": bson.M{"lastvisit": 1}},
+			},
+			"as": "visit",
+		}},
+		bson.M{"$addFields": bson.M{
+			"since": bson.M{"$ifNull": bson.A{bson.M{"$arrayElemAt": bson.A{"$visit.lastvisit", 0}}, time.Time{}}},
+		}},
+		bson.M{"$lookup": bson.M{
+			"from": "messages",
+			"let":  bson.M{"rid": "$room_id", "uid": "$user_id", "since": "$since"},
+			"pipeline": bson.A{
+				bson.M{"$match": bson.M{
+					"$expr": bson.M{"$and": bson.A{
+						bson.M{"$eq": bson.A{"$room_id", "$$rid"}},
+						bson.M{"$ne": bson.A{"$sender", "$$uid"}},
+						bson.M{"$gt": bson.A{"$created_at", "$$since"}},
+					}},
+					"expires_at": bson.M{"$not": bson.M{"$lte": now}},
+				}},
+				bson.M{"$count": "n"},
+			},
+			"as": "unread",
+		}},
+		bson.M{"$addFields": bson.M{
+			"unread": bson.M{"$ifNull": bson.A{bson.M{"$arrayElemAt": bson.A{"$unread.n", 0}}, 0}},
+		}},
+		bson.M{"$project": bson.M{"visit": 0, "since": 0}},
+	}
+
+	pipeline := append(roomListStages(userId, includeArchived), bson.D{{Key: "$facet", Value: bson.M{
+		"total": bson.A{bson.M{"$count": "n"}},
+		"page":  page,
+	}}})
+
+	cur, err := r.coll.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer cur.Close(ctx)
+
+	var out []struct {
+		Total []struct {
+			N int64 `bson:"n"`
+		} `bson:"total"`
+		Page []*entities.InboxEntry `bson:"page"`
+	}
+	if err := cur.All(ctx, &out); err != nil {
+		return nil, 0, err
+	}
+	if len(out) == 0 || len(out[0].Total) == 0 {
+		return []*entities.InboxEntry{}, 0, nil
+	}
+	return out[0].Page, out[0].Total[0].N, nil
+}
+
+// SetMuted silences the room for the member until until, or indefinitely when until is nil
+func (r *MongoRoomMemberRepository) SetMuted(roomId uint, userId uuid.UUID, muted bool, until *time.Time) error {
+	set := bson.M{"muted": muted}
+	if muted && until != nil {
+		set["muted_until"] = until
+		return r.updatePreferences(roomId, userId, bson.M{"$set": set})
+	}
+	return r.updatePreferences(roomId, userId, bson.M{"$set": set, "$unset": bson.M{"muted_until": ""}})
+}
+
+func (r *MongoRoomMemberRepository) SetArchived(roomId uint, userId uuid.UUID, archived bool) error {
+	return r.updatePreferences(roomId, userId, bson.M{"$set": bson.M{"archived": archived}})
+}
+
+// SetPinned pins the room at pinnedAt, or unpins it when pinnedAt is nil
+func (r *MongoRoomMemberRepository) SetPinned(roomId uint, userId uuid.UUID, pinnedAt *time.Time) error {
+	if pinnedAt == nil {
+		return r.updatePreferences(roomId, userId, bson.M{"$unset": bson.M{"pinned_at": ""}})
+	}
+	return r.updatePreferences(roomId, userId, bson.M{"$set": bson.M{"pinned_at": pinnedAt}})
+}
+
+func (r *MongoRoomMemberRepository) updatePreferences(roomId uint, userId uuid.UUID, update bson.M) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	set, _ := update["$set"].(bson.M)
+	if set == nil {
+		set = bson.M{}
+		update["$set"] = set
+	}
+	set["updated_at"] = time.Now()
+
+	res, err := r.coll.UpdateOne(ctx, bson.M{"room_id": roomId, "user_id": userId}, update)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
 }
 
 // DeleteByRoomIDAndUserID deletes a specific room member
@@ -174,11 +407,14 @@ func (r *MongoRoomMemberRepository) DeleteByRoomIDAndUserID(roomId uint, userId
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	_, err := r.coll.DeleteOne(ctx, bson.M{
+	res, err := r.coll.DeleteOne(ctx, bson.M{
 		"room_id": roomId,
 		"user_id": userId,
 	})
-	return err
+	if err != nil {
+		return err
+	}
+	return r.addToMemberCount(ctx, roomId, -res.DeletedCount)
 }
 
 // DeleteAllByRoomID deletes all members of a room
@@ -186,10 +422,28 @@ func (r *MongoRoomMemberRepository) DeleteAllByRoomID(roomId int) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	_, err := r.coll.DeleteMany(ctx, bson.M{"room_id": roomId})
+	if _, err := r.coll.DeleteMany(ctx, bson.M{"room_id": roomId}); err != nil {
+		return err
+	}
+	_, err := r.rooms.UpdateByID(ctx, roomId, bson.M{"$set": bson.M{"member_count": 0}})
 	return err
 }
 
+func (r *MongoRoomMemberRepository) toEntity(d roomMemberDoc) *entities.RoomMember {
+	return &entities.RoomMember{
+		ID:         uint(d.ID),
+		RoomId:     d.RoomId,
+		UserId:     d.UserId,
+		Role:       d.Role,
+		Muted:      d.Muted,
+		MutedUntil: d.MutedUntil,
+		Archived:   d.Archived,
+		PinnedAt:   d.PinnedAt,
+		CreatedAt:  d.CreatedAt,
+		UpdatedAt:  d.UpdatedAt,
+	}
+}
+
 // getNextSequence generates auto-increment ID
 func (r *MongoRoomMemberRepository) getNextSequence(ctx context.Context, name string) (int, error) {
 	counters := r.db.Collection("counters")
@@ -223,6 +477,13 @@ func (r *MongoRoomMemberRepository) Delete(id int) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	_, err := r.coll.DeleteOne(ctx, bson.M{"_id": id})
-	return err
+	var d roomMemberDoc
+	err := r.coll.FindOneAndDelete(ctx, bson.M{"_id": id}).Decode(&d)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return r.addToMemberCount(ctx, d.RoomId, -1)
 }
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"github.com/MingPV/ChatService/internal/entities"
+	"github.com/google/uuid"
+)
+
+type RestrictionRepository interface {
+	// Save stores the restriction, replacing any earlier one of the same kind for the user in the room.
+	Save(restriction *entities.RoomRestriction) error
+	// ExistsActive reports whether userId is under an unexpired restriction of any of kinds in roomId.
+	ExistsActive(roomId uint, userId uuid.UUID, kinds ...entities.RestrictionKind) (bool, error)
+	FindAllActiveByRoomID(roomId uint) ([]*entities.RoomRestriction, error)
+	Delete(roomId uint, userId uuid.UUID, kind entities.RestrictionKind) error
+	EnsureIndexes() error
+}
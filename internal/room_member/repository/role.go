@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"errors"
+
+	"github.com/MingPV/ChatService/internal/entities"
+	"github.com/MingPV/ChatService/pkg/apperror"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// RoleIn returns userId's effective role in room, or apperror.ErrForbidden when
+// they are not a member. The room owner is an owner even without a membership.
+func RoleIn(members RoomMemberRepository, room *entities.Chatroom, userId uuid.UUID) (entities.RoomRole, error) {
+	if room.Owner == userId {
+		return entities.RoomRoleOwner, nil
+	}
+	member, err := members.FindAllByRoomIDAndUserID(room.ID, userId)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return "", apperror.ErrForbidden
+	}
+	if err != nil {
+		return "", err
+	}
+	return member.EffectiveRole(room), nil
+}
+
+// CheckCanModerate requires userId to be an owner or admin of room
+func CheckCanModerate(members RoomMemberRepository, room *entities.Chatroom, userId uuid.UUID) error {
+	role, err := RoleIn(members, room, userId)
+	if err != nil {
+		return err
+	}
+	if !role.CanModerate() {
+		return apperror.ErrForbidden
+	}
+	return nil
+}
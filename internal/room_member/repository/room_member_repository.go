@@ -1,15 +1,26 @@
 package repository
 
 import (
+	"time"
+
 	"github.com/MingPV/ChatService/internal/entities"
 	"github.com/google/uuid"
 )
 
 type RoomMemberRepository interface {
 	Save(roomId uint, userIDs []uuid.UUID) error
+	FindByID(id int) (*entities.RoomMember, error)
 	FindAllByRoomID(roomID uint) ([]*entities.RoomMember, error)
 	FindAllByUserID(userId uuid.UUID) ([]*entities.RoomMember, error)
 	FindAllByRoomIDAndUserID(roomId uint, userId uuid.UUID) (*entities.RoomMember, error)
+	// FindRoomList lists userId's rooms for display: pinned first, then by latest activity.
+	FindRoomList(userId uuid.UUID, includeArchived bool) ([]*entities.RoomMember, error)
+	// FindInbox is a page of the room list with each room's last message and unread count.
+	FindInbox(userId uuid.UUID, includeArchived bool, offset, limit int) ([]*entities.InboxEntry, int64, error)
+	UpdateRole(roomId uint, userId uuid.UUID, role entities.RoomRole) error
+	SetMuted(roomId uint, userId uuid.UUID, muted bool, until *time.Time) error
+	SetArchived(roomId uint, userId uuid.UUID, archived bool) error
+	SetPinned(roomId uint, userId uuid.UUID, pinnedAt *time.Time) error
 	DeleteByRoomIDAndUserID(roomID uint, userID uuid.UUID) error
 	DeleteAllByRoomID(roomID int) error
 	Delete(id int) error
@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+	"log"
+
+	"github.com/MingPV/ChatService/internal/entities"
+	"github.com/MingPV/ChatService/pkg/profile"
+	"github.com/google/uuid"
+)
+
+// resolveDisplay fills the display name and avatar of each member's room as seen by viewer.
+// Group rooms show their own name and avatar, 1:1 rooms show the other participant.
+func (s *RoomMemberService) resolveDisplay(viewer uuid.UUID, members []*entities.RoomMember) error {
+	peers := make(map[*entities.RoomMember]uuid.UUID)
+	ids := make([]uuid.UUID, 0, len(members))
+	for _, m := range members {
+		room := &m.Chatroom
+		if room.IsGroup {
+			room.DisplayName = room.RoomName
+			room.DisplayAvatarURL = room.AvatarURL
+			continue
+		}
+		peer, ok := room.DirectPeer(viewer)
+		if !ok {
+			var err error
+			if peer, ok, err = s.legacyPeer(m.RoomId, viewer); err != nil {
+				return err
+			}
+		}
+		if !ok {
+			room.DisplayName = room.RoomName
+			continue
+		}
+		peers[m] = peer
+		ids = append(ids, peer)
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	profiles, err := s.profiles.FindProfiles(context.Background(), ids)
+	if err != nil {
+		// names are cosmetic; fall back rather than fail the whole list
+		log.Printf("failed to load profiles for room list: %v", err)
+		profiles = nil
+	}
+	for m, peer := range peers {
+		p, ok := profiles[peer]
+		if !ok {
+			p = profile.Fallback(peer)
+		}
+		m.Chatroom.DisplayName = p.DisplayName
+		m.Chatroom.DisplayAvatarURL = p.AvatarURL
+	}
+	return nil
+}
+
+// displayNames resolves a name for each of ids, falling back to a placeholder for unknown users
+func (s *RoomMemberService) displayNames(ids ...uuid.UUID) map[uuid.UUID]string {
+	profiles, err := s.profiles.FindProfiles(context.Background(), ids)
+	if err != nil {
+		log.Printf("failed to load profiles: %v", err)
+	}
+	names := make(map[uuid.UUID]string, len(ids))
+	for _, id := range ids {
+		p, ok := profiles[id]
+		if !ok {
+			p = profile.Fallback(id)
+		}
+		names[id] = p.DisplayName
+	}
+	return names
+}
+
+// legacyPeer finds the other participant of a 1:1 room stored without a DirectKey
+func (s *RoomMemberService) legacyPeer(roomId uint, viewer uuid.UUID) (uuid.UUID, bool, error) {
+	members, err := s.repo.FindAllByRoomID(roomId)
+	if err != nil {
+		return uuid.Nil, false, err
+	}
+	for _, m := range members {
+		if m.UserId != viewer {
+			return m.UserId, true, nil
+		}
+	}
+	return uuid.Nil, false, nil
+}
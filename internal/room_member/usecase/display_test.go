@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/MingPV/ChatService/internal/entities"
+	"github.com/MingPV/ChatService/pkg/profile"
+	"github.com/google/uuid"
+)
+
+type failingProfiles struct{}
+
+func (failingProfiles) FindProfiles(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]profile.Profile, error) {
+	return nil, errors.New("profile service unavailable")
+}
+
+func TestResolveDisplay(t *testing.T) {
+	viewer, peer, unknown := uuid.New(), uuid.New(), uuid.New()
+	known := profile.NewStaticProvider([]profile.Profile{{UserID: peer, DisplayName: "Bob", AvatarURL: "https://cdn.example/bob.png"}})
+
+	tests := []struct {
+		name       string
+		room       entities.Chatroom
+		legacy     []uuid.UUID // members of a room stored without a DirectKey
+		profiles   profile.Provider
+		wantName   string
+		wantAvatar string
+	}{
+		{
+			name:       "group shows its own name and avatar",
+			room:       entities.Chatroom{ID: 1, IsGroup: true, RoomName: "Team", AvatarURL: "https://cdn.example/team.png"},
+			profiles:   known,
+			wantName:   "Team",
+			wantAvatar: "https://cdn.example/team.png",
+		},
+		{
+			name:       "direct room shows the other participant",
+			room:       entities.Chatroom{ID: 1, DirectKey: entities.DirectRoomKey(viewer, peer)},
+			profiles:   known,
+			wantName:   "Bob",
+			wantAvatar: "https://cdn.example/bob.png",
+		},
+		{
+			name:     "unknown participant gets a placeholder",
+			room:     entities.Chatroom{ID: 1, DirectKey: entities.DirectRoomKey(viewer, unknown)},
+			profiles: known,
+			wantName: profile.Fallback(unknown).DisplayName,
+		},
+		{
+			name:       "legacy direct room finds the peer through its members",
+			room:       entities.Chatroom{ID: 1, RoomName: "room_legacy"},
+			legacy:     []uuid.UUID{viewer, peer},
+			profiles:   known,
+			wantName:   "Bob",
+			wantAvatar: "https://cdn.example/bob.png",
+		},
+		{
+			name:     "legacy room without a peer keeps its stored name",
+			room:     entities.Chatroom{ID: 1, RoomName: "room_legacy"},
+			legacy:   []uuid.UUID{viewer},
+			profiles: known,
+			wantName: "room_legacy",
+		},
+		{
+			name:     "provider failure falls back instead of failing",
+			room:     entities.Chatroom{ID: 1, DirectKey: entities.DirectRoomKey(viewer, peer)},
+			profiles: failingProfiles{},
+			wantName: profile.Fallback(peer).DisplayName,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			roles := make(map[uuid.UUID]entities.RoomRole)
+			for _, id := range tt.legacy {
+				roles[id] = entities.RoomRoleMember
+			}
+			s := &RoomMemberService{repo: newFakeMembers(roles), profiles: tt.profiles}
+
+			member := &entities.RoomMember{RoomId: 1, UserId: viewer, Chatroom: tt.room}
+			if err := s.resolveDisplay(viewer, []*entities.RoomMember{member}); err != nil {
+				t.Fatalf("resolveDisplay() error = %v", err)
+			}
+			if member.Chatroom.DisplayName != tt.wantName || member.Chatroom.DisplayAvatarURL != tt.wantAvatar {
+				t.Fatalf("display = %q %q, want %q %q", member.Chatroom.DisplayName, member.Chatroom.DisplayAvatarURL, tt.wantName, tt.wantAvatar)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	bookmarkRepo "github.com/MingPV/ChatService/internal/bookmark/repository"
+	"github.com/MingPV/ChatService/internal/entities"
+	messageUseCase "github.com/MingPV/ChatService/internal/message/usecase"
+	"github.com/MingPV/ChatService/internal/testsupport"
+	"github.com/google/uuid"
+)
+
+// fakeMembers adds a recorded FindInbox to the shared fake
+type fakeMembers struct {
+	*testsupport.Members
+	// inbox is served by FindInbox, which records the page it was asked for
+	inbox       []*entities.InboxEntry
+	inboxOffset int
+	inboxLimit  int
+}
+
+func newFakeMembers(roles map[uuid.UUID]entities.RoomRole) *fakeMembers {
+	return &fakeMembers{Members: testsupport.NewRoomMembers(1, roles)}
+}
+
+func (f *fakeMembers) FindInbox(userId uuid.UUID, includeArchived bool, offset, limit int) ([]*entities.InboxEntry, int64, error) {
+	f.inboxOffset, f.inboxLimit = offset, limit
+	var page []*entities.InboxEntry
+	for i, e := range f.inbox {
+		if i >= offset && len(page) < limit && (includeArchived || !e.Archived) {
+			page = append(page, e)
+		}
+	}
+	return page, int64(len(f.inbox)), nil
+}
+
+type fakeBookmarks struct {
+	bookmarkRepo.BookmarkRepository
+}
+
+func (f *fakeBookmarks) DeleteAllByRoomIDAndUserID(roomId uint, userId uuid.UUID) error {
+	return nil
+}
+
+// announcements records posted system messages
+type announcements struct {
+	messageUseCase.MessageUseCase
+	events []*entities.SystemEvent
+}
+
+func (a *announcements) PostSystemMessage(roomId uint, text string, event *entities.SystemEvent) (*entities.Message, error) {
+	a.events = append(a.events, event)
+	return &entities.Message{RoomId: roomId, Message: text, System: event}, nil
+}
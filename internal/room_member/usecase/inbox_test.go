@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/MingPV/ChatService/internal/entities"
+	"github.com/google/uuid"
+)
+
+func TestFindInbox(t *testing.T) {
+	user := uuid.New()
+
+	tests := []struct {
+		name       string
+		page       int
+		pageSize   int
+		wantOffset int
+		wantLimit  int
+		wantRooms  []string
+	}{
+		{name: "first page", page: 1, pageSize: 2, wantOffset: 0, wantLimit: 2, wantRooms: []string{"Team", "Family"}},
+		{name: "second page", page: 2, pageSize: 2, wantOffset: 2, wantLimit: 2, wantRooms: []string{"Book club"}},
+		{name: "defaults", wantOffset: 0, wantLimit: 20, wantRooms: []string{"Team", "Family", "Book club"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			members := newFakeMembers(nil)
+			for i, name := range []string{"Team", "Family", "Book club"} {
+				members.inbox = append(members.inbox, &entities.InboxEntry{
+					RoomMember:  entities.RoomMember{RoomId: uint(i + 1), UserId: user, Chatroom: entities.Chatroom{ID: uint(i + 1), RoomName: name, IsGroup: true}},
+					LastMessage: &entities.Message{RoomId: uint(i + 1), Message: "hi"},
+					Unread:      int64(i),
+				})
+			}
+			s := &RoomMemberService{repo: members}
+
+			entries, total, err := s.FindInbox(user, false, tt.page, tt.pageSize)
+			if err != nil {
+				t.Fatalf("FindInbox() error = %v", err)
+			}
+			if members.inboxOffset != tt.wantOffset || members.inboxLimit != tt.wantLimit {
+				t.Fatalf("offset, limit = %d, %d, want %d, %d", members.inboxOffset, members.inboxLimit, tt.wantOffset, tt.wantLimit)
+			}
+			if total != 3 {
+				t.Fatalf("total = %d, want 3", total)
+			}
+			if len(entries) != len(tt.wantRooms) {
+				t.Fatalf("entries = %d, want %d", len(entries), len(tt.wantRooms))
+			}
+			for i, e := range entries {
+				if e.Chatroom.DisplayName != tt.wantRooms[i] {
+					t.Fatalf("entry %d display name = %q, want %q", i, e.Chatroom.DisplayName, tt.wantRooms[i])
+				}
+				if e.LastMessage == nil {
+					t.Fatalf("entry %d lost its last message", i)
+				}
+			}
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package usecase
 
 import (
+	"time"
+
 	"github.com/MingPV/ChatService/internal/entities"
 	"github.com/google/uuid"
 )
@@ -8,8 +10,29 @@ import (
 type RoomMemberUseCase interface {
 	CreateRoomMembers(roomId uint, userIDs []uuid.UUID) error
 	FindAllByRoomID(roomId uint) ([]*entities.RoomMember, error)
-	FindAllByUserID(userId uuid.UUID) ([]*entities.RoomMember, error)
+	// FindAllByUserID lists the user's rooms, pinned first and then by latest activity.
+	// Archived rooms are left out unless includeArchived is set.
+	FindAllByUserID(userId uuid.UUID, includeArchived bool) ([]*entities.RoomMember, error)
+	// FindInbox pages through the same room list with each room's last message and unread count.
+	FindInbox(userId uuid.UUID, includeArchived bool, page, pageSize int) ([]*entities.InboxEntry, int64, error)
 	FindByRoomIDAndUserID(roomId uint, userId uuid.UUID) (*entities.RoomMember, error)
+	UpdateMemberRole(roomId uint, actorId uuid.UUID, userId uuid.UUID, role entities.RoomRole) (*entities.RoomMember, error)
+	// MuteRoom silences notifications from the room for duration, or until unmuted when duration is 0.
+	MuteRoom(roomId uint, userId uuid.UUID, duration time.Duration) (*entities.RoomMember, error)
+	UnmuteRoom(roomId uint, userId uuid.UUID) (*entities.RoomMember, error)
+	ArchiveRoom(roomId uint, userId uuid.UUID, archived bool) (*entities.RoomMember, error)
+	PinRoom(roomId uint, userId uuid.UUID, pinned bool) (*entities.RoomMember, error)
+	// KickMember removes userId from the room; unlike a ban, they may rejoin right away.
+	KickMember(roomId uint, actorId uuid.UUID, userId uuid.UUID) error
+	// BanMember removes userId and keeps them from rejoining or being invited for duration,
+	// or until unbanned when duration is 0. The user need not be a member.
+	BanMember(roomId uint, actorId uuid.UUID, userId uuid.UUID, duration time.Duration, reason string) (*entities.RoomRestriction, error)
+	UnbanMember(roomId uint, actorId uuid.UUID, userId uuid.UUID) error
+	// MuteMember stops userId from posting in the room for duration, or until unmuted when duration is 0.
+	MuteMember(roomId uint, actorId uuid.UUID, userId uuid.UUID, duration time.Duration, reason string) (*entities.RoomRestriction, error)
+	UnmuteMember(roomId uint, actorId uuid.UUID, userId uuid.UUID) error
+	// FindRestrictions lists the room's active bans and mutes; moderators only.
+	FindRestrictions(roomId uint, actorId uuid.UUID) ([]*entities.RoomRestriction, error)
 	DeleteByRoomIDAndUserID(roomId uint, userId uuid.UUID) error
 	DeleteAllByRoomID(roomId int) error
 	DeleteRoomMember(id int) error
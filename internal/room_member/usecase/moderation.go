@@ -0,0 +1,189 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/MingPV/ChatService/internal/entities"
+	"github.com/MingPV/ChatService/internal/room_member/repository"
+	"github.com/MingPV/ChatService/pkg/apperror"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const maxRestrictionReason = 500
+
+func (s *RoomMemberService) KickMember(roomId uint, actorId uuid.UUID, userId uuid.UUID) error {
+	if err := s.checkCanModerateMember(roomId, actorId, userId); err != nil {
+		return err
+	}
+	if _, err := s.repo.FindAllByRoomIDAndUserID(roomId, userId); err != nil {
+		return err
+	}
+	if err := s.DeleteByRoomIDAndUserID(roomId, userId); err != nil {
+		return err
+	}
+	s.announce(roomId, &entities.SystemEvent{Type: entities.SystemEventMemberKicked, Actor: actorId, Target: userId})
+	return nil
+}
+
+func (s *RoomMemberService) BanMember(roomId uint, actorId uuid.UUID, userId uuid.UUID, duration time.Duration, reason string) (*entities.RoomRestriction, error) {
+	restriction, err := s.restrict(roomId, actorId, userId, entities.RestrictionBan, duration, reason)
+	if err != nil {
+		return nil, err
+	}
+	// the ban is recorded first so the user cannot slip back in between the two steps
+	if err := s.DeleteByRoomIDAndUserID(roomId, userId); err != nil {
+		return nil, err
+	}
+	s.announce(roomId, &entities.SystemEvent{Type: entities.SystemEventMemberBanned, Actor: actorId, Target: userId, Until: restriction.ExpiresAt})
+	return restriction, nil
+}
+
+func (s *RoomMemberService) UnbanMember(roomId uint, actorId uuid.UUID, userId uuid.UUID) error {
+	if err := s.lift(roomId, actorId, userId, entities.RestrictionBan); err != nil {
+		return err
+	}
+	s.announce(roomId, &entities.SystemEvent{Type: entities.SystemEventMemberUnbanned, Actor: actorId, Target: userId})
+	return nil
+}
+
+func (s *RoomMemberService) MuteMember(roomId uint, actorId uuid.UUID, userId uuid.UUID, duration time.Duration, reason string) (*entities.RoomRestriction, error) {
+	if _, err := s.repo.FindAllByRoomIDAndUserID(roomId, userId); err != nil {
+		return nil, err
+	}
+	restriction, err := s.restrict(roomId, actorId, userId, entities.RestrictionMute, duration, reason)
+	if err != nil {
+		return nil, err
+	}
+	s.announce(roomId, &entities.SystemEvent{Type: entities.SystemEventMemberMuted, Actor: actorId, Target: userId, Until: restriction.ExpiresAt})
+	return restriction, nil
+}
+
+func (s *RoomMemberService) UnmuteMember(roomId uint, actorId uuid.UUID, userId uuid.UUID) error {
+	if err := s.lift(roomId, actorId, userId, entities.RestrictionMute); err != nil {
+		return err
+	}
+	s.announce(roomId, &entities.SystemEvent{Type: entities.SystemEventMemberUnmuted, Actor: actorId, Target: userId})
+	return nil
+}
+
+func (s *RoomMemberService) FindRestrictions(roomId uint, actorId uuid.UUID) ([]*entities.RoomRestriction, error) {
+	room, err := s.chatroomRepo.FindByID(int(roomId))
+	if err != nil {
+		return nil, err
+	}
+	role, err := repository.RoleIn(s.repo, room, actorId)
+	if err != nil {
+		return nil, err
+	}
+	if !role.CanModerate() {
+		return nil, apperror.ErrForbidden
+	}
+	return s.restrictionRepo.FindAllActiveByRoomID(roomId)
+}
+
+// restrict records a ban or mute after checking that actorId may impose it on userId
+func (s *RoomMemberService) restrict(roomId uint, actorId uuid.UUID, userId uuid.UUID, kind entities.RestrictionKind, duration time.Duration, reason string) (*entities.RoomRestriction, error) {
+	if duration < 0 {
+		return nil, apperror.ErrOutOfRange
+	}
+	reason = strings.TrimSpace(reason)
+	if len([]rune(reason)) > maxRestrictionReason {
+		return nil, apperror.ErrOutOfRange
+	}
+	if err := s.checkCanModerateMember(roomId, actorId, userId); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	restriction := &entities.RoomRestriction{
+		RoomId:    roomId,
+		UserId:    userId,
+		Kind:      kind,
+		Reason:    reason,
+		CreatedBy: actorId,
+		CreatedAt: now,
+	}
+	if duration > 0 {
+		expiresAt := now.Add(duration)
+		restriction.ExpiresAt = &expiresAt
+	}
+	if err := s.restrictionRepo.Save(restriction); err != nil {
+		return nil, err
+	}
+	return restriction, nil
+}
+
+func (s *RoomMemberService) lift(roomId uint, actorId uuid.UUID, userId uuid.UUID, kind entities.RestrictionKind) error {
+	if err := s.checkCanModerateMember(roomId, actorId, userId); err != nil {
+		return err
+	}
+	return s.restrictionRepo.Delete(roomId, userId, kind)
+}
+
+// checkCanModerateMember requires actorId to moderate the group room and to outrank userId.
+// A user who is not a member counts as a plain member.
+func (s *RoomMemberService) checkCanModerateMember(roomId uint, actorId uuid.UUID, userId uuid.UUID) error {
+	if actorId == userId {
+		return apperror.ErrInvalidData
+	}
+	room, err := s.chatroomRepo.FindByID(int(roomId))
+	if err != nil {
+		return err
+	}
+	if !room.IsGroup {
+		return apperror.ErrOperationDenied
+	}
+
+	actorRole, err := repository.RoleIn(s.repo, room, actorId)
+	if err != nil {
+		return err
+	}
+	if !actorRole.CanModerate() {
+		return apperror.ErrForbidden
+	}
+	targetRole := entities.RoomRoleMember
+	if target, err := s.repo.FindAllByRoomIDAndUserID(roomId, userId); err == nil {
+		targetRole = target.EffectiveRole(room)
+	} else if !errors.Is(err, mongo.ErrNoDocuments) {
+		return err
+	} else if room.Owner == userId {
+		targetRole = entities.RoomRoleOwner
+	}
+	if !actorRole.Outranks(targetRole) {
+		return apperror.ErrForbidden
+	}
+	return nil
+}
+
+// announce posts a system message about a moderation action. The action has
+// already taken effect, so a failed announcement is only logged.
+func (s *RoomMemberService) announce(roomId uint, event *entities.SystemEvent) {
+	names := s.displayNames(event.Actor, event.Target)
+	actor, target := names[event.Actor], names[event.Target]
+
+	var text string
+	switch event.Type {
+	case entities.SystemEventMemberKicked:
+		text = fmt.Sprintf("%s removed %s", actor, target)
+	case entities.SystemEventMemberBanned:
+		text = fmt.Sprintf("%s banned %s", actor, target)
+	case entities.SystemEventMemberUnbanned:
+		text = fmt.Sprintf("%s lifted the ban on %s", actor, target)
+	case entities.SystemEventMemberMuted:
+		text = fmt.Sprintf("%s muted %s", actor, target)
+	case entities.SystemEventMemberUnmuted:
+		text = fmt.Sprintf("%s unmuted %s", actor, target)
+	}
+	if event.Until != nil {
+		text += " until " + event.Until.Format("2006-01-02 15:04 UTC")
+	}
+
+	if _, err := s.messageUseCase.PostSystemMessage(roomId, text, event); err != nil {
+		log.Printf("failed to announce %s in room %d: %v", event.Type, roomId, err)
+	}
+}
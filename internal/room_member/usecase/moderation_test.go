@@ -0,0 +1,192 @@
+package usecase
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MingPV/ChatService/internal/entities"
+	"github.com/MingPV/ChatService/internal/testsupport"
+	"github.com/MingPV/ChatService/pkg/apperror"
+	"github.com/MingPV/ChatService/pkg/profile"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestRoomRoleOutranks(t *testing.T) {
+	tests := []struct {
+		role  entities.RoomRole
+		other entities.RoomRole
+		want  bool
+	}{
+		{entities.RoomRoleOwner, entities.RoomRoleAdmin, true},
+		{entities.RoomRoleOwner, entities.RoomRoleMember, true},
+		{entities.RoomRoleAdmin, entities.RoomRoleMember, true},
+		{entities.RoomRoleOwner, entities.RoomRoleOwner, false},
+		{entities.RoomRoleAdmin, entities.RoomRoleAdmin, false},
+		{entities.RoomRoleAdmin, entities.RoomRoleOwner, false},
+		{entities.RoomRoleMember, entities.RoomRoleMember, false},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.role)+" over "+string(tt.other), func(t *testing.T) {
+			if got := tt.role.Outranks(tt.other); got != tt.want {
+				t.Fatalf("Outranks() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// newModerationService sets up group room 1 owned by owner with an admin, a second admin and a member
+func newModerationService(owner, admin, otherAdmin, member uuid.UUID, group bool) (*RoomMemberService, *fakeMembers, *testsupport.Restrictions, *announcements) {
+	members := newFakeMembers(map[uuid.UUID]entities.RoomRole{
+		owner:      entities.RoomRoleMember, // the room owner wins over the stored role
+		admin:      entities.RoomRoleAdmin,
+		otherAdmin: entities.RoomRoleAdmin,
+		member:     "", // stored before roles existed
+	})
+	restrictions := &testsupport.Restrictions{}
+	events := &announcements{}
+	s := &RoomMemberService{
+		repo:            members,
+		restrictionRepo: restrictions,
+		chatroomRepo:    testsupport.NewChatrooms(&entities.Chatroom{ID: 1, IsGroup: group, Owner: owner}),
+		bookmarkRepo:    &fakeBookmarks{},
+		messageUseCase:  events,
+		profiles:        profile.NewStaticProvider(nil),
+	}
+	return s, members, restrictions, events
+}
+
+func TestCheckCanModerateMember(t *testing.T) {
+	owner, admin, otherAdmin, member, outsider := uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	tests := []struct {
+		name    string
+		group   bool
+		actor   uuid.UUID
+		target  uuid.UUID
+		wantErr error
+	}{
+		{name: "owner moderates an admin", group: true, actor: owner, target: admin},
+		{name: "owner moderates a member", group: true, actor: owner, target: member},
+		{name: "admin moderates a member", group: true, actor: admin, target: member},
+		{name: "admin moderates a non-member", group: true, actor: admin, target: outsider},
+		{name: "admin cannot moderate another admin", group: true, actor: admin, target: otherAdmin, wantErr: apperror.ErrForbidden},
+		{name: "admin cannot moderate the owner", group: true, actor: admin, target: owner, wantErr: apperror.ErrForbidden},
+		{name: "member cannot moderate", group: true, actor: member, target: outsider, wantErr: apperror.ErrForbidden},
+		{name: "non-member cannot moderate", group: true, actor: outsider, target: member, wantErr: apperror.ErrForbidden},
+		{name: "nobody moderates themselves", group: true, actor: owner, target: owner, wantErr: apperror.ErrInvalidData},
+		{name: "direct rooms have no moderators", group: false, actor: owner, target: member, wantErr: apperror.ErrOperationDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _, _, _ := newModerationService(owner, admin, otherAdmin, member, tt.group)
+
+			err := s.checkCanModerateMember(1, tt.actor, tt.target)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("checkCanModerateMember() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCheckCanModerateMemberOwnerWhoLeft(t *testing.T) {
+	owner, admin, otherAdmin, member := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	s, members, _, _ := newModerationService(owner, admin, otherAdmin, member, true)
+	members.DeleteByRoomIDAndUserID(1, owner)
+
+	if err := s.checkCanModerateMember(1, admin, owner); !errors.Is(err, apperror.ErrForbidden) {
+		t.Fatalf("checkCanModerateMember() error = %v, want %v", err, apperror.ErrForbidden)
+	}
+}
+
+func TestKickMember(t *testing.T) {
+	owner, admin, otherAdmin, member, outsider := uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	tests := []struct {
+		name    string
+		actor   uuid.UUID
+		target  uuid.UUID
+		wantErr error
+	}{
+		{name: "admin removes a member", actor: admin, target: member},
+		{name: "admin cannot remove an admin", actor: admin, target: otherAdmin, wantErr: apperror.ErrForbidden},
+		{name: "target must be in the room", actor: owner, target: outsider, wantErr: mongo.ErrNoDocuments},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, members, _, events := newModerationService(owner, admin, otherAdmin, member, true)
+
+			err := s.KickMember(1, tt.actor, tt.target)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("KickMember() error = %v, want %v", err, tt.wantErr)
+			}
+			stillMember := members.Find(1, tt.target) != nil
+			if tt.wantErr != nil {
+				if len(events.events) != 0 {
+					t.Fatalf("announced %d events, want none", len(events.events))
+				}
+				return
+			}
+			if stillMember {
+				t.Fatalf("target is still a member")
+			}
+			if len(events.events) != 1 || events.events[0].Type != entities.SystemEventMemberKicked {
+				t.Fatalf("events = %+v, want one kick", events.events)
+			}
+		})
+	}
+}
+
+func TestBanMember(t *testing.T) {
+	owner, admin, otherAdmin, member := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	tests := []struct {
+		name       string
+		actor      uuid.UUID
+		target     uuid.UUID
+		duration   time.Duration
+		reason     string
+		wantErr    error
+		wantExpiry bool
+	}{
+		{name: "permanent ban", actor: admin, target: member, reason: " spam "},
+		{name: "temporary ban", actor: owner, target: admin, duration: time.Hour, wantExpiry: true},
+		{name: "admin cannot ban an admin", actor: admin, target: otherAdmin, wantErr: apperror.ErrForbidden},
+		{name: "negative duration", actor: admin, target: member, duration: -time.Hour, wantErr: apperror.ErrOutOfRange},
+		{name: "reason too long", actor: admin, target: member, reason: strings.Repeat("x", maxRestrictionReason+1), wantErr: apperror.ErrOutOfRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, members, restrictions, events := newModerationService(owner, admin, otherAdmin, member, true)
+
+			restriction, err := s.BanMember(1, tt.actor, tt.target, tt.duration, tt.reason)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("BanMember() error = %v, want %v", err, tt.wantErr)
+			}
+			stillMember := members.Find(1, tt.target) != nil
+			if tt.wantErr != nil {
+				if !stillMember || len(restrictions.Active) != 0 {
+					t.Fatalf("failed ban changed the room: member %v, restrictions %d", stillMember, len(restrictions.Active))
+				}
+				return
+			}
+			if stillMember {
+				t.Fatalf("banned user is still a member")
+			}
+			if restriction.Kind != entities.RestrictionBan || restriction.CreatedBy != tt.actor || restriction.Reason != strings.TrimSpace(tt.reason) {
+				t.Fatalf("restriction = %+v", restriction)
+			}
+			if (restriction.ExpiresAt != nil) != tt.wantExpiry {
+				t.Fatalf("expires at = %v, want set %v", restriction.ExpiresAt, tt.wantExpiry)
+			}
+			if len(events.events) != 1 || events.events[0].Type != entities.SystemEventMemberBanned {
+				t.Fatalf("events = %+v, want one ban", events.events)
+			}
+		})
+	}
+}
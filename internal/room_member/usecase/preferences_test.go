@@ -0,0 +1,106 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/MingPV/ChatService/internal/entities"
+	"github.com/MingPV/ChatService/pkg/apperror"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestMuteRoom(t *testing.T) {
+	user := uuid.New()
+
+	tests := []struct {
+		name       string
+		userId     uuid.UUID
+		duration   time.Duration
+		wantErr    error
+		wantUntil  bool
+		mutedLater bool // still muted two hours from now
+	}{
+		{name: "mutes until unmuted", userId: user, wantUntil: false, mutedLater: true},
+		{name: "mutes for an hour", userId: user, duration: time.Hour, wantUntil: true, mutedLater: false},
+		{name: "negative duration", userId: user, duration: -time.Minute, wantErr: apperror.ErrOutOfRange},
+		{name: "not a member", userId: uuid.New(), wantErr: mongo.ErrNoDocuments},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			members := newFakeMembers(map[uuid.UUID]entities.RoomRole{user: entities.RoomRoleMember})
+			s := &RoomMemberService{repo: members}
+
+			member, err := s.MuteRoom(1, tt.userId, tt.duration)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("MuteRoom() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				if members.Find(1, user).Muted {
+					t.Fatalf("member muted after a failed call")
+				}
+				return
+			}
+			if (member.MutedUntil != nil) != tt.wantUntil {
+				t.Fatalf("muted until = %v, want set %v", member.MutedUntil, tt.wantUntil)
+			}
+			now := time.Now().UTC()
+			if !member.IsMuted(now) {
+				t.Fatalf("IsMuted(now) = false, want true")
+			}
+			if got := member.IsMuted(now.Add(2 * time.Hour)); got != tt.mutedLater {
+				t.Fatalf("IsMuted(later) = %v, want %v", got, tt.mutedLater)
+			}
+
+			member, err = s.UnmuteRoom(1, tt.userId)
+			if err != nil {
+				t.Fatalf("UnmuteRoom() error = %v", err)
+			}
+			if member.IsMuted(now) || member.MutedUntil != nil {
+				t.Fatalf("member = %+v, want unmuted", member)
+			}
+		})
+	}
+}
+
+func TestArchiveAndPinRoom(t *testing.T) {
+	user := uuid.New()
+
+	tests := []struct {
+		name     string
+		archived bool
+		pinned   bool
+	}{
+		{name: "archive and pin", archived: true, pinned: true},
+		{name: "unarchive and unpin", archived: false, pinned: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			members := newFakeMembers(map[uuid.UUID]entities.RoomRole{user: entities.RoomRoleMember})
+			members.Find(1, user).Archived = !tt.archived
+			if !tt.pinned {
+				now := time.Now().UTC()
+				members.Find(1, user).PinnedAt = &now
+			}
+			s := &RoomMemberService{repo: members}
+
+			member, err := s.ArchiveRoom(1, user, tt.archived)
+			if err != nil {
+				t.Fatalf("ArchiveRoom() error = %v", err)
+			}
+			if member.Archived != tt.archived {
+				t.Fatalf("archived = %v, want %v", member.Archived, tt.archived)
+			}
+			member, err = s.PinRoom(1, user, tt.pinned)
+			if err != nil {
+				t.Fatalf("PinRoom() error = %v", err)
+			}
+			if (member.PinnedAt != nil) != tt.pinned {
+				t.Fatalf("pinned at = %v, want pinned %v", member.PinnedAt, tt.pinned)
+			}
+		})
+	}
+}
@@ -1,23 +1,45 @@
 package usecase
 
 import (
+	"time"
+
+	bookmarkRepo "github.com/MingPV/ChatService/internal/bookmark/repository"
+	chatroomRepo "github.com/MingPV/ChatService/internal/chatroom/repository"
 	"github.com/MingPV/ChatService/internal/entities"
+	messageUseCase "github.com/MingPV/ChatService/internal/message/usecase"
 	"github.com/MingPV/ChatService/internal/room_member/repository"
+	"github.com/MingPV/ChatService/pkg/apperror"
+	"github.com/MingPV/ChatService/pkg/pagination"
+	"github.com/MingPV/ChatService/pkg/profile"
 	"github.com/google/uuid"
 )
 
 // RoomMemberService implements RoomMemberUseCase
 type RoomMemberService struct {
 	repo repository.RoomMemberRepository
+	restrictionRepo repository.RestrictionRepository
+	chatroomRepo chatroomRepo.ChatroomRepository
+	bookmarkRepo bookmarkRepo.BookmarkRepository
+	messageUseCase messageUseCase.MessageUseCase
+	profiles profile.Provider
 }
 
 // Init RoomMemberService
-func NewRoomMemberService(repo repository.RoomMemberRepository) RoomMemberUseCase {
-	return &RoomMemberService{repo: repo}
+func NewRoomMemberService(repo repository.RoomMemberRepository, restrictionRepo repository.RestrictionRepository, chatroomRepo chatroomRepo.ChatroomRepository, bookmarkRepo bookmarkRepo.BookmarkRepository, messageUseCase messageUseCase.MessageUseCase, profiles profile.Provider) RoomMemberUseCase {
+	return &RoomMemberService{repo: repo, restrictionRepo: restrictionRepo, chatroomRepo: chatroomRepo, bookmarkRepo: bookmarkRepo, messageUseCase: messageUseCase, profiles: profiles}
 }
 
 // 1. Create multiple members in a room
 func (s *RoomMemberService) CreateRoomMembers(roomId uint, userIDs []uuid.UUID) error {
+	for _, id := range userIDs {
+		banned, err := s.restrictionRepo.ExistsActive(roomId, id, entities.RestrictionBan)
+		if err != nil {
+			return err
+		}
+		if banned {
+			return apperror.ErrForbidden
+		}
+	}
 	if err := s.repo.Save(roomId, userIDs); err != nil {
 		return err
 	}
@@ -33,14 +55,33 @@ func (s *RoomMemberService) FindAllByRoomID(roomId uint) ([]*entities.RoomMember
 	return members, nil
 }
 
-func (s *RoomMemberService) FindAllByUserID(userId uuid.UUID) ([]*entities.RoomMember, error) {
-	chatrooms, err := s.repo.FindAllByUserID(userId)
+func (s *RoomMemberService) FindAllByUserID(userId uuid.UUID, includeArchived bool) ([]*entities.RoomMember, error) {
+	chatrooms, err := s.repo.FindRoomList(userId, includeArchived)
 	if err != nil {
 		return nil, err
 	}
+	if err := s.resolveDisplay(userId, chatrooms); err != nil {
+		return nil, err
+	}
 	return chatrooms, nil
 }
 
+func (s *RoomMemberService) FindInbox(userId uuid.UUID, includeArchived bool, page, pageSize int) ([]*entities.InboxEntry, int64, error) {
+	offset, limit := pagination.OffsetLimit(page, pageSize)
+	entries, total, err := s.repo.FindInbox(userId, includeArchived, offset, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+	members := make([]*entities.RoomMember, 0, len(entries))
+	for _, e := range entries {
+		members = append(members, &e.RoomMember)
+	}
+	if err := s.resolveDisplay(userId, members); err != nil {
+		return nil, 0, err
+	}
+	return entries, total, nil
+}
+
 // 3. Get a specific member in a room
 func (s *RoomMemberService) FindByRoomIDAndUserID(roomId uint, userId uuid.UUID) (*entities.RoomMember, error) {
 	member, err := s.repo.FindAllByRoomIDAndUserID(roomId, userId)
@@ -55,6 +96,10 @@ func (s *RoomMemberService) DeleteByRoomIDAndUserID(roomId uint, userId uuid.UUI
 	if err := s.repo.DeleteByRoomIDAndUserID(roomId, userId); err != nil {
 		return err
 	}
+	// saved messages from a room the user left are no longer readable
+	if err := s.bookmarkRepo.DeleteAllByRoomIDAndUserID(roomId, userId); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -67,8 +112,81 @@ func (s *RoomMemberService) DeleteAllByRoomID(roomId int) error {
 }
 
 func (s *RoomMemberService) DeleteRoomMember(id int) error {
+	member, err := s.repo.FindByID(id)
+	if err != nil {
+		return err
+	}
 	if err := s.repo.Delete(id); err != nil {
 		return err
 	}
+	if err := s.bookmarkRepo.DeleteAllByRoomIDAndUserID(member.RoomId, member.UserId); err != nil {
+		return err
+	}
 	return nil
-}
\ No newline at end of file
+}
+
+// UpdateMemberRole lets the room owner promote members to admin or demote them back.
+// Ownership itself stays with Chatroom.Owner and cannot be granted here.
+func (s *RoomMemberService) UpdateMemberRole(roomId uint, actorId uuid.UUID, userId uuid.UUID, role entities.RoomRole) (*entities.RoomMember, error) {
+	if role != entities.RoomRoleAdmin && role != entities.RoomRoleMember {
+		return nil, apperror.ErrInvalidData
+	}
+
+	room, err := s.chatroomRepo.FindByID(int(roomId))
+	if err != nil {
+		return nil, err
+	}
+	if room.Owner != actorId {
+		return nil, apperror.ErrForbidden
+	}
+	if room.Owner == userId {
+		return nil, apperror.ErrForbidden
+	}
+
+	if err := s.repo.UpdateRole(roomId, userId, role); err != nil {
+		return nil, err
+	}
+	return s.repo.FindAllByRoomIDAndUserID(roomId, userId)
+}
+
+func (s *RoomMemberService) MuteRoom(roomId uint, userId uuid.UUID, duration time.Duration) (*entities.RoomMember, error) {
+	if duration < 0 {
+		return nil, apperror.ErrOutOfRange
+	}
+	var until *time.Time
+	if duration > 0 {
+		t := time.Now().UTC().Add(duration)
+		until = &t
+	}
+	if err := s.repo.SetMuted(roomId, userId, true, until); err != nil {
+		return nil, err
+	}
+	return s.repo.FindAllByRoomIDAndUserID(roomId, userId)
+}
+
+func (s *RoomMemberService) UnmuteRoom(roomId uint, userId uuid.UUID) (*entities.RoomMember, error) {
+	if err := s.repo.SetMuted(roomId, userId, false, nil); err != nil {
+		return nil, err
+	}
+	return s.repo.FindAllByRoomIDAndUserID(roomId, userId)
+}
+
+func (s *RoomMemberService) ArchiveRoom(roomId uint, userId uuid.UUID, archived bool) (*entities.RoomMember, error) {
+	if err := s.repo.SetArchived(roomId, userId, archived); err != nil {
+		return nil, err
+	}
+	return s.repo.FindAllByRoomIDAndUserID(roomId, userId)
+}
+
+func (s *RoomMemberService) PinRoom(roomId uint, userId uuid.UUID, pinned bool) (*entities.RoomMember, error) {
+	var pinnedAt *time.Time
+	if pinned {
+		now := time.Now().UTC()
+		pinnedAt = &now
+	}
+	if err := s.repo.SetPinned(roomId, userId, pinnedAt); err != nil {
+		return nil, err
+	}
+	return s.repo.FindAllByRoomIDAndUserID(roomId, userId)
+}
+
@@ -0,0 +1,110 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/MingPV/ChatService/internal/entities"
+	"github.com/MingPV/ChatService/internal/scheduled_message/usecase"
+	"github.com/MingPV/ChatService/pkg/apperror"
+	scheduledpb "github.com/MingPV/ChatService/proto/scheduled_message"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type GrpcScheduledMessageHandler struct {
+	scheduledUseCase usecase.ScheduledMessageUseCase
+	scheduledpb.UnimplementedScheduledMessageServiceServer
+}
+
+func NewGrpcScheduledMessageHandler(uc usecase.ScheduledMessageUseCase) *GrpcScheduledMessageHandler {
+	return &GrpcScheduledMessageHandler{scheduledUseCase: uc}
+}
+
+func (h *GrpcScheduledMessageHandler) CreateScheduledMessage(ctx context.Context, req *scheduledpb.CreateScheduledMessageRequest) (*scheduledpb.CreateScheduledMessageResponse, error) {
+	sender, err := uuid.Parse(req.Sender)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+	if req.SendAt == nil {
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrRequiredField), "send_at is required")
+	}
+
+	scheduled := &entities.ScheduledMessage{
+		RoomId:  uint(req.RoomId),
+		Sender:  sender,
+		Message: req.Message,
+		SendAt:  req.SendAt.AsTime(),
+	}
+	for _, id := range req.AttachmentIds {
+		scheduled.AttachmentIds = append(scheduled.AttachmentIds, uint(id))
+	}
+	if err := h.scheduledUseCase.CreateScheduledMessage(scheduled); err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+	return &scheduledpb.CreateScheduledMessageResponse{ScheduledMessage: toProtoScheduledMessage(scheduled)}, nil
+}
+
+func (h *GrpcScheduledMessageHandler) FindAllScheduledMessages(ctx context.Context, req *scheduledpb.FindAllScheduledMessagesRequest) (*scheduledpb.FindAllScheduledMessagesResponse, error) {
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+	scheduled, total, err := h.scheduledUseCase.FindAllPendingBySender(userId, uint(req.RoomId), int(req.Page), int(req.PageSize))
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+
+	var protoScheduled []*scheduledpb.ScheduledMessage
+	for _, sm := range scheduled {
+		protoScheduled = append(protoScheduled, toProtoScheduledMessage(sm))
+	}
+	return &scheduledpb.FindAllScheduledMessagesResponse{ScheduledMessages: protoScheduled, Total: total}, nil
+}
+
+func (h *GrpcScheduledMessageHandler) CancelScheduledMessage(ctx context.Context, req *scheduledpb.CancelScheduledMessageRequest) (*scheduledpb.CancelScheduledMessageResponse, error) {
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+	if err := h.scheduledUseCase.CancelScheduledMessage(int(req.Id), userId); err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+	return &scheduledpb.CancelScheduledMessageResponse{Message: "scheduled message cancelled"}, nil
+}
+
+func (h *GrpcScheduledMessageHandler) RescheduleMessage(ctx context.Context, req *scheduledpb.RescheduleMessageRequest) (*scheduledpb.RescheduleMessageResponse, error) {
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrInvalidID), "%s", err.Error())
+	}
+	if req.SendAt == nil {
+		return nil, status.Errorf(apperror.GRPCCode(apperror.ErrRequiredField), "send_at is required")
+	}
+	scheduled, err := h.scheduledUseCase.RescheduleMessage(int(req.Id), userId, req.SendAt.AsTime())
+	if err != nil {
+		return nil, status.Errorf(apperror.GRPCCode(err), "%s", err.Error())
+	}
+	return &scheduledpb.RescheduleMessageResponse{ScheduledMessage: toProtoScheduledMessage(scheduled)}, nil
+}
+
+// ---- Helper functions ----
+
+func toProtoScheduledMessage(sm *entities.ScheduledMessage) *scheduledpb.ScheduledMessage {
+	out := &scheduledpb.ScheduledMessage{
+		Id:        int32(sm.ID),
+		RoomId:    int32(sm.RoomId),
+		Sender:    sm.Sender.String(),
+		Message:   sm.Message,
+		SendAt:    timestamppb.New(sm.SendAt),
+		Status:    string(sm.Status),
+		MessageId: int32(sm.MessageId),
+		Error:     sm.Error,
+		CreatedAt: timestamppb.New(sm.CreatedAt),
+		UpdatedAt: timestamppb.New(sm.UpdatedAt),
+	}
+	for _, id := range sm.AttachmentIds {
+		out.AttachmentIds = append(out.AttachmentIds, int32(id))
+	}
+	return out
+}
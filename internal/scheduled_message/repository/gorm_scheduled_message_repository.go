@@ -0,0 +1,224 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/MingPV/ChatService/internal/entities"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type MongoScheduledMessageRepository struct {
+	db   *mongo.Database
+	coll *mongo.Collection
+}
+
+func NewMongoScheduledMessageRepository(db *mongo.Database) ScheduledMessageRepository {
+	return &MongoScheduledMessageRepository{
+		db:   db,
+		coll: db.Collection("scheduled_messages"),
+	}
+}
+
+type counterDoc struct {
+	ID  string `bson:"_id"`
+	Seq int    `bson:"seq"`
+}
+
+// EnsureIndexes backs the scheduler's due query and the per-sender listing
+func (r *MongoScheduledMessageRepository) EnsureIndexes() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	_, err := r.coll.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "status", Value: 1}, {Key: "send_at", Value: 1}}},
+		{Keys: bson.D{{Key: "sender", Value: 1}, {Key: "status", Value: 1}, {Key: "send_at", Value: 1}}},
+	})
+	return err
+}
+
+func (r *MongoScheduledMessageRepository) Save(scheduled *entities.ScheduledMessage) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	nextID, err := r.getNextSequence(ctx, "scheduled_messages")
+	if err != nil {
+		return err
+	}
+	scheduled.ID = uint(nextID)
+
+	_, err = r.coll.InsertOne(ctx, scheduled)
+	return err
+}
+
+func (r *MongoScheduledMessageRepository) FindByID(id int) (*entities.ScheduledMessage, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var sm entities.ScheduledMessage
+	err := r.coll.FindOne(ctx, bson.M{"_id": id}).Decode(&sm)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return &entities.ScheduledMessage{}, err
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &sm, nil
+}
+
+// FindAllPendingBySender lists pending messages, soonest first. roomId 0 matches every room.
+func (r *MongoScheduledMessageRepository) FindAllPendingBySender(sender uuid.UUID, roomId uint, offset, limit int) ([]*entities.ScheduledMessage, int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	filter := bson.M{"sender": sender, "status": entities.ScheduledMessagePending}
+	if roomId != 0 {
+		filter["room_id"] = roomId
+	}
+
+	total, err := r.coll.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "send_at", Value: 1}}).
+		SetSkip(int64(offset)).
+		SetLimit(int64(limit))
+	cur, err := r.coll.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer cur.Close(ctx)
+
+	var results []*entities.ScheduledMessage
+	for cur.Next(ctx) {
+		var sm entities.ScheduledMessage
+		if err := cur.Decode(&sm); err != nil {
+			return nil, 0, err
+		}
+		results = append(results, &sm)
+	}
+	return results, total, cur.Err()
+}
+
+func (r *MongoScheduledMessageRepository) Reschedule(id uint, sendAt time.Time) error {
+	return r.updatePending(id, bson.M{"send_at": sendAt})
+}
+
+func (r *MongoScheduledMessageRepository) Cancel(id uint) error {
+	return r.updatePending(id, bson.M{"status": entities.ScheduledMessageCancelled})
+}
+
+func (r *MongoScheduledMessageRepository) updatePending(id uint, set bson.M) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	set["updated_at"] = time.Now().UTC()
+	res, err := r.coll.UpdateOne(ctx,
+		bson.M{"_id": id, "status": entities.ScheduledMessagePending},
+		bson.M{"$set": set},
+	)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+func (r *MongoScheduledMessageRepository) ClaimDue(now time.Time) (*entities.ScheduledMessage, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "send_at", Value: 1}}).
+		SetReturnDocument(options.After)
+
+	var sm entities.ScheduledMessage
+	err := r.coll.FindOneAndUpdate(ctx,
+		bson.M{"status": entities.ScheduledMessagePending, "send_at": bson.M{"$lte": now}},
+		bson.M{"$set": bson.M{"status": entities.ScheduledMessageSending, "claimed_at": now, "updated_at": now}},
+		opts,
+	).Decode(&sm)
+	if err != nil {
+		return nil, err
+	}
+	return &sm, nil
+}
+
+func (r *MongoScheduledMessageRepository) MarkSent(id uint, messageId uint) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := r.coll.UpdateByID(ctx, id, bson.M{"$set": bson.M{
+		"status":     entities.ScheduledMessageSent,
+		"message_id": messageId,
+		"updated_at": time.Now().UTC(),
+	}})
+	return err
+}
+
+func (r *MongoScheduledMessageRepository) MarkFailed(id uint, reason string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := r.coll.UpdateByID(ctx, id, bson.M{"$set": bson.M{
+		"status":     entities.ScheduledMessageFailed,
+		"error":      reason,
+		"updated_at": time.Now().UTC(),
+	}})
+	return err
+}
+
+func (r *MongoScheduledMessageRepository) ReleaseClaimed(before time.Time) (int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// claims without claimed_at predate the lease and are treated as stale
+	res, err := r.coll.UpdateMany(ctx,
+		bson.M{"status": entities.ScheduledMessageSending, "claimed_at": bson.M{"$not": bson.M{"$gt": before}}},
+		bson.M{
+			"$set":   bson.M{"status": entities.ScheduledMessagePending, "updated_at": time.Now().UTC()},
+			"$unset": bson.M{"claimed_at": ""},
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return res.ModifiedCount, nil
+}
+
+// getNextSequence generates auto-increment ID
+func (r *MongoScheduledMessageRepository) getNextSequence(ctx context.Context, name string) (int, error) {
+	counters := r.db.Collection("counters")
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+
+	var out counterDoc
+	err := counters.FindOneAndUpdate(
+		ctx,
+		bson.M{"_id": name},
+		bson.M{"$inc": bson.M{"seq": 1}},
+		opts,
+	).Decode(&out)
+
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		_, ierr := counters.InsertOne(ctx, counterDoc{ID: name, Seq: 1})
+		if ierr != nil {
+			return 0, ierr
+		}
+		return 1, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if out.Seq == 0 {
+		return 1, nil
+	}
+	return out.Seq, nil
+}
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/MingPV/ChatService/internal/entities"
+	"github.com/google/uuid"
+)
+
+type ScheduledMessageRepository interface {
+	Save(scheduled *entities.ScheduledMessage) error
+	FindByID(id int) (*entities.ScheduledMessage, error)
+	FindAllPendingBySender(sender uuid.UUID, roomId uint, offset, limit int) ([]*entities.ScheduledMessage, int64, error)
+	// Reschedule and Cancel only apply to pending messages; otherwise they return mongo.ErrNoDocuments.
+	Reschedule(id uint, sendAt time.Time) error
+	Cancel(id uint) error
+	// ClaimDue atomically moves the oldest due pending message to sending.
+	ClaimDue(now time.Time) (*entities.ScheduledMessage, error)
+	MarkSent(id uint, messageId uint) error
+	MarkFailed(id uint, reason string) error
+	// ReleaseClaimed puts messages claimed before the cutoff and still in sending
+	// (e.g. after a crash) back to pending.
+	ReleaseClaimed(before time.Time) (int64, error)
+	EnsureIndexes() error
+}
@@ -0,0 +1,15 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/MingPV/ChatService/internal/entities"
+	"github.com/google/uuid"
+)
+
+type ScheduledMessageUseCase interface {
+	CreateScheduledMessage(scheduled *entities.ScheduledMessage) error
+	FindAllPendingBySender(sender uuid.UUID, roomId uint, page, pageSize int) ([]*entities.ScheduledMessage, int64, error)
+	CancelScheduledMessage(id int, userId uuid.UUID) error
+	RescheduleMessage(id int, userId uuid.UUID, sendAt time.Time) (*entities.ScheduledMessage, error)
+}
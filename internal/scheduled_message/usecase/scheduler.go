@@ -0,0 +1,140 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/MingPV/ChatService/internal/entities"
+	messageRepo "github.com/MingPV/ChatService/internal/message/repository"
+	messageUseCase "github.com/MingPV/ChatService/internal/message/usecase"
+	roommemberRepo "github.com/MingPV/ChatService/internal/room_member/repository"
+	scheduledRepo "github.com/MingPV/ChatService/internal/scheduled_message/repository"
+	"github.com/MingPV/ChatService/pkg/apperror"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Scheduler delivers due scheduled messages through MessageUseCase.CreateMessage.
+// All state lives in MongoDB, so pending messages survive a restart.
+type Scheduler struct {
+	repo           scheduledRepo.ScheduledMessageRepository
+	roommemberRepo roommemberRepo.RoomMemberRepository
+	messageRepo    messageRepo.MessageRepository
+	messageUseCase messageUseCase.MessageUseCase
+	interval       time.Duration
+	lease          time.Duration
+}
+
+// NewScheduler polls every interval. A claim older than lease is assumed to
+// belong to a process that died and is handed out again; messageRepo is used
+// to spot a message that was delivered before the claim was marked sent.
+func NewScheduler(repo scheduledRepo.ScheduledMessageRepository, roommemberRepo roommemberRepo.RoomMemberRepository, messageRepo messageRepo.MessageRepository, messageUseCase messageUseCase.MessageUseCase, interval, lease time.Duration) *Scheduler {
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+	if lease <= 0 {
+		lease = 5 * time.Minute
+	}
+	return &Scheduler{repo: repo, roommemberRepo: roommemberRepo, messageRepo: messageRepo, messageUseCase: messageUseCase, interval: interval, lease: lease}
+}
+
+// Start polls for due messages until ctx is cancelled, re-queueing claims
+// whose lease ran out so other running schedulers keep their work.
+func (s *Scheduler) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+		for {
+			s.releaseExpired()
+			s.deliverDue()
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (s *Scheduler) releaseExpired() {
+	n, err := s.repo.ReleaseClaimed(time.Now().UTC().Add(-s.lease))
+	if err != nil {
+		log.Printf("scheduler: failed to release claimed messages: %v", err)
+	} else if n > 0 {
+		log.Printf("scheduler: re-queued %d interrupted messages", n)
+	}
+}
+
+func (s *Scheduler) deliverDue() {
+	for {
+		scheduled, err := s.repo.ClaimDue(time.Now().UTC())
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return
+		}
+		if err != nil {
+			log.Printf("scheduler: failed to claim due message: %v", err)
+			return
+		}
+		s.deliver(scheduled)
+	}
+}
+
+func (s *Scheduler) deliver(scheduled *entities.ScheduledMessage) {
+	// a claim handed out again after MarkSent failed finds its message already there
+	delivered, err := s.messageRepo.FindByScheduledID(scheduled.ID)
+	if err == nil {
+		s.markSent(scheduled, delivered.ID)
+		return
+	}
+	if !errors.Is(err, mongo.ErrNoDocuments) {
+		s.fail(scheduled, err)
+		return
+	}
+
+	if _, err := s.roommemberRepo.FindAllByRoomIDAndUserID(scheduled.RoomId, scheduled.Sender); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			err = apperror.ErrForbidden
+		}
+		s.fail(scheduled, err)
+		return
+	}
+
+	now := time.Now().UTC()
+	message := &entities.Message{
+		RoomId:      scheduled.RoomId,
+		Message:     scheduled.Message,
+		Sender:      scheduled.Sender,
+		ScheduledId: scheduled.ID,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	for _, id := range scheduled.AttachmentIds {
+		message.Attachments = append(message.Attachments, entities.Attachment{ID: id})
+	}
+	if err := s.messageUseCase.CreateMessage(message); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			// another scheduler holding an expired lease delivered it first
+			if delivered, ferr := s.messageRepo.FindByScheduledID(scheduled.ID); ferr == nil {
+				s.markSent(scheduled, delivered.ID)
+				return
+			}
+		}
+		s.fail(scheduled, err)
+		return
+	}
+	s.markSent(scheduled, message.ID)
+}
+
+func (s *Scheduler) markSent(scheduled *entities.ScheduledMessage, messageId uint) {
+	if err := s.repo.MarkSent(scheduled.ID, messageId); err != nil {
+		log.Printf("scheduler: message %d sent but not marked: %v", scheduled.ID, err)
+	}
+}
+
+func (s *Scheduler) fail(scheduled *entities.ScheduledMessage, err error) {
+	log.Printf("scheduler: scheduled message %d failed: %v", scheduled.ID, err)
+	if merr := s.repo.MarkFailed(scheduled.ID, err.Error()); merr != nil {
+		log.Printf("scheduler: failed to mark message %d failed: %v", scheduled.ID, merr)
+	}
+}
@@ -0,0 +1,147 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/MingPV/ChatService/internal/entities"
+	messageRepo "github.com/MingPV/ChatService/internal/message/repository"
+	messageUseCase "github.com/MingPV/ChatService/internal/message/usecase"
+	scheduledRepo "github.com/MingPV/ChatService/internal/scheduled_message/repository"
+	"github.com/MingPV/ChatService/internal/testsupport"
+	"github.com/MingPV/ChatService/pkg/apperror"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type fakeScheduledRepo struct {
+	scheduledRepo.ScheduledMessageRepository
+	sent          map[uint]uint
+	failed        map[uint]string
+	releaseBefore time.Time
+}
+
+func (f *fakeScheduledRepo) MarkSent(id uint, messageId uint) error {
+	f.sent[id] = messageId
+	return nil
+}
+
+func (f *fakeScheduledRepo) MarkFailed(id uint, reason string) error {
+	f.failed[id] = reason
+	return nil
+}
+
+func (f *fakeScheduledRepo) ReleaseClaimed(before time.Time) (int64, error) {
+	f.releaseBefore = before
+	return 0, nil
+}
+
+// fakeDelivered holds messages already delivered, by scheduled message id
+type fakeDelivered struct {
+	messageRepo.MessageRepository
+	messages map[uint]*entities.Message
+}
+
+func (f *fakeDelivered) FindByScheduledID(scheduledId uint) (*entities.Message, error) {
+	if m, ok := f.messages[scheduledId]; ok {
+		return m, nil
+	}
+	return &entities.Message{}, mongo.ErrNoDocuments
+}
+
+type fakeMessages struct {
+	messageUseCase.MessageUseCase
+	err     error
+	created []*entities.Message
+}
+
+func (f *fakeMessages) CreateMessage(message *entities.Message) error {
+	if f.err != nil {
+		return f.err
+	}
+	message.ID = uint(100 + len(f.created))
+	f.created = append(f.created, message)
+	return nil
+}
+
+func TestCheckSendAt(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name    string
+		sendAt  time.Time
+		wantErr error
+	}{
+		{"in an hour", now.Add(time.Hour), nil},
+		{"in the past", now.Add(-time.Minute), apperror.ErrOutOfRange},
+		{"too far ahead", now.Add(maxScheduleAhead + time.Hour), apperror.ErrOutOfRange},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := checkSendAt(tt.sendAt); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("checkSendAt = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSchedulerDeliver(t *testing.T) {
+	member := uuid.New()
+	tests := []struct {
+		name      string
+		sender    uuid.UUID
+		createErr error
+		delivered *entities.Message
+		wantSent  bool
+	}{
+		{"member's message is sent", member, nil, nil, true},
+		{"sender left the room", uuid.New(), nil, nil, false},
+		{"send rejected", member, apperror.ErrForbidden, nil, false},
+		{"already delivered is only marked sent", member, nil, &entities.Message{ID: 42, ScheduledId: 1}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeScheduledRepo{sent: map[uint]uint{}, failed: map[uint]string{}}
+			messages := &fakeMessages{err: tt.createErr}
+			delivered := &fakeDelivered{messages: map[uint]*entities.Message{}}
+			if tt.delivered != nil {
+				delivered.messages[tt.delivered.ScheduledId] = tt.delivered
+			}
+			s := NewScheduler(repo, testsupport.NewRoomMembers(2, map[uuid.UUID]entities.RoomRole{member: entities.RoomRoleMember}), delivered, messages, time.Second, time.Minute)
+
+			s.deliver(&entities.ScheduledMessage{ID: 1, RoomId: 2, Sender: tt.sender, Message: "hi", AttachmentIds: []uint{5}})
+
+			if _, sent := repo.sent[1]; sent != tt.wantSent {
+				t.Fatalf("sent = %v, want %v", sent, tt.wantSent)
+			}
+			if _, failed := repo.failed[1]; failed == tt.wantSent {
+				t.Fatalf("failed = %v, want %v", failed, !tt.wantSent)
+			}
+			if tt.delivered != nil {
+				if len(messages.created) != 0 || repo.sent[1] != tt.delivered.ID {
+					t.Fatalf("created %d messages, marked sent as %d, want only %d marked", len(messages.created), repo.sent[1], tt.delivered.ID)
+				}
+				return
+			}
+			if tt.wantSent {
+				m := messages.created[0]
+				if repo.sent[1] != m.ID || m.RoomId != 2 || m.ScheduledId != 1 || len(m.Attachments) != 1 || m.Attachments[0].ID != 5 {
+					t.Fatalf("created %+v, marked sent as %d", m, repo.sent[1])
+				}
+			}
+		})
+	}
+}
+
+func TestSchedulerReleasesOnlyExpiredLeases(t *testing.T) {
+	repo := &fakeScheduledRepo{}
+	s := NewScheduler(repo, nil, nil, nil, time.Second, 10*time.Minute)
+
+	before := time.Now().UTC()
+	s.releaseExpired()
+
+	want := before.Add(-10 * time.Minute)
+	if d := repo.releaseBefore.Sub(want); d < 0 || d > time.Second {
+		t.Fatalf("released claims before %v, want about %v", repo.releaseBefore, want)
+	}
+}
@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"errors"
+	"time"
+
+	"github.com/MingPV/ChatService/internal/entities"
+	roommemberRepo "github.com/MingPV/ChatService/internal/room_member/repository"
+	scheduledRepo "github.com/MingPV/ChatService/internal/scheduled_message/repository"
+	"github.com/MingPV/ChatService/pkg/apperror"
+	"github.com/MingPV/ChatService/pkg/pagination"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// maxScheduleAhead bounds how far in the future a message can be scheduled
+const maxScheduleAhead = 365 * 24 * time.Hour
+
+// ScheduledMessageService implements ScheduledMessageUseCase
+type ScheduledMessageService struct {
+	repo           scheduledRepo.ScheduledMessageRepository
+	roommemberRepo roommemberRepo.RoomMemberRepository
+}
+
+func NewScheduledMessageService(repo scheduledRepo.ScheduledMessageRepository, roommemberRepo roommemberRepo.RoomMemberRepository) ScheduledMessageUseCase {
+	return &ScheduledMessageService{repo: repo, roommemberRepo: roommemberRepo}
+}
+
+func (s *ScheduledMessageService) CreateScheduledMessage(scheduled *entities.ScheduledMessage) error {
+	if scheduled.Message == "" && len(scheduled.AttachmentIds) == 0 {
+		return apperror.ErrRequiredField
+	}
+	if err := checkSendAt(scheduled.SendAt); err != nil {
+		return err
+	}
+	if err := s.checkMember(scheduled.RoomId, scheduled.Sender); err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	scheduled.SendAt = scheduled.SendAt.UTC()
+	scheduled.Status = entities.ScheduledMessagePending
+	scheduled.CreatedAt = now
+	scheduled.UpdatedAt = now
+	return s.repo.Save(scheduled)
+}
+
+func (s *ScheduledMessageService) FindAllPendingBySender(sender uuid.UUID, roomId uint, page, pageSize int) ([]*entities.ScheduledMessage, int64, error) {
+	offset, limit := pagination.OffsetLimit(page, pageSize)
+	return s.repo.FindAllPendingBySender(sender, roomId, offset, limit)
+}
+
+func (s *ScheduledMessageService) CancelScheduledMessage(id int, userId uuid.UUID) error {
+	scheduled, err := s.findOwned(id, userId)
+	if err != nil {
+		return err
+	}
+	if err := s.repo.Cancel(scheduled.ID); err != nil {
+		return notPending(err)
+	}
+	return nil
+}
+
+func (s *ScheduledMessageService) RescheduleMessage(id int, userId uuid.UUID, sendAt time.Time) (*entities.ScheduledMessage, error) {
+	if err := checkSendAt(sendAt); err != nil {
+		return nil, err
+	}
+	scheduled, err := s.findOwned(id, userId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.Reschedule(scheduled.ID, sendAt.UTC()); err != nil {
+		return nil, notPending(err)
+	}
+	return s.repo.FindByID(id)
+}
+
+func (s *ScheduledMessageService) findOwned(id int, userId uuid.UUID) (*entities.ScheduledMessage, error) {
+	scheduled, err := s.repo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if scheduled.Sender != userId {
+		return nil, apperror.ErrForbidden
+	}
+	return scheduled, nil
+}
+
+func (s *ScheduledMessageService) checkMember(roomId uint, userId uuid.UUID) error {
+	_, err := s.roommemberRepo.FindAllByRoomIDAndUserID(roomId, userId)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return apperror.ErrForbidden
+	}
+	return err
+}
+
+func checkSendAt(sendAt time.Time) error {
+	now := time.Now()
+	if !sendAt.After(now) || sendAt.After(now.Add(maxScheduleAhead)) {
+		return apperror.ErrOutOfRange
+	}
+	return nil
+}
+
+// notPending maps a miss on a pending-only update: the message was already sent or cancelled
+func notPending(err error) error {
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return apperror.ErrNotAvailable
+	}
+	return err
+}
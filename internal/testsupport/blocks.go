@@ -0,0 +1,50 @@
+package testsupport
+
+import (
+	"github.com/MingPV/ChatService/internal/entities"
+	friendRepo "github.com/MingPV/ChatService/internal/friend/repository"
+	"github.com/google/uuid"
+)
+
+// Blocks is an in-memory BlockRepository keyed blocker -> blocked
+type Blocks struct {
+	friendRepo.BlockRepository
+	Blocked map[uuid.UUID][]uuid.UUID
+}
+
+func NewBlocks() *Blocks {
+	return &Blocks{Blocked: make(map[uuid.UUID][]uuid.UUID)}
+}
+
+func (f *Blocks) Save(block *entities.Block) error {
+	f.Blocked[block.UserID] = append(f.Blocked[block.UserID], block.BlockedID)
+	return nil
+}
+
+func (f *Blocks) Exists(userId uuid.UUID, blockedId uuid.UUID) (bool, error) {
+	for _, id := range f.Blocked[userId] {
+		if id == blockedId {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (f *Blocks) ExistsBetween(a uuid.UUID, b uuid.UUID) (bool, error) {
+	if blocked, _ := f.Exists(a, b); blocked {
+		return true, nil
+	}
+	return f.Exists(b, a)
+}
+
+func (f *Blocks) FindAllRelatedIDs(userId uuid.UUID) ([]uuid.UUID, error) {
+	related := append([]uuid.UUID{}, f.Blocked[userId]...)
+	for blocker, blocked := range f.Blocked {
+		for _, id := range blocked {
+			if id == userId {
+				related = append(related, blocker)
+			}
+		}
+	}
+	return related, nil
+}
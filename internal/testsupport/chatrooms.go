@@ -0,0 +1,76 @@
+package testsupport
+
+import (
+	chatroomRepo "github.com/MingPV/ChatService/internal/chatroom/repository"
+	"github.com/MingPV/ChatService/internal/entities"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Chatrooms is an in-memory ChatroomRepository keyed by room id
+type Chatrooms struct {
+	chatroomRepo.ChatroomRepository
+	Rooms   map[uint]*entities.Chatroom
+	SaveErr error
+	Deleted []int
+	// Raced is stored by the next Save, which then fails as if another request created it first
+	Raced *entities.Chatroom
+}
+
+// NewChatrooms holds rooms under their ids
+func NewChatrooms(rooms ...*entities.Chatroom) *Chatrooms {
+	f := &Chatrooms{Rooms: make(map[uint]*entities.Chatroom)}
+	for _, room := range rooms {
+		if room != nil {
+			f.Rooms[room.ID] = room
+		}
+	}
+	return f
+}
+
+func (f *Chatrooms) FindByID(id int) (*entities.Chatroom, error) {
+	if room, ok := f.Rooms[uint(id)]; ok {
+		return room, nil
+	}
+	return &entities.Chatroom{}, mongo.ErrNoDocuments
+}
+
+func (f *Chatrooms) FindDirect(a, b uuid.UUID) (*entities.Chatroom, error) {
+	key := entities.DirectRoomKey(a, b)
+	for _, room := range f.Rooms {
+		if room.DirectKey == key {
+			return room, nil
+		}
+	}
+	return &entities.Chatroom{}, mongo.ErrNoDocuments
+}
+
+func (f *Chatrooms) Save(room *entities.Chatroom) error {
+	if f.Raced != nil {
+		f.Rooms[f.Raced.ID] = f.Raced
+		f.Raced = nil
+		return mongo.WriteException{WriteErrors: mongo.WriteErrors{{Code: 11000}}}
+	}
+	if f.SaveErr != nil {
+		return f.SaveErr
+	}
+	room.ID = f.nextID()
+	f.Rooms[room.ID] = room
+	return nil
+}
+
+func (f *Chatrooms) Delete(id int) error {
+	f.Deleted = append(f.Deleted, id)
+	delete(f.Rooms, uint(id))
+	return nil
+}
+
+func (f *Chatrooms) nextID() uint {
+	var max uint
+	for id := range f.Rooms {
+		if id > max {
+			max = id
+		}
+	}
+	return max + 1
+}
@@ -0,0 +1,131 @@
+// Package testsupport holds in-memory repository fakes shared by the usecase tests.
+// Each fake embeds its repository interface, so calling a method it does not
+// implement panics and points at the missing piece.
+package testsupport
+
+import (
+	"time"
+
+	"github.com/MingPV/ChatService/internal/entities"
+	roommemberRepo "github.com/MingPV/ChatService/internal/room_member/repository"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Members is an in-memory RoomMemberRepository
+type Members struct {
+	roommemberRepo.RoomMemberRepository
+	Members []*entities.RoomMember
+	SaveErr error
+	// FindErr fails every single-member lookup
+	FindErr error
+}
+
+// NewRoomMembers puts each user of roles into room roomId with the given role
+func NewRoomMembers(roomId uint, roles map[uuid.UUID]entities.RoomRole) *Members {
+	f := &Members{}
+	for userId, role := range roles {
+		f.Add(&entities.RoomMember{RoomId: roomId, UserId: userId, Role: role})
+	}
+	return f
+}
+
+func (f *Members) Add(member *entities.RoomMember) {
+	member.ID = uint(len(f.Members) + 1)
+	f.Members = append(f.Members, member)
+}
+
+// Find returns the stored member itself, or nil
+func (f *Members) Find(roomId uint, userId uuid.UUID) *entities.RoomMember {
+	for _, m := range f.Members {
+		if m.RoomId == roomId && m.UserId == userId {
+			return m
+		}
+	}
+	return nil
+}
+
+func (f *Members) Save(roomId uint, userIDs []uuid.UUID) error {
+	if f.SaveErr != nil {
+		return f.SaveErr
+	}
+	for _, id := range userIDs {
+		f.Add(&entities.RoomMember{RoomId: roomId, UserId: id, Role: entities.RoomRoleMember})
+	}
+	return nil
+}
+
+func (f *Members) FindAllByRoomID(roomId uint) ([]*entities.RoomMember, error) {
+	var out []*entities.RoomMember
+	for _, m := range f.Members {
+		if m.RoomId == roomId {
+			out = append(out, m)
+		}
+	}
+	return out, nil
+}
+
+func (f *Members) FindAllByUserID(userId uuid.UUID) ([]*entities.RoomMember, error) {
+	var out []*entities.RoomMember
+	for _, m := range f.Members {
+		if m.UserId == userId {
+			out = append(out, m)
+		}
+	}
+	return out, nil
+}
+
+func (f *Members) FindAllByRoomIDAndUserID(roomId uint, userId uuid.UUID) (*entities.RoomMember, error) {
+	if f.FindErr != nil {
+		return nil, f.FindErr
+	}
+	if m := f.Find(roomId, userId); m != nil {
+		copied := *m
+		return &copied, nil
+	}
+	return &entities.RoomMember{}, mongo.ErrNoDocuments
+}
+
+func (f *Members) UpdateRole(roomId uint, userId uuid.UUID, role entities.RoomRole) error {
+	return f.update(roomId, userId, func(m *entities.RoomMember) { m.Role = role })
+}
+
+func (f *Members) SetMuted(roomId uint, userId uuid.UUID, muted bool, until *time.Time) error {
+	return f.update(roomId, userId, func(m *entities.RoomMember) { m.Muted, m.MutedUntil = muted, until })
+}
+
+func (f *Members) SetArchived(roomId uint, userId uuid.UUID, archived bool) error {
+	return f.update(roomId, userId, func(m *entities.RoomMember) { m.Archived = archived })
+}
+
+func (f *Members) SetPinned(roomId uint, userId uuid.UUID, pinnedAt *time.Time) error {
+	return f.update(roomId, userId, func(m *entities.RoomMember) { m.PinnedAt = pinnedAt })
+}
+
+func (f *Members) DeleteByRoomIDAndUserID(roomId uint, userId uuid.UUID) error {
+	return f.remove(func(m *entities.RoomMember) bool { return m.RoomId == roomId && m.UserId == userId })
+}
+
+func (f *Members) DeleteAllByRoomID(roomId int) error {
+	return f.remove(func(m *entities.RoomMember) bool { return m.RoomId == uint(roomId) })
+}
+
+func (f *Members) update(roomId uint, userId uuid.UUID, apply func(m *entities.RoomMember)) error {
+	m := f.Find(roomId, userId)
+	if m == nil {
+		return mongo.ErrNoDocuments
+	}
+	apply(m)
+	return nil
+}
+
+func (f *Members) remove(match func(m *entities.RoomMember) bool) error {
+	kept := f.Members[:0]
+	for _, m := range f.Members {
+		if !match(m) {
+			kept = append(kept, m)
+		}
+	}
+	f.Members = kept
+	return nil
+}
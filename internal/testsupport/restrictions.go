@@ -0,0 +1,71 @@
+package testsupport
+
+import (
+	"github.com/MingPV/ChatService/internal/entities"
+	roommemberRepo "github.com/MingPV/ChatService/internal/room_member/repository"
+	"github.com/google/uuid"
+)
+
+// Restrictions is an in-memory RestrictionRepository. Expiry is not tracked,
+// so every stored restriction counts as active.
+type Restrictions struct {
+	roommemberRepo.RestrictionRepository
+	Active []*entities.RoomRestriction
+}
+
+// NewRestrictions places a restriction of kind on each of userIds in room roomId
+func NewRestrictions(roomId uint, kind entities.RestrictionKind, userIds ...uuid.UUID) *Restrictions {
+	f := &Restrictions{}
+	for _, userId := range userIds {
+		f.Save(&entities.RoomRestriction{RoomId: roomId, UserId: userId, Kind: kind})
+	}
+	return f
+}
+
+// Find returns the stored restriction, or nil
+func (f *Restrictions) Find(roomId uint, userId uuid.UUID, kind entities.RestrictionKind) *entities.RoomRestriction {
+	for _, r := range f.Active {
+		if r.RoomId == roomId && r.UserId == userId && r.Kind == kind {
+			return r
+		}
+	}
+	return nil
+}
+
+// Save replaces any restriction of the same kind on the same member
+func (f *Restrictions) Save(restriction *entities.RoomRestriction) error {
+	f.Delete(restriction.RoomId, restriction.UserId, restriction.Kind)
+	restriction.ID = uint(len(f.Active) + 1)
+	f.Active = append(f.Active, restriction)
+	return nil
+}
+
+func (f *Restrictions) ExistsActive(roomId uint, userId uuid.UUID, kinds ...entities.RestrictionKind) (bool, error) {
+	for _, kind := range kinds {
+		if f.Find(roomId, userId, kind) != nil {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (f *Restrictions) FindAllActiveByRoomID(roomId uint) ([]*entities.RoomRestriction, error) {
+	var out []*entities.RoomRestriction
+	for _, r := range f.Active {
+		if r.RoomId == roomId {
+			out = append(out, r)
+		}
+	}
+	return out, nil
+}
+
+func (f *Restrictions) Delete(roomId uint, userId uuid.UUID, kind entities.RestrictionKind) error {
+	kept := f.Active[:0]
+	for _, r := range f.Active {
+		if r.RoomId != roomId || r.UserId != userId || r.Kind != kind {
+			kept = append(kept, r)
+		}
+	}
+	f.Active = kept
+	return nil
+}
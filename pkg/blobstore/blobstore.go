@@ -0,0 +1,21 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// ErrBlobNotFound is returned when a key does not exist in the store.
+var ErrBlobNotFound = errors.New("blob not found")
+
+// BlobStore persists binary objects (attachments, thumbnails) by key.
+// Keys are slash separated paths such as "rooms/12/<uuid>".
+type BlobStore interface {
+	// Put stores the content of r under key and returns the number of bytes written.
+	Put(ctx context.Context, key string, r io.Reader) (int64, error)
+	// Get opens the object stored under key. The caller must close the reader.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete removes the object stored under key. Deleting a missing key is not an error.
+	Delete(ctx context.Context, key string) error
+}
@@ -4,6 +4,7 @@ import (
 	"log"
 	"os"
 	"strconv"
+	"strings"
 
 	"github.com/joho/godotenv"
 )
@@ -21,6 +22,25 @@ type Config struct {
 	JWTSecret     string
 	JWTExpiration int // in seconds
 
+	AttachmentDir          string
+	AttachmentMaxSize      int64 // in bytes
+	AttachmentAllowedTypes []string
+	AttachmentURLTTL       int // in seconds
+	ThumbnailSizes         []int
+
+	LinkPreviewCacheTTL int // in seconds
+
+	MaxPinsPerRoom int // 0 disables the limit
+
+	SchedulerPollInterval int // in seconds
+	SchedulerLease        int // in seconds, how long a claimed message may stay in sending
+	MessageReaperInterval int // in seconds
+
+	InviteTTL            int // in seconds, 0 keeps invites until answered
+	InviteDenyCooldown   int // in seconds
+	InviteExpiryInterval int // in seconds
+
+	ProfileFile string // JSON list of user profiles, empty shows fallback names
 }
 
 func LoadConfig(env string) *Config {
@@ -46,6 +66,26 @@ func LoadConfig(env string) *Config {
 		MongoURI:      getEnv("MONGO_URI", "mongodb://localhost:27014"),
 		JWTSecret:     getEnv("JWT_SECRET", "changeme"),
 		JWTExpiration: jwtExp,
+
+		AttachmentDir:          getEnv("ATTACHMENT_DIR", "./uploads"),
+		AttachmentMaxSize:      int64(getEnvAsInt("ATTACHMENT_MAX_SIZE", 10<<20)),
+		AttachmentAllowedTypes: getEnvAsList("ATTACHMENT_ALLOWED_TYPES", "image/*,application/pdf,text/plain,application/zip"),
+		AttachmentURLTTL:       getEnvAsInt("ATTACHMENT_URL_TTL", 900),
+		ThumbnailSizes:         getEnvAsIntList("THUMBNAIL_SIZES", "64,256,1024"),
+
+		LinkPreviewCacheTTL: getEnvAsInt("LINK_PREVIEW_CACHE_TTL", 86400),
+
+		MaxPinsPerRoom: getEnvAsInt("MAX_PINS_PER_ROOM", 50),
+
+		SchedulerPollInterval: getEnvAsInt("SCHEDULER_POLL_INTERVAL", 5),
+		SchedulerLease:        getEnvAsInt("SCHEDULER_LEASE", 300),
+		MessageReaperInterval: getEnvAsInt("MESSAGE_REAPER_INTERVAL", 30),
+
+		InviteTTL:            getEnvAsInt("INVITE_TTL", 7*24*3600),
+		InviteDenyCooldown:   getEnvAsInt("INVITE_DENY_COOLDOWN", 24*3600),
+		InviteExpiryInterval: getEnvAsInt("INVITE_EXPIRY_INTERVAL", 60),
+
+		ProfileFile: getEnv("PROFILE_FILE", ""),
 	}
 
 	return cfg
@@ -66,3 +106,23 @@ func getEnvAsInt(key string, fallback int) int {
 	}
 	return fallback
 }
+
+func getEnvAsList(key, fallback string) []string {
+	var out []string
+	for _, item := range strings.Split(getEnv(key, fallback), ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			out = append(out, item)
+		}
+	}
+	return out
+}
+
+func getEnvAsIntList(key, fallback string) []int {
+	var out []int
+	for _, item := range getEnvAsList(key, fallback) {
+		if parsed, err := strconv.Atoi(item); err == nil && parsed > 0 {
+			out = append(out, parsed)
+		}
+	}
+	return out
+}
@@ -1,10 +1,12 @@
 package middleware
 
 import (
+	"errors"
 	"os"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 )
 
 func JWTMiddleware() fiber.Handler {
@@ -35,3 +37,12 @@ func JWTMiddleware() fiber.Handler {
 		return c.Next()
 	}
 }
+
+// UserID returns the authenticated user set by JWTMiddleware
+func UserID(c *fiber.Ctx) (uuid.UUID, error) {
+	raw, ok := c.Locals("user_id").(string)
+	if !ok {
+		return uuid.Nil, errors.New("missing user_id claim")
+	}
+	return uuid.Parse(raw)
+}
@@ -0,0 +1,18 @@
+package pagination
+
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
+// OffsetLimit turns a 1-based page into offset and limit. Pages before the
+// first are treated as the first, and out-of-range sizes fall back to the default.
+func OffsetLimit(page, pageSize int) (int, int) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > MaxPageSize {
+		pageSize = DefaultPageSize
+	}
+	return (page - 1) * pageSize, pageSize
+}
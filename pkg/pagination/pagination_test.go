@@ -0,0 +1,26 @@
+package pagination
+
+import "testing"
+
+func TestOffsetLimit(t *testing.T) {
+	tests := []struct {
+		name                  string
+		page, pageSize        int
+		wantOffset, wantLimit int
+	}{
+		{"first page", 1, 10, 0, 10},
+		{"later page", 3, 10, 20, 10},
+		{"page before the first", 0, 10, 0, 10},
+		{"size missing", 2, 0, 20, DefaultPageSize},
+		{"size too large", 1, MaxPageSize + 1, 0, DefaultPageSize},
+		{"largest size", 2, MaxPageSize, MaxPageSize, MaxPageSize},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			offset, limit := OffsetLimit(tt.page, tt.pageSize)
+			if offset != tt.wantOffset || limit != tt.wantLimit {
+				t.Fatalf("OffsetLimit(%d, %d) = %d, %d, want %d, %d", tt.page, tt.pageSize, offset, limit, tt.wantOffset, tt.wantLimit)
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package profile
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Profile is how a user is shown to others
+type Profile struct {
+	UserID      uuid.UUID `json:"user_id"`
+	DisplayName string    `json:"display_name"`
+	AvatarURL   string    `json:"avatar_url"`
+}
+
+// Provider resolves user profiles, typically from the user service that owns them.
+type Provider interface {
+	// FindProfiles returns the known profiles among ids. Unknown users are simply absent.
+	FindProfiles(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]Profile, error)
+}
+
+// Fallback is shown for users the provider does not know about.
+func Fallback(userId uuid.UUID) Profile {
+	return Profile{UserID: userId, DisplayName: "User " + userId.String()[:8]}
+}
@@ -0,0 +1,49 @@
+package profile
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+
+	"github.com/google/uuid"
+)
+
+// StaticProvider serves profiles from a fixed set loaded at startup.
+type StaticProvider struct {
+	profiles map[uuid.UUID]Profile
+}
+
+func NewStaticProvider(profiles []Profile) Provider {
+	m := make(map[uuid.UUID]Profile, len(profiles))
+	for _, p := range profiles {
+		m[p.UserID] = p
+	}
+	return &StaticProvider{profiles: m}
+}
+
+// LoadStaticProvider reads a JSON array of profiles from path.
+// An empty path gives a provider that knows nobody.
+func LoadStaticProvider(path string) (Provider, error) {
+	if path == "" {
+		return NewStaticProvider(nil), nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var profiles []Profile
+	if err := json.Unmarshal(data, &profiles); err != nil {
+		return nil, err
+	}
+	return NewStaticProvider(profiles), nil
+}
+
+func (p *StaticProvider) FindProfiles(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]Profile, error) {
+	out := make(map[uuid.UUID]Profile, len(ids))
+	for _, id := range ids {
+		if profile, ok := p.profiles[id]; ok {
+			out[id] = profile
+		}
+	}
+	return out, nil
+}
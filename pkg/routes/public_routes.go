@@ -10,12 +10,28 @@ import (
 	orderRepository "github.com/MingPV/ChatService/internal/order/repository"
 	orderUseCase "github.com/MingPV/ChatService/internal/order/usecase"
 
+	// Attachment
+	attachmentHandler "github.com/MingPV/ChatService/internal/attachment/handler/rest"
+	attachmentRepository "github.com/MingPV/ChatService/internal/attachment/repository"
+	attachmentUseCase "github.com/MingPV/ChatService/internal/attachment/usecase"
+	roommemberRepository "github.com/MingPV/ChatService/internal/room_member/repository"
+
+	chatroomRepository "github.com/MingPV/ChatService/internal/chatroom/repository"
+	friendRepository "github.com/MingPV/ChatService/internal/friend/repository"
+
+	// Message
+	messageHandler "github.com/MingPV/ChatService/internal/message/handler/rest"
+	messageRepository "github.com/MingPV/ChatService/internal/message/repository"
+	messageUseCase "github.com/MingPV/ChatService/internal/message/usecase"
+
 	// Message gateway over gRPC
 	messageGateway "github.com/MingPV/ChatService/internal/message/handler"
 	messagepb "github.com/MingPV/ChatService/proto/message"
 	"google.golang.org/grpc"
 
+	"github.com/MingPV/ChatService/pkg/blobstore"
 	"github.com/MingPV/ChatService/pkg/config"
+	"github.com/MingPV/ChatService/pkg/middleware"
 )
 
 func RegisterPublicRoutes(app fiber.Router, db *mongo.Database, cfg *config.Config) {
@@ -29,6 +45,19 @@ func RegisterPublicRoutes(app fiber.Router, db *mongo.Database, cfg *config.Conf
 	orderService := orderUseCase.NewOrderService(orderRepo)
 	orderHandler := orderHandler.NewHttpOrderHandler(orderService)
 
+	// Dependency wiring for Attachments
+	attachmentRepo := attachmentRepository.NewMongoAttachmentRepository(db)
+	roommemberRepo := roommemberRepository.NewMongoRoomMemberRepository(db)
+	attachmentService := attachmentUseCase.NewAttachmentService(attachmentRepo, roommemberRepo, blobstore.NewLocalBlobStore(cfg.AttachmentDir), attachmentUseCase.NewAttachmentPolicy(cfg))
+	attachmentHandler := attachmentHandler.NewHttpAttachmentHandler(attachmentService)
+
+	// Dependency wiring for Messages (search only; streaming goes through the gateway)
+	messageRepo := messageRepository.NewMongoMessageRepository(db)
+	chatroomRepo := chatroomRepository.NewMongoChatroomRepository(db)
+	blockRepo := friendRepository.NewMongoBlockRepository(db)
+	restrictionRepo := roommemberRepository.NewMongoRestrictionRepository(db)
+	messageService := messageUseCase.NewMessageService(messageRepo, attachmentRepo, roommemberRepo, chatroomRepo, blockRepo, restrictionRepo, messageUseCase.NewMessagePolicy(cfg))
+	messageHandler := messageHandler.NewHttpMessageHandler(messageService)
 
 	// WebSocket -> gRPC gateway client
 	grpcConn, _ := grpc.Dial("localhost:"+cfg.GrpcPort, grpc.WithInsecure())
@@ -45,6 +74,16 @@ func RegisterPublicRoutes(app fiber.Router, db *mongo.Database, cfg *config.Conf
 	orderGroup.Patch("/:id", orderHandler.PatchOrder)
 	orderGroup.Delete("/:id", orderHandler.DeleteOrder)
 
+	// Attachment routes act on behalf of the token's user
+	attachmentGroup := api.Group("/attachments", middleware.JWTMiddleware())
+	attachmentGroup.Post("/", attachmentHandler.UploadAttachment)
+	attachmentGroup.Get("/:id/url", attachmentHandler.GetDownloadURL)
+	attachmentGroup.Get("/:id/download", attachmentHandler.DownloadAttachment)
+
+	// Message routes search as the token's user
+	messageGroup := api.Group("/messages", middleware.JWTMiddleware())
+	messageGroup.Get("/search", messageHandler.SearchMessages)
+
 	// Message websocket routes
 	wsGroup := api.Group("/ws")
 	wsGroup.Use("/rooms/:roomId", wsGateway.UpgradeMiddleware)
@@ -0,0 +1,177 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v6.32.0
+// source: proto/bookmark/bookmark.proto
+
+package bookmark
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// BookmarkServiceClient is the client API for BookmarkService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BookmarkServiceClient interface {
+	SaveMessage(ctx context.Context, in *SaveMessageRequest, opts ...grpc.CallOption) (*SaveMessageResponse, error)
+	UnsaveMessage(ctx context.Context, in *UnsaveMessageRequest, opts ...grpc.CallOption) (*UnsaveMessageResponse, error)
+	FindAllBookmarks(ctx context.Context, in *FindAllBookmarksRequest, opts ...grpc.CallOption) (*FindAllBookmarksResponse, error)
+}
+
+type bookmarkServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBookmarkServiceClient(cc grpc.ClientConnInterface) BookmarkServiceClient {
+	return &bookmarkServiceClient{cc}
+}
+
+func (c *bookmarkServiceClient) SaveMessage(ctx context.Context, in *SaveMessageRequest, opts ...grpc.CallOption) (*SaveMessageResponse, error) {
+	out := new(SaveMessageResponse)
+	err := c.cc.Invoke(ctx, "/bookmark.BookmarkService/SaveMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookmarkServiceClient) UnsaveMessage(ctx context.Context, in *UnsaveMessageRequest, opts ...grpc.CallOption) (*UnsaveMessageResponse, error) {
+	out := new(UnsaveMessageResponse)
+	err := c.cc.Invoke(ctx, "/bookmark.BookmarkService/UnsaveMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookmarkServiceClient) FindAllBookmarks(ctx context.Context, in *FindAllBookmarksRequest, opts ...grpc.CallOption) (*FindAllBookmarksResponse, error) {
+	out := new(FindAllBookmarksResponse)
+	err := c.cc.Invoke(ctx, "/bookmark.BookmarkService/FindAllBookmarks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BookmarkServiceServer is the server API for BookmarkService service.
+// All implementations must embed UnimplementedBookmarkServiceServer
+// for forward compatibility
+type BookmarkServiceServer interface {
+	SaveMessage(context.Context, *SaveMessageRequest) (*SaveMessageResponse, error)
+	UnsaveMessage(context.Context, *UnsaveMessageRequest) (*UnsaveMessageResponse, error)
+	FindAllBookmarks(context.Context, *FindAllBookmarksRequest) (*FindAllBookmarksResponse, error)
+	mustEmbedUnimplementedBookmarkServiceServer()
+}
+
+// UnimplementedBookmarkServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedBookmarkServiceServer struct {
+}
+
+func (UnimplementedBookmarkServiceServer) SaveMessage(context.Context, *SaveMessageRequest) (*SaveMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveMessage not implemented")
+}
+func (UnimplementedBookmarkServiceServer) UnsaveMessage(context.Context, *UnsaveMessageRequest) (*UnsaveMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsaveMessage not implemented")
+}
+func (UnimplementedBookmarkServiceServer) FindAllBookmarks(context.Context, *FindAllBookmarksRequest) (*FindAllBookmarksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindAllBookmarks not implemented")
+}
+func (UnimplementedBookmarkServiceServer) mustEmbedUnimplementedBookmarkServiceServer() {}
+
+// UnsafeBookmarkServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BookmarkServiceServer will
+// result in compilation errors.
+type UnsafeBookmarkServiceServer interface {
+	mustEmbedUnimplementedBookmarkServiceServer()
+}
+
+func RegisterBookmarkServiceServer(s grpc.ServiceRegistrar, srv BookmarkServiceServer) {
+	s.RegisterService(&BookmarkService_ServiceDesc, srv)
+}
+
+func _BookmarkService_SaveMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookmarkServiceServer).SaveMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bookmark.BookmarkService/SaveMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookmarkServiceServer).SaveMessage(ctx, req.(*SaveMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookmarkService_UnsaveMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsaveMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookmarkServiceServer).UnsaveMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bookmark.BookmarkService/UnsaveMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookmarkServiceServer).UnsaveMessage(ctx, req.(*UnsaveMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookmarkService_FindAllBookmarks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindAllBookmarksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookmarkServiceServer).FindAllBookmarks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bookmark.BookmarkService/FindAllBookmarks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookmarkServiceServer).FindAllBookmarks(ctx, req.(*FindAllBookmarksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BookmarkService_ServiceDesc is the grpc.ServiceDesc for BookmarkService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BookmarkService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "bookmark.BookmarkService",
+	HandlerType: (*BookmarkServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SaveMessage",
+			Handler:    _BookmarkService_SaveMessage_Handler,
+		},
+		{
+			MethodName: "UnsaveMessage",
+			Handler:    _BookmarkService_UnsaveMessage_Handler,
+		},
+		{
+			MethodName: "FindAllBookmarks",
+			Handler:    _BookmarkService_FindAllBookmarks_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/bookmark/bookmark.proto",
+}
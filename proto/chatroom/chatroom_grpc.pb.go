@@ -26,6 +26,10 @@ type ChatroomServiceClient interface {
 	FindChatroomByID(ctx context.Context, in *FindChatroomByIDRequest, opts ...grpc.CallOption) (*FindChatroomByIDResponse, error)
 	PatchChatroom(ctx context.Context, in *PatchChatroomRequest, opts ...grpc.CallOption) (*PatchChatroomResponse, error)
 	DeleteChatroom(ctx context.Context, in *DeleteChatroomRequest, opts ...grpc.CallOption) (*DeleteChatroomResponse, error)
+	SetMessageTTL(ctx context.Context, in *SetMessageTTLRequest, opts ...grpc.CallOption) (*SetMessageTTLResponse, error)
+	UpdateChatroomSettings(ctx context.Context, in *UpdateChatroomSettingsRequest, opts ...grpc.CallOption) (*UpdateChatroomSettingsResponse, error)
+	FindPublicChatrooms(ctx context.Context, in *FindPublicChatroomsRequest, opts ...grpc.CallOption) (*FindPublicChatroomsResponse, error)
+	JoinChatroom(ctx context.Context, in *JoinChatroomRequest, opts ...grpc.CallOption) (*JoinChatroomResponse, error)
 }
 
 type chatroomServiceClient struct {
@@ -72,6 +76,42 @@ func (c *chatroomServiceClient) DeleteChatroom(ctx context.Context, in *DeleteCh
 	return out, nil
 }
 
+func (c *chatroomServiceClient) SetMessageTTL(ctx context.Context, in *SetMessageTTLRequest, opts ...grpc.CallOption) (*SetMessageTTLResponse, error) {
+	out := new(SetMessageTTLResponse)
+	err := c.cc.Invoke(ctx, "/chatroom.ChatroomService/SetMessageTTL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatroomServiceClient) UpdateChatroomSettings(ctx context.Context, in *UpdateChatroomSettingsRequest, opts ...grpc.CallOption) (*UpdateChatroomSettingsResponse, error) {
+	out := new(UpdateChatroomSettingsResponse)
+	err := c.cc.Invoke(ctx, "/chatroom.ChatroomService/UpdateChatroomSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatroomServiceClient) FindPublicChatrooms(ctx context.Context, in *FindPublicChatroomsRequest, opts ...grpc.CallOption) (*FindPublicChatroomsResponse, error) {
+	out := new(FindPublicChatroomsResponse)
+	err := c.cc.Invoke(ctx, "/chatroom.ChatroomService/FindPublicChatrooms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatroomServiceClient) JoinChatroom(ctx context.Context, in *JoinChatroomRequest, opts ...grpc.CallOption) (*JoinChatroomResponse, error) {
+	out := new(JoinChatroomResponse)
+	err := c.cc.Invoke(ctx, "/chatroom.ChatroomService/JoinChatroom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ChatroomServiceServer is the server API for ChatroomService service.
 // All implementations must embed UnimplementedChatroomServiceServer
 // for forward compatibility
@@ -80,6 +120,10 @@ type ChatroomServiceServer interface {
 	FindChatroomByID(context.Context, *FindChatroomByIDRequest) (*FindChatroomByIDResponse, error)
 	PatchChatroom(context.Context, *PatchChatroomRequest) (*PatchChatroomResponse, error)
 	DeleteChatroom(context.Context, *DeleteChatroomRequest) (*DeleteChatroomResponse, error)
+	SetMessageTTL(context.Context, *SetMessageTTLRequest) (*SetMessageTTLResponse, error)
+	UpdateChatroomSettings(context.Context, *UpdateChatroomSettingsRequest) (*UpdateChatroomSettingsResponse, error)
+	FindPublicChatrooms(context.Context, *FindPublicChatroomsRequest) (*FindPublicChatroomsResponse, error)
+	JoinChatroom(context.Context, *JoinChatroomRequest) (*JoinChatroomResponse, error)
 	mustEmbedUnimplementedChatroomServiceServer()
 }
 
@@ -99,6 +143,18 @@ func (UnimplementedChatroomServiceServer) PatchChatroom(context.Context, *PatchC
 func (UnimplementedChatroomServiceServer) DeleteChatroom(context.Context, *DeleteChatroomRequest) (*DeleteChatroomResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteChatroom not implemented")
 }
+func (UnimplementedChatroomServiceServer) SetMessageTTL(context.Context, *SetMessageTTLRequest) (*SetMessageTTLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMessageTTL not implemented")
+}
+func (UnimplementedChatroomServiceServer) UpdateChatroomSettings(context.Context, *UpdateChatroomSettingsRequest) (*UpdateChatroomSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChatroomSettings not implemented")
+}
+func (UnimplementedChatroomServiceServer) FindPublicChatrooms(context.Context, *FindPublicChatroomsRequest) (*FindPublicChatroomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindPublicChatrooms not implemented")
+}
+func (UnimplementedChatroomServiceServer) JoinChatroom(context.Context, *JoinChatroomRequest) (*JoinChatroomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinChatroom not implemented")
+}
 func (UnimplementedChatroomServiceServer) mustEmbedUnimplementedChatroomServiceServer() {}
 
 // UnsafeChatroomServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -184,6 +240,78 @@ func _ChatroomService_DeleteChatroom_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChatroomService_SetMessageTTL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMessageTTLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatroomServiceServer).SetMessageTTL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chatroom.ChatroomService/SetMessageTTL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatroomServiceServer).SetMessageTTL(ctx, req.(*SetMessageTTLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatroomService_UpdateChatroomSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateChatroomSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatroomServiceServer).UpdateChatroomSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chatroom.ChatroomService/UpdateChatroomSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatroomServiceServer).UpdateChatroomSettings(ctx, req.(*UpdateChatroomSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatroomService_FindPublicChatrooms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindPublicChatroomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatroomServiceServer).FindPublicChatrooms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chatroom.ChatroomService/FindPublicChatrooms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatroomServiceServer).FindPublicChatrooms(ctx, req.(*FindPublicChatroomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatroomService_JoinChatroom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinChatroomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatroomServiceServer).JoinChatroom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chatroom.ChatroomService/JoinChatroom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatroomServiceServer).JoinChatroom(ctx, req.(*JoinChatroomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ChatroomService_ServiceDesc is the grpc.ServiceDesc for ChatroomService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -207,6 +335,22 @@ var ChatroomService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteChatroom",
 			Handler:    _ChatroomService_DeleteChatroom_Handler,
 		},
+		{
+			MethodName: "SetMessageTTL",
+			Handler:    _ChatroomService_SetMessageTTL_Handler,
+		},
+		{
+			MethodName: "UpdateChatroomSettings",
+			Handler:    _ChatroomService_UpdateChatroomSettings_Handler,
+		},
+		{
+			MethodName: "FindPublicChatrooms",
+			Handler:    _ChatroomService_FindPublicChatrooms_Handler,
+		},
+		{
+			MethodName: "JoinChatroom",
+			Handler:    _ChatroomService_JoinChatroom_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/chatroom/chatroom.proto",
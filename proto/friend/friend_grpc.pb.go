@@ -30,6 +30,20 @@ type FriendServiceClient interface {
 	DeleteFriend(ctx context.Context, in *DeleteFriendRequest, opts ...grpc.CallOption) (*DeleteFriendResponse, error)
 	IsMyFriend(ctx context.Context, in *IsMyFriendRequest, opts ...grpc.CallOption) (*IsMyFriendResponse, error)
 	AcceptFriend(ctx context.Context, in *AcceptFriendRequest, opts ...grpc.CallOption) (*AcceptFriendResponse, error)
+	RejectFriend(ctx context.Context, in *RejectFriendRequest, opts ...grpc.CallOption) (*RejectFriendResponse, error)
+	CancelFriend(ctx context.Context, in *CancelFriendRequest, opts ...grpc.CallOption) (*CancelFriendResponse, error)
+	FindDirectRoom(ctx context.Context, in *FindDirectRoomRequest, opts ...grpc.CallOption) (*FindDirectRoomResponse, error)
+	FindFriendSuggestions(ctx context.Context, in *FindFriendSuggestionsRequest, opts ...grpc.CallOption) (*FindFriendSuggestionsResponse, error)
+	CreateFriendLabel(ctx context.Context, in *CreateFriendLabelRequest, opts ...grpc.CallOption) (*FriendLabelResponse, error)
+	FindFriendLabels(ctx context.Context, in *FindFriendLabelsRequest, opts ...grpc.CallOption) (*FindFriendLabelsResponse, error)
+	DeleteFriendLabel(ctx context.Context, in *DeleteFriendLabelRequest, opts ...grpc.CallOption) (*DeleteFriendLabelResponse, error)
+	AssignFriendLabel(ctx context.Context, in *FriendLabelMemberRequest, opts ...grpc.CallOption) (*FriendLabelResponse, error)
+	UnassignFriendLabel(ctx context.Context, in *FriendLabelMemberRequest, opts ...grpc.CallOption) (*FriendLabelResponse, error)
+	FindFriendsByLabel(ctx context.Context, in *FindFriendsByLabelRequest, opts ...grpc.CallOption) (*FindFriendsByLabelResponse, error)
+	CreateGroupFromLabel(ctx context.Context, in *CreateGroupFromLabelRequest, opts ...grpc.CallOption) (*CreateGroupFromLabelResponse, error)
+	BlockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*BlockUserResponse, error)
+	UnblockUser(ctx context.Context, in *UnblockUserRequest, opts ...grpc.CallOption) (*UnblockUserResponse, error)
+	FindBlockedUsers(ctx context.Context, in *FindBlockedUsersRequest, opts ...grpc.CallOption) (*FindBlockedUsersResponse, error)
 }
 
 type friendServiceClient struct {
@@ -112,6 +126,132 @@ func (c *friendServiceClient) AcceptFriend(ctx context.Context, in *AcceptFriend
 	return out, nil
 }
 
+func (c *friendServiceClient) RejectFriend(ctx context.Context, in *RejectFriendRequest, opts ...grpc.CallOption) (*RejectFriendResponse, error) {
+	out := new(RejectFriendResponse)
+	err := c.cc.Invoke(ctx, "/friend.FriendService/RejectFriend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendServiceClient) CancelFriend(ctx context.Context, in *CancelFriendRequest, opts ...grpc.CallOption) (*CancelFriendResponse, error) {
+	out := new(CancelFriendResponse)
+	err := c.cc.Invoke(ctx, "/friend.FriendService/CancelFriend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendServiceClient) FindDirectRoom(ctx context.Context, in *FindDirectRoomRequest, opts ...grpc.CallOption) (*FindDirectRoomResponse, error) {
+	out := new(FindDirectRoomResponse)
+	err := c.cc.Invoke(ctx, "/friend.FriendService/FindDirectRoom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendServiceClient) FindFriendSuggestions(ctx context.Context, in *FindFriendSuggestionsRequest, opts ...grpc.CallOption) (*FindFriendSuggestionsResponse, error) {
+	out := new(FindFriendSuggestionsResponse)
+	err := c.cc.Invoke(ctx, "/friend.FriendService/FindFriendSuggestions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendServiceClient) CreateFriendLabel(ctx context.Context, in *CreateFriendLabelRequest, opts ...grpc.CallOption) (*FriendLabelResponse, error) {
+	out := new(FriendLabelResponse)
+	err := c.cc.Invoke(ctx, "/friend.FriendService/CreateFriendLabel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendServiceClient) FindFriendLabels(ctx context.Context, in *FindFriendLabelsRequest, opts ...grpc.CallOption) (*FindFriendLabelsResponse, error) {
+	out := new(FindFriendLabelsResponse)
+	err := c.cc.Invoke(ctx, "/friend.FriendService/FindFriendLabels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendServiceClient) DeleteFriendLabel(ctx context.Context, in *DeleteFriendLabelRequest, opts ...grpc.CallOption) (*DeleteFriendLabelResponse, error) {
+	out := new(DeleteFriendLabelResponse)
+	err := c.cc.Invoke(ctx, "/friend.FriendService/DeleteFriendLabel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendServiceClient) AssignFriendLabel(ctx context.Context, in *FriendLabelMemberRequest, opts ...grpc.CallOption) (*FriendLabelResponse, error) {
+	out := new(FriendLabelResponse)
+	err := c.cc.Invoke(ctx, "/friend.FriendService/AssignFriendLabel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendServiceClient) UnassignFriendLabel(ctx context.Context, in *FriendLabelMemberRequest, opts ...grpc.CallOption) (*FriendLabelResponse, error) {
+	out := new(FriendLabelResponse)
+	err := c.cc.Invoke(ctx, "/friend.FriendService/UnassignFriendLabel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendServiceClient) FindFriendsByLabel(ctx context.Context, in *FindFriendsByLabelRequest, opts ...grpc.CallOption) (*FindFriendsByLabelResponse, error) {
+	out := new(FindFriendsByLabelResponse)
+	err := c.cc.Invoke(ctx, "/friend.FriendService/FindFriendsByLabel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendServiceClient) CreateGroupFromLabel(ctx context.Context, in *CreateGroupFromLabelRequest, opts ...grpc.CallOption) (*CreateGroupFromLabelResponse, error) {
+	out := new(CreateGroupFromLabelResponse)
+	err := c.cc.Invoke(ctx, "/friend.FriendService/CreateGroupFromLabel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendServiceClient) BlockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*BlockUserResponse, error) {
+	out := new(BlockUserResponse)
+	err := c.cc.Invoke(ctx, "/friend.FriendService/BlockUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendServiceClient) UnblockUser(ctx context.Context, in *UnblockUserRequest, opts ...grpc.CallOption) (*UnblockUserResponse, error) {
+	out := new(UnblockUserResponse)
+	err := c.cc.Invoke(ctx, "/friend.FriendService/UnblockUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendServiceClient) FindBlockedUsers(ctx context.Context, in *FindBlockedUsersRequest, opts ...grpc.CallOption) (*FindBlockedUsersResponse, error) {
+	out := new(FindBlockedUsersResponse)
+	err := c.cc.Invoke(ctx, "/friend.FriendService/FindBlockedUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FriendServiceServer is the server API for FriendService service.
 // All implementations must embed UnimplementedFriendServiceServer
 // for forward compatibility
@@ -124,6 +264,20 @@ type FriendServiceServer interface {
 	DeleteFriend(context.Context, *DeleteFriendRequest) (*DeleteFriendResponse, error)
 	IsMyFriend(context.Context, *IsMyFriendRequest) (*IsMyFriendResponse, error)
 	AcceptFriend(context.Context, *AcceptFriendRequest) (*AcceptFriendResponse, error)
+	RejectFriend(context.Context, *RejectFriendRequest) (*RejectFriendResponse, error)
+	CancelFriend(context.Context, *CancelFriendRequest) (*CancelFriendResponse, error)
+	FindDirectRoom(context.Context, *FindDirectRoomRequest) (*FindDirectRoomResponse, error)
+	FindFriendSuggestions(context.Context, *FindFriendSuggestionsRequest) (*FindFriendSuggestionsResponse, error)
+	CreateFriendLabel(context.Context, *CreateFriendLabelRequest) (*FriendLabelResponse, error)
+	FindFriendLabels(context.Context, *FindFriendLabelsRequest) (*FindFriendLabelsResponse, error)
+	DeleteFriendLabel(context.Context, *DeleteFriendLabelRequest) (*DeleteFriendLabelResponse, error)
+	AssignFriendLabel(context.Context, *FriendLabelMemberRequest) (*FriendLabelResponse, error)
+	UnassignFriendLabel(context.Context, *FriendLabelMemberRequest) (*FriendLabelResponse, error)
+	FindFriendsByLabel(context.Context, *FindFriendsByLabelRequest) (*FindFriendsByLabelResponse, error)
+	CreateGroupFromLabel(context.Context, *CreateGroupFromLabelRequest) (*CreateGroupFromLabelResponse, error)
+	BlockUser(context.Context, *BlockUserRequest) (*BlockUserResponse, error)
+	UnblockUser(context.Context, *UnblockUserRequest) (*UnblockUserResponse, error)
+	FindBlockedUsers(context.Context, *FindBlockedUsersRequest) (*FindBlockedUsersResponse, error)
 	mustEmbedUnimplementedFriendServiceServer()
 }
 
@@ -155,6 +309,48 @@ func (UnimplementedFriendServiceServer) IsMyFriend(context.Context, *IsMyFriendR
 func (UnimplementedFriendServiceServer) AcceptFriend(context.Context, *AcceptFriendRequest) (*AcceptFriendResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AcceptFriend not implemented")
 }
+func (UnimplementedFriendServiceServer) RejectFriend(context.Context, *RejectFriendRequest) (*RejectFriendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectFriend not implemented")
+}
+func (UnimplementedFriendServiceServer) CancelFriend(context.Context, *CancelFriendRequest) (*CancelFriendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelFriend not implemented")
+}
+func (UnimplementedFriendServiceServer) FindDirectRoom(context.Context, *FindDirectRoomRequest) (*FindDirectRoomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindDirectRoom not implemented")
+}
+func (UnimplementedFriendServiceServer) FindFriendSuggestions(context.Context, *FindFriendSuggestionsRequest) (*FindFriendSuggestionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindFriendSuggestions not implemented")
+}
+func (UnimplementedFriendServiceServer) CreateFriendLabel(context.Context, *CreateFriendLabelRequest) (*FriendLabelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFriendLabel not implemented")
+}
+func (UnimplementedFriendServiceServer) FindFriendLabels(context.Context, *FindFriendLabelsRequest) (*FindFriendLabelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindFriendLabels not implemented")
+}
+func (UnimplementedFriendServiceServer) DeleteFriendLabel(context.Context, *DeleteFriendLabelRequest) (*DeleteFriendLabelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFriendLabel not implemented")
+}
+func (UnimplementedFriendServiceServer) AssignFriendLabel(context.Context, *FriendLabelMemberRequest) (*FriendLabelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignFriendLabel not implemented")
+}
+func (UnimplementedFriendServiceServer) UnassignFriendLabel(context.Context, *FriendLabelMemberRequest) (*FriendLabelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnassignFriendLabel not implemented")
+}
+func (UnimplementedFriendServiceServer) FindFriendsByLabel(context.Context, *FindFriendsByLabelRequest) (*FindFriendsByLabelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindFriendsByLabel not implemented")
+}
+func (UnimplementedFriendServiceServer) CreateGroupFromLabel(context.Context, *CreateGroupFromLabelRequest) (*CreateGroupFromLabelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroupFromLabel not implemented")
+}
+func (UnimplementedFriendServiceServer) BlockUser(context.Context, *BlockUserRequest) (*BlockUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockUser not implemented")
+}
+func (UnimplementedFriendServiceServer) UnblockUser(context.Context, *UnblockUserRequest) (*UnblockUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockUser not implemented")
+}
+func (UnimplementedFriendServiceServer) FindBlockedUsers(context.Context, *FindBlockedUsersRequest) (*FindBlockedUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindBlockedUsers not implemented")
+}
 func (UnimplementedFriendServiceServer) mustEmbedUnimplementedFriendServiceServer() {}
 
 // UnsafeFriendServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -312,6 +508,258 @@ func _FriendService_AcceptFriend_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FriendService_RejectFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectFriendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServiceServer).RejectFriend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/friend.FriendService/RejectFriend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServiceServer).RejectFriend(ctx, req.(*RejectFriendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendService_CancelFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelFriendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServiceServer).CancelFriend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/friend.FriendService/CancelFriend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServiceServer).CancelFriend(ctx, req.(*CancelFriendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendService_FindDirectRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindDirectRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServiceServer).FindDirectRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/friend.FriendService/FindDirectRoom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServiceServer).FindDirectRoom(ctx, req.(*FindDirectRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendService_FindFriendSuggestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindFriendSuggestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServiceServer).FindFriendSuggestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/friend.FriendService/FindFriendSuggestions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServiceServer).FindFriendSuggestions(ctx, req.(*FindFriendSuggestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendService_CreateFriendLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFriendLabelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServiceServer).CreateFriendLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/friend.FriendService/CreateFriendLabel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServiceServer).CreateFriendLabel(ctx, req.(*CreateFriendLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendService_FindFriendLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindFriendLabelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServiceServer).FindFriendLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/friend.FriendService/FindFriendLabels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServiceServer).FindFriendLabels(ctx, req.(*FindFriendLabelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendService_DeleteFriendLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFriendLabelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServiceServer).DeleteFriendLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/friend.FriendService/DeleteFriendLabel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServiceServer).DeleteFriendLabel(ctx, req.(*DeleteFriendLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendService_AssignFriendLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FriendLabelMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServiceServer).AssignFriendLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/friend.FriendService/AssignFriendLabel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServiceServer).AssignFriendLabel(ctx, req.(*FriendLabelMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendService_UnassignFriendLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FriendLabelMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServiceServer).UnassignFriendLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/friend.FriendService/UnassignFriendLabel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServiceServer).UnassignFriendLabel(ctx, req.(*FriendLabelMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendService_FindFriendsByLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindFriendsByLabelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServiceServer).FindFriendsByLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/friend.FriendService/FindFriendsByLabel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServiceServer).FindFriendsByLabel(ctx, req.(*FindFriendsByLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendService_CreateGroupFromLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupFromLabelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServiceServer).CreateGroupFromLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/friend.FriendService/CreateGroupFromLabel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServiceServer).CreateGroupFromLabel(ctx, req.(*CreateGroupFromLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendService_BlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServiceServer).BlockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/friend.FriendService/BlockUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServiceServer).BlockUser(ctx, req.(*BlockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendService_UnblockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServiceServer).UnblockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/friend.FriendService/UnblockUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServiceServer).UnblockUser(ctx, req.(*UnblockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendService_FindBlockedUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindBlockedUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServiceServer).FindBlockedUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/friend.FriendService/FindBlockedUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServiceServer).FindBlockedUsers(ctx, req.(*FindBlockedUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FriendService_ServiceDesc is the grpc.ServiceDesc for FriendService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -351,6 +799,62 @@ var FriendService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AcceptFriend",
 			Handler:    _FriendService_AcceptFriend_Handler,
 		},
+		{
+			MethodName: "RejectFriend",
+			Handler:    _FriendService_RejectFriend_Handler,
+		},
+		{
+			MethodName: "CancelFriend",
+			Handler:    _FriendService_CancelFriend_Handler,
+		},
+		{
+			MethodName: "FindDirectRoom",
+			Handler:    _FriendService_FindDirectRoom_Handler,
+		},
+		{
+			MethodName: "FindFriendSuggestions",
+			Handler:    _FriendService_FindFriendSuggestions_Handler,
+		},
+		{
+			MethodName: "CreateFriendLabel",
+			Handler:    _FriendService_CreateFriendLabel_Handler,
+		},
+		{
+			MethodName: "FindFriendLabels",
+			Handler:    _FriendService_FindFriendLabels_Handler,
+		},
+		{
+			MethodName: "DeleteFriendLabel",
+			Handler:    _FriendService_DeleteFriendLabel_Handler,
+		},
+		{
+			MethodName: "AssignFriendLabel",
+			Handler:    _FriendService_AssignFriendLabel_Handler,
+		},
+		{
+			MethodName: "UnassignFriendLabel",
+			Handler:    _FriendService_UnassignFriendLabel_Handler,
+		},
+		{
+			MethodName: "FindFriendsByLabel",
+			Handler:    _FriendService_FindFriendsByLabel_Handler,
+		},
+		{
+			MethodName: "CreateGroupFromLabel",
+			Handler:    _FriendService_CreateGroupFromLabel_Handler,
+		},
+		{
+			MethodName: "BlockUser",
+			Handler:    _FriendService_BlockUser_Handler,
+		},
+		{
+			MethodName: "UnblockUser",
+			Handler:    _FriendService_UnblockUser_Handler,
+		},
+		{
+			MethodName: "FindBlockedUsers",
+			Handler:    _FriendService_FindBlockedUsers_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/friend/friend.proto",
@@ -26,6 +26,12 @@ type MessageServiceClient interface {
 	FindAllMessageByRoomID(ctx context.Context, in *FindAllMessageByRoomIDRequest, opts ...grpc.CallOption) (*FindAllMessageByRoomIDResponse, error)
 	FindLatestMessageByRoomId(ctx context.Context, in *FindLatestMessageByRoomIdRequest, opts ...grpc.CallOption) (*FindLastestMessageByRoomIdResponse, error)
 	FindAllMessageUnread(ctx context.Context, in *FindAllMessageUnreadRequest, opts ...grpc.CallOption) (*FindAllMessageUnreadResponse, error)
+	FindAllMentions(ctx context.Context, in *FindAllMentionsRequest, opts ...grpc.CallOption) (*FindAllMentionsResponse, error)
+	FindUnreadCounts(ctx context.Context, in *FindUnreadCountsRequest, opts ...grpc.CallOption) (*FindUnreadCountsResponse, error)
+	SearchMessages(ctx context.Context, in *SearchMessagesRequest, opts ...grpc.CallOption) (*SearchMessagesResponse, error)
+	PinMessage(ctx context.Context, in *PinMessageRequest, opts ...grpc.CallOption) (*PinMessageResponse, error)
+	UnpinMessage(ctx context.Context, in *UnpinMessageRequest, opts ...grpc.CallOption) (*UnpinMessageResponse, error)
+	FindPinnedMessages(ctx context.Context, in *FindPinnedMessagesRequest, opts ...grpc.CallOption) (*FindPinnedMessagesResponse, error)
 }
 
 type messageServiceClient struct {
@@ -94,6 +100,60 @@ func (c *messageServiceClient) FindAllMessageUnread(ctx context.Context, in *Fin
 	return out, nil
 }
 
+func (c *messageServiceClient) FindAllMentions(ctx context.Context, in *FindAllMentionsRequest, opts ...grpc.CallOption) (*FindAllMentionsResponse, error) {
+	out := new(FindAllMentionsResponse)
+	err := c.cc.Invoke(ctx, "/message.MessageService/FindAllMentions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) FindUnreadCounts(ctx context.Context, in *FindUnreadCountsRequest, opts ...grpc.CallOption) (*FindUnreadCountsResponse, error) {
+	out := new(FindUnreadCountsResponse)
+	err := c.cc.Invoke(ctx, "/message.MessageService/FindUnreadCounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) SearchMessages(ctx context.Context, in *SearchMessagesRequest, opts ...grpc.CallOption) (*SearchMessagesResponse, error) {
+	out := new(SearchMessagesResponse)
+	err := c.cc.Invoke(ctx, "/message.MessageService/SearchMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) PinMessage(ctx context.Context, in *PinMessageRequest, opts ...grpc.CallOption) (*PinMessageResponse, error) {
+	out := new(PinMessageResponse)
+	err := c.cc.Invoke(ctx, "/message.MessageService/PinMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) UnpinMessage(ctx context.Context, in *UnpinMessageRequest, opts ...grpc.CallOption) (*UnpinMessageResponse, error) {
+	out := new(UnpinMessageResponse)
+	err := c.cc.Invoke(ctx, "/message.MessageService/UnpinMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) FindPinnedMessages(ctx context.Context, in *FindPinnedMessagesRequest, opts ...grpc.CallOption) (*FindPinnedMessagesResponse, error) {
+	out := new(FindPinnedMessagesResponse)
+	err := c.cc.Invoke(ctx, "/message.MessageService/FindPinnedMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MessageServiceServer is the server API for MessageService service.
 // All implementations must embed UnimplementedMessageServiceServer
 // for forward compatibility
@@ -102,6 +162,12 @@ type MessageServiceServer interface {
 	FindAllMessageByRoomID(context.Context, *FindAllMessageByRoomIDRequest) (*FindAllMessageByRoomIDResponse, error)
 	FindLatestMessageByRoomId(context.Context, *FindLatestMessageByRoomIdRequest) (*FindLastestMessageByRoomIdResponse, error)
 	FindAllMessageUnread(context.Context, *FindAllMessageUnreadRequest) (*FindAllMessageUnreadResponse, error)
+	FindAllMentions(context.Context, *FindAllMentionsRequest) (*FindAllMentionsResponse, error)
+	FindUnreadCounts(context.Context, *FindUnreadCountsRequest) (*FindUnreadCountsResponse, error)
+	SearchMessages(context.Context, *SearchMessagesRequest) (*SearchMessagesResponse, error)
+	PinMessage(context.Context, *PinMessageRequest) (*PinMessageResponse, error)
+	UnpinMessage(context.Context, *UnpinMessageRequest) (*UnpinMessageResponse, error)
+	FindPinnedMessages(context.Context, *FindPinnedMessagesRequest) (*FindPinnedMessagesResponse, error)
 	mustEmbedUnimplementedMessageServiceServer()
 }
 
@@ -121,6 +187,24 @@ func (UnimplementedMessageServiceServer) FindLatestMessageByRoomId(context.Conte
 func (UnimplementedMessageServiceServer) FindAllMessageUnread(context.Context, *FindAllMessageUnreadRequest) (*FindAllMessageUnreadResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindAllMessageUnread not implemented")
 }
+func (UnimplementedMessageServiceServer) FindAllMentions(context.Context, *FindAllMentionsRequest) (*FindAllMentionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindAllMentions not implemented")
+}
+func (UnimplementedMessageServiceServer) FindUnreadCounts(context.Context, *FindUnreadCountsRequest) (*FindUnreadCountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindUnreadCounts not implemented")
+}
+func (UnimplementedMessageServiceServer) SearchMessages(context.Context, *SearchMessagesRequest) (*SearchMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchMessages not implemented")
+}
+func (UnimplementedMessageServiceServer) PinMessage(context.Context, *PinMessageRequest) (*PinMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinMessage not implemented")
+}
+func (UnimplementedMessageServiceServer) UnpinMessage(context.Context, *UnpinMessageRequest) (*UnpinMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpinMessage not implemented")
+}
+func (UnimplementedMessageServiceServer) FindPinnedMessages(context.Context, *FindPinnedMessagesRequest) (*FindPinnedMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindPinnedMessages not implemented")
+}
 func (UnimplementedMessageServiceServer) mustEmbedUnimplementedMessageServiceServer() {}
 
 // UnsafeMessageServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -214,6 +298,114 @@ func _MessageService_FindAllMessageUnread_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MessageService_FindAllMentions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindAllMentionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).FindAllMentions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.MessageService/FindAllMentions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).FindAllMentions(ctx, req.(*FindAllMentionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_FindUnreadCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindUnreadCountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).FindUnreadCounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.MessageService/FindUnreadCounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).FindUnreadCounts(ctx, req.(*FindUnreadCountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_SearchMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).SearchMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.MessageService/SearchMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).SearchMessages(ctx, req.(*SearchMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_PinMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).PinMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.MessageService/PinMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).PinMessage(ctx, req.(*PinMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_UnpinMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpinMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).UnpinMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.MessageService/UnpinMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).UnpinMessage(ctx, req.(*UnpinMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_FindPinnedMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindPinnedMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).FindPinnedMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.MessageService/FindPinnedMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).FindPinnedMessages(ctx, req.(*FindPinnedMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MessageService_ServiceDesc is the grpc.ServiceDesc for MessageService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -233,6 +425,30 @@ var MessageService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FindAllMessageUnread",
 			Handler:    _MessageService_FindAllMessageUnread_Handler,
 		},
+		{
+			MethodName: "FindAllMentions",
+			Handler:    _MessageService_FindAllMentions_Handler,
+		},
+		{
+			MethodName: "FindUnreadCounts",
+			Handler:    _MessageService_FindUnreadCounts_Handler,
+		},
+		{
+			MethodName: "SearchMessages",
+			Handler:    _MessageService_SearchMessages_Handler,
+		},
+		{
+			MethodName: "PinMessage",
+			Handler:    _MessageService_PinMessage_Handler,
+		},
+		{
+			MethodName: "UnpinMessage",
+			Handler:    _MessageService_UnpinMessage_Handler,
+		},
+		{
+			MethodName: "FindPinnedMessages",
+			Handler:    _MessageService_FindPinnedMessages_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -30,6 +30,18 @@ type RoomInviteServiceClient interface {
 	PatchRoomInvite(ctx context.Context, in *PatchRoomInviteRequest, opts ...grpc.CallOption) (*PatchRoomInviteResponse, error)
 	DeleteRoomInvite(ctx context.Context, in *DeleteRoomInviteRequest, opts ...grpc.CallOption) (*DeleteRoomInviteResponse, error)
 	AcceptedRoomInvite(ctx context.Context, in *AcceptedRoomInviteRequest, opts ...grpc.CallOption) (*AcceptedRoomInviteResponse, error)
+	DenyRoomInvite(ctx context.Context, in *DenyRoomInviteRequest, opts ...grpc.CallOption) (*DenyRoomInviteResponse, error)
+	RevokeRoomInvite(ctx context.Context, in *RevokeRoomInviteRequest, opts ...grpc.CallOption) (*RevokeRoomInviteResponse, error)
+	CreateInviteLink(ctx context.Context, in *CreateInviteLinkRequest, opts ...grpc.CallOption) (*CreateInviteLinkResponse, error)
+	RedeemInviteLink(ctx context.Context, in *RedeemInviteLinkRequest, opts ...grpc.CallOption) (*RedeemInviteLinkResponse, error)
+	FindActiveInviteLinks(ctx context.Context, in *FindActiveInviteLinksRequest, opts ...grpc.CallOption) (*FindActiveInviteLinksResponse, error)
+	RevokeInviteLink(ctx context.Context, in *RevokeInviteLinkRequest, opts ...grpc.CallOption) (*RevokeInviteLinkResponse, error)
+	FindInviteLinkRedemptions(ctx context.Context, in *FindInviteLinkRedemptionsRequest, opts ...grpc.CallOption) (*FindInviteLinkRedemptionsResponse, error)
+	RequestToJoin(ctx context.Context, in *RequestToJoinRequest, opts ...grpc.CallOption) (*RequestToJoinResponse, error)
+	FindPendingJoinRequests(ctx context.Context, in *FindPendingJoinRequestsRequest, opts ...grpc.CallOption) (*FindPendingJoinRequestsResponse, error)
+	ApproveJoinRequest(ctx context.Context, in *DecideJoinRequestRequest, opts ...grpc.CallOption) (*DecideJoinRequestResponse, error)
+	RejectJoinRequest(ctx context.Context, in *DecideJoinRequestRequest, opts ...grpc.CallOption) (*DecideJoinRequestResponse, error)
+	CancelJoinRequest(ctx context.Context, in *DecideJoinRequestRequest, opts ...grpc.CallOption) (*DecideJoinRequestResponse, error)
 }
 
 type roomInviteServiceClient struct {
@@ -112,6 +124,114 @@ func (c *roomInviteServiceClient) AcceptedRoomInvite(ctx context.Context, in *Ac
 	return out, nil
 }
 
+func (c *roomInviteServiceClient) DenyRoomInvite(ctx context.Context, in *DenyRoomInviteRequest, opts ...grpc.CallOption) (*DenyRoomInviteResponse, error) {
+	out := new(DenyRoomInviteResponse)
+	err := c.cc.Invoke(ctx, "/roominvite.RoomInviteService/DenyRoomInvite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomInviteServiceClient) RevokeRoomInvite(ctx context.Context, in *RevokeRoomInviteRequest, opts ...grpc.CallOption) (*RevokeRoomInviteResponse, error) {
+	out := new(RevokeRoomInviteResponse)
+	err := c.cc.Invoke(ctx, "/roominvite.RoomInviteService/RevokeRoomInvite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomInviteServiceClient) CreateInviteLink(ctx context.Context, in *CreateInviteLinkRequest, opts ...grpc.CallOption) (*CreateInviteLinkResponse, error) {
+	out := new(CreateInviteLinkResponse)
+	err := c.cc.Invoke(ctx, "/roominvite.RoomInviteService/CreateInviteLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomInviteServiceClient) RedeemInviteLink(ctx context.Context, in *RedeemInviteLinkRequest, opts ...grpc.CallOption) (*RedeemInviteLinkResponse, error) {
+	out := new(RedeemInviteLinkResponse)
+	err := c.cc.Invoke(ctx, "/roominvite.RoomInviteService/RedeemInviteLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomInviteServiceClient) FindActiveInviteLinks(ctx context.Context, in *FindActiveInviteLinksRequest, opts ...grpc.CallOption) (*FindActiveInviteLinksResponse, error) {
+	out := new(FindActiveInviteLinksResponse)
+	err := c.cc.Invoke(ctx, "/roominvite.RoomInviteService/FindActiveInviteLinks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomInviteServiceClient) RevokeInviteLink(ctx context.Context, in *RevokeInviteLinkRequest, opts ...grpc.CallOption) (*RevokeInviteLinkResponse, error) {
+	out := new(RevokeInviteLinkResponse)
+	err := c.cc.Invoke(ctx, "/roominvite.RoomInviteService/RevokeInviteLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomInviteServiceClient) FindInviteLinkRedemptions(ctx context.Context, in *FindInviteLinkRedemptionsRequest, opts ...grpc.CallOption) (*FindInviteLinkRedemptionsResponse, error) {
+	out := new(FindInviteLinkRedemptionsResponse)
+	err := c.cc.Invoke(ctx, "/roominvite.RoomInviteService/FindInviteLinkRedemptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomInviteServiceClient) RequestToJoin(ctx context.Context, in *RequestToJoinRequest, opts ...grpc.CallOption) (*RequestToJoinResponse, error) {
+	out := new(RequestToJoinResponse)
+	err := c.cc.Invoke(ctx, "/roominvite.RoomInviteService/RequestToJoin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomInviteServiceClient) FindPendingJoinRequests(ctx context.Context, in *FindPendingJoinRequestsRequest, opts ...grpc.CallOption) (*FindPendingJoinRequestsResponse, error) {
+	out := new(FindPendingJoinRequestsResponse)
+	err := c.cc.Invoke(ctx, "/roominvite.RoomInviteService/FindPendingJoinRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomInviteServiceClient) ApproveJoinRequest(ctx context.Context, in *DecideJoinRequestRequest, opts ...grpc.CallOption) (*DecideJoinRequestResponse, error) {
+	out := new(DecideJoinRequestResponse)
+	err := c.cc.Invoke(ctx, "/roominvite.RoomInviteService/ApproveJoinRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomInviteServiceClient) RejectJoinRequest(ctx context.Context, in *DecideJoinRequestRequest, opts ...grpc.CallOption) (*DecideJoinRequestResponse, error) {
+	out := new(DecideJoinRequestResponse)
+	err := c.cc.Invoke(ctx, "/roominvite.RoomInviteService/RejectJoinRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomInviteServiceClient) CancelJoinRequest(ctx context.Context, in *DecideJoinRequestRequest, opts ...grpc.CallOption) (*DecideJoinRequestResponse, error) {
+	out := new(DecideJoinRequestResponse)
+	err := c.cc.Invoke(ctx, "/roominvite.RoomInviteService/CancelJoinRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RoomInviteServiceServer is the server API for RoomInviteService service.
 // All implementations must embed UnimplementedRoomInviteServiceServer
 // for forward compatibility
@@ -124,6 +244,18 @@ type RoomInviteServiceServer interface {
 	PatchRoomInvite(context.Context, *PatchRoomInviteRequest) (*PatchRoomInviteResponse, error)
 	DeleteRoomInvite(context.Context, *DeleteRoomInviteRequest) (*DeleteRoomInviteResponse, error)
 	AcceptedRoomInvite(context.Context, *AcceptedRoomInviteRequest) (*AcceptedRoomInviteResponse, error)
+	DenyRoomInvite(context.Context, *DenyRoomInviteRequest) (*DenyRoomInviteResponse, error)
+	RevokeRoomInvite(context.Context, *RevokeRoomInviteRequest) (*RevokeRoomInviteResponse, error)
+	CreateInviteLink(context.Context, *CreateInviteLinkRequest) (*CreateInviteLinkResponse, error)
+	RedeemInviteLink(context.Context, *RedeemInviteLinkRequest) (*RedeemInviteLinkResponse, error)
+	FindActiveInviteLinks(context.Context, *FindActiveInviteLinksRequest) (*FindActiveInviteLinksResponse, error)
+	RevokeInviteLink(context.Context, *RevokeInviteLinkRequest) (*RevokeInviteLinkResponse, error)
+	FindInviteLinkRedemptions(context.Context, *FindInviteLinkRedemptionsRequest) (*FindInviteLinkRedemptionsResponse, error)
+	RequestToJoin(context.Context, *RequestToJoinRequest) (*RequestToJoinResponse, error)
+	FindPendingJoinRequests(context.Context, *FindPendingJoinRequestsRequest) (*FindPendingJoinRequestsResponse, error)
+	ApproveJoinRequest(context.Context, *DecideJoinRequestRequest) (*DecideJoinRequestResponse, error)
+	RejectJoinRequest(context.Context, *DecideJoinRequestRequest) (*DecideJoinRequestResponse, error)
+	CancelJoinRequest(context.Context, *DecideJoinRequestRequest) (*DecideJoinRequestResponse, error)
 	mustEmbedUnimplementedRoomInviteServiceServer()
 }
 
@@ -155,6 +287,42 @@ func (UnimplementedRoomInviteServiceServer) DeleteRoomInvite(context.Context, *D
 func (UnimplementedRoomInviteServiceServer) AcceptedRoomInvite(context.Context, *AcceptedRoomInviteRequest) (*AcceptedRoomInviteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AcceptedRoomInvite not implemented")
 }
+func (UnimplementedRoomInviteServiceServer) DenyRoomInvite(context.Context, *DenyRoomInviteRequest) (*DenyRoomInviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenyRoomInvite not implemented")
+}
+func (UnimplementedRoomInviteServiceServer) RevokeRoomInvite(context.Context, *RevokeRoomInviteRequest) (*RevokeRoomInviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeRoomInvite not implemented")
+}
+func (UnimplementedRoomInviteServiceServer) CreateInviteLink(context.Context, *CreateInviteLinkRequest) (*CreateInviteLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInviteLink not implemented")
+}
+func (UnimplementedRoomInviteServiceServer) RedeemInviteLink(context.Context, *RedeemInviteLinkRequest) (*RedeemInviteLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemInviteLink not implemented")
+}
+func (UnimplementedRoomInviteServiceServer) FindActiveInviteLinks(context.Context, *FindActiveInviteLinksRequest) (*FindActiveInviteLinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindActiveInviteLinks not implemented")
+}
+func (UnimplementedRoomInviteServiceServer) RevokeInviteLink(context.Context, *RevokeInviteLinkRequest) (*RevokeInviteLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeInviteLink not implemented")
+}
+func (UnimplementedRoomInviteServiceServer) FindInviteLinkRedemptions(context.Context, *FindInviteLinkRedemptionsRequest) (*FindInviteLinkRedemptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindInviteLinkRedemptions not implemented")
+}
+func (UnimplementedRoomInviteServiceServer) RequestToJoin(context.Context, *RequestToJoinRequest) (*RequestToJoinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestToJoin not implemented")
+}
+func (UnimplementedRoomInviteServiceServer) FindPendingJoinRequests(context.Context, *FindPendingJoinRequestsRequest) (*FindPendingJoinRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindPendingJoinRequests not implemented")
+}
+func (UnimplementedRoomInviteServiceServer) ApproveJoinRequest(context.Context, *DecideJoinRequestRequest) (*DecideJoinRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveJoinRequest not implemented")
+}
+func (UnimplementedRoomInviteServiceServer) RejectJoinRequest(context.Context, *DecideJoinRequestRequest) (*DecideJoinRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectJoinRequest not implemented")
+}
+func (UnimplementedRoomInviteServiceServer) CancelJoinRequest(context.Context, *DecideJoinRequestRequest) (*DecideJoinRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelJoinRequest not implemented")
+}
 func (UnimplementedRoomInviteServiceServer) mustEmbedUnimplementedRoomInviteServiceServer() {}
 
 // UnsafeRoomInviteServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -312,6 +480,222 @@ func _RoomInviteService_AcceptedRoomInvite_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RoomInviteService_DenyRoomInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DenyRoomInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomInviteServiceServer).DenyRoomInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/roominvite.RoomInviteService/DenyRoomInvite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomInviteServiceServer).DenyRoomInvite(ctx, req.(*DenyRoomInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomInviteService_RevokeRoomInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeRoomInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomInviteServiceServer).RevokeRoomInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/roominvite.RoomInviteService/RevokeRoomInvite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomInviteServiceServer).RevokeRoomInvite(ctx, req.(*RevokeRoomInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomInviteService_CreateInviteLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInviteLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomInviteServiceServer).CreateInviteLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/roominvite.RoomInviteService/CreateInviteLink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomInviteServiceServer).CreateInviteLink(ctx, req.(*CreateInviteLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomInviteService_RedeemInviteLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeemInviteLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomInviteServiceServer).RedeemInviteLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/roominvite.RoomInviteService/RedeemInviteLink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomInviteServiceServer).RedeemInviteLink(ctx, req.(*RedeemInviteLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomInviteService_FindActiveInviteLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindActiveInviteLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomInviteServiceServer).FindActiveInviteLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/roominvite.RoomInviteService/FindActiveInviteLinks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomInviteServiceServer).FindActiveInviteLinks(ctx, req.(*FindActiveInviteLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomInviteService_RevokeInviteLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeInviteLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomInviteServiceServer).RevokeInviteLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/roominvite.RoomInviteService/RevokeInviteLink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomInviteServiceServer).RevokeInviteLink(ctx, req.(*RevokeInviteLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomInviteService_FindInviteLinkRedemptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindInviteLinkRedemptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomInviteServiceServer).FindInviteLinkRedemptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/roominvite.RoomInviteService/FindInviteLinkRedemptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomInviteServiceServer).FindInviteLinkRedemptions(ctx, req.(*FindInviteLinkRedemptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomInviteService_RequestToJoin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestToJoinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomInviteServiceServer).RequestToJoin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/roominvite.RoomInviteService/RequestToJoin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomInviteServiceServer).RequestToJoin(ctx, req.(*RequestToJoinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomInviteService_FindPendingJoinRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindPendingJoinRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomInviteServiceServer).FindPendingJoinRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/roominvite.RoomInviteService/FindPendingJoinRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomInviteServiceServer).FindPendingJoinRequests(ctx, req.(*FindPendingJoinRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomInviteService_ApproveJoinRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecideJoinRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomInviteServiceServer).ApproveJoinRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/roominvite.RoomInviteService/ApproveJoinRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomInviteServiceServer).ApproveJoinRequest(ctx, req.(*DecideJoinRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomInviteService_RejectJoinRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecideJoinRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomInviteServiceServer).RejectJoinRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/roominvite.RoomInviteService/RejectJoinRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomInviteServiceServer).RejectJoinRequest(ctx, req.(*DecideJoinRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomInviteService_CancelJoinRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecideJoinRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomInviteServiceServer).CancelJoinRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/roominvite.RoomInviteService/CancelJoinRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomInviteServiceServer).CancelJoinRequest(ctx, req.(*DecideJoinRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RoomInviteService_ServiceDesc is the grpc.ServiceDesc for RoomInviteService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -351,6 +735,54 @@ var RoomInviteService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AcceptedRoomInvite",
 			Handler:    _RoomInviteService_AcceptedRoomInvite_Handler,
 		},
+		{
+			MethodName: "DenyRoomInvite",
+			Handler:    _RoomInviteService_DenyRoomInvite_Handler,
+		},
+		{
+			MethodName: "RevokeRoomInvite",
+			Handler:    _RoomInviteService_RevokeRoomInvite_Handler,
+		},
+		{
+			MethodName: "CreateInviteLink",
+			Handler:    _RoomInviteService_CreateInviteLink_Handler,
+		},
+		{
+			MethodName: "RedeemInviteLink",
+			Handler:    _RoomInviteService_RedeemInviteLink_Handler,
+		},
+		{
+			MethodName: "FindActiveInviteLinks",
+			Handler:    _RoomInviteService_FindActiveInviteLinks_Handler,
+		},
+		{
+			MethodName: "RevokeInviteLink",
+			Handler:    _RoomInviteService_RevokeInviteLink_Handler,
+		},
+		{
+			MethodName: "FindInviteLinkRedemptions",
+			Handler:    _RoomInviteService_FindInviteLinkRedemptions_Handler,
+		},
+		{
+			MethodName: "RequestToJoin",
+			Handler:    _RoomInviteService_RequestToJoin_Handler,
+		},
+		{
+			MethodName: "FindPendingJoinRequests",
+			Handler:    _RoomInviteService_FindPendingJoinRequests_Handler,
+		},
+		{
+			MethodName: "ApproveJoinRequest",
+			Handler:    _RoomInviteService_ApproveJoinRequest_Handler,
+		},
+		{
+			MethodName: "RejectJoinRequest",
+			Handler:    _RoomInviteService_RejectJoinRequest_Handler,
+		},
+		{
+			MethodName: "CancelJoinRequest",
+			Handler:    _RoomInviteService_CancelJoinRequest_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/room_invite/room_invite.proto",
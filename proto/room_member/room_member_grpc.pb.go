@@ -25,7 +25,19 @@ type RoomMemberServiceClient interface {
 	CreateRoomMembers(ctx context.Context, in *CreateRoomMembersRequest, opts ...grpc.CallOption) (*CreateRoomMembersResponse, error)
 	FindAllByRoomID(ctx context.Context, in *FindAllByRoomIDRequest, opts ...grpc.CallOption) (*FindAllByRoomIDResponse, error)
 	FindAllByUserID(ctx context.Context, in *FindAllByUserIDRequest, opts ...grpc.CallOption) (*FindAllByUserIDResponse, error)
+	FindInbox(ctx context.Context, in *FindInboxRequest, opts ...grpc.CallOption) (*FindInboxResponse, error)
 	FindByRoomIDAndUserID(ctx context.Context, in *FindByRoomIDAndUserIDRequest, opts ...grpc.CallOption) (*FindByRoomIDAndUserIDResponse, error)
+	UpdateMemberRole(ctx context.Context, in *UpdateMemberRoleRequest, opts ...grpc.CallOption) (*UpdateMemberRoleResponse, error)
+	MuteRoom(ctx context.Context, in *MuteRoomRequest, opts ...grpc.CallOption) (*RoomPreferencesResponse, error)
+	UnmuteRoom(ctx context.Context, in *UnmuteRoomRequest, opts ...grpc.CallOption) (*RoomPreferencesResponse, error)
+	ArchiveRoom(ctx context.Context, in *ArchiveRoomRequest, opts ...grpc.CallOption) (*RoomPreferencesResponse, error)
+	PinRoom(ctx context.Context, in *PinRoomRequest, opts ...grpc.CallOption) (*RoomPreferencesResponse, error)
+	KickMember(ctx context.Context, in *KickMemberRequest, opts ...grpc.CallOption) (*KickMemberResponse, error)
+	BanMember(ctx context.Context, in *RestrictMemberRequest, opts ...grpc.CallOption) (*RestrictMemberResponse, error)
+	UnbanMember(ctx context.Context, in *LiftRestrictionRequest, opts ...grpc.CallOption) (*LiftRestrictionResponse, error)
+	MuteMember(ctx context.Context, in *RestrictMemberRequest, opts ...grpc.CallOption) (*RestrictMemberResponse, error)
+	UnmuteMember(ctx context.Context, in *LiftRestrictionRequest, opts ...grpc.CallOption) (*LiftRestrictionResponse, error)
+	FindRestrictions(ctx context.Context, in *FindRestrictionsRequest, opts ...grpc.CallOption) (*FindRestrictionsResponse, error)
 	DeleteByRoomIDAndUserID(ctx context.Context, in *DeleteByRoomIDAndUserIDRequest, opts ...grpc.CallOption) (*DeleteByRoomIDAndUserIDResponse, error)
 	DeleteAllByRoomID(ctx context.Context, in *DeleteAllByRoomIDRequest, opts ...grpc.CallOption) (*DeleteAllByRoomIDResponse, error)
 	DeleteRoomMember(ctx context.Context, in *DeleteRoomMemberRequest, opts ...grpc.CallOption) (*DeleteRoomMemberResponse, error)
@@ -66,6 +78,15 @@ func (c *roomMemberServiceClient) FindAllByUserID(ctx context.Context, in *FindA
 	return out, nil
 }
 
+func (c *roomMemberServiceClient) FindInbox(ctx context.Context, in *FindInboxRequest, opts ...grpc.CallOption) (*FindInboxResponse, error) {
+	out := new(FindInboxResponse)
+	err := c.cc.Invoke(ctx, "/roommember.RoomMemberService/FindInbox", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *roomMemberServiceClient) FindByRoomIDAndUserID(ctx context.Context, in *FindByRoomIDAndUserIDRequest, opts ...grpc.CallOption) (*FindByRoomIDAndUserIDResponse, error) {
 	out := new(FindByRoomIDAndUserIDResponse)
 	err := c.cc.Invoke(ctx, "/roommember.RoomMemberService/FindByRoomIDAndUserID", in, out, opts...)
@@ -75,6 +96,105 @@ func (c *roomMemberServiceClient) FindByRoomIDAndUserID(ctx context.Context, in
 	return out, nil
 }
 
+func (c *roomMemberServiceClient) UpdateMemberRole(ctx context.Context, in *UpdateMemberRoleRequest, opts ...grpc.CallOption) (*UpdateMemberRoleResponse, error) {
+	out := new(UpdateMemberRoleResponse)
+	err := c.cc.Invoke(ctx, "/roommember.RoomMemberService/UpdateMemberRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomMemberServiceClient) MuteRoom(ctx context.Context, in *MuteRoomRequest, opts ...grpc.CallOption) (*RoomPreferencesResponse, error) {
+	out := new(RoomPreferencesResponse)
+	err := c.cc.Invoke(ctx, "/roommember.RoomMemberService/MuteRoom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomMemberServiceClient) UnmuteRoom(ctx context.Context, in *UnmuteRoomRequest, opts ...grpc.CallOption) (*RoomPreferencesResponse, error) {
+	out := new(RoomPreferencesResponse)
+	err := c.cc.Invoke(ctx, "/roommember.RoomMemberService/UnmuteRoom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomMemberServiceClient) ArchiveRoom(ctx context.Context, in *ArchiveRoomRequest, opts ...grpc.CallOption) (*RoomPreferencesResponse, error) {
+	out := new(RoomPreferencesResponse)
+	err := c.cc.Invoke(ctx, "/roommember.RoomMemberService/ArchiveRoom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomMemberServiceClient) PinRoom(ctx context.Context, in *PinRoomRequest, opts ...grpc.CallOption) (*RoomPreferencesResponse, error) {
+	out := new(RoomPreferencesResponse)
+	err := c.cc.Invoke(ctx, "/roommember.RoomMemberService/PinRoom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomMemberServiceClient) KickMember(ctx context.Context, in *KickMemberRequest, opts ...grpc.CallOption) (*KickMemberResponse, error) {
+	out := new(KickMemberResponse)
+	err := c.cc.Invoke(ctx, "/roommember.RoomMemberService/KickMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomMemberServiceClient) BanMember(ctx context.Context, in *RestrictMemberRequest, opts ...grpc.CallOption) (*RestrictMemberResponse, error) {
+	out := new(RestrictMemberResponse)
+	err := c.cc.Invoke(ctx, "/roommember.RoomMemberService/BanMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomMemberServiceClient) UnbanMember(ctx context.Context, in *LiftRestrictionRequest, opts ...grpc.CallOption) (*LiftRestrictionResponse, error) {
+	out := new(LiftRestrictionResponse)
+	err := c.cc.Invoke(ctx, "/roommember.RoomMemberService/UnbanMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomMemberServiceClient) MuteMember(ctx context.Context, in *RestrictMemberRequest, opts ...grpc.CallOption) (*RestrictMemberResponse, error) {
+	out := new(RestrictMemberResponse)
+	err := c.cc.Invoke(ctx, "/roommember.RoomMemberService/MuteMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomMemberServiceClient) UnmuteMember(ctx context.Context, in *LiftRestrictionRequest, opts ...grpc.CallOption) (*LiftRestrictionResponse, error) {
+	out := new(LiftRestrictionResponse)
+	err := c.cc.Invoke(ctx, "/roommember.RoomMemberService/UnmuteMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomMemberServiceClient) FindRestrictions(ctx context.Context, in *FindRestrictionsRequest, opts ...grpc.CallOption) (*FindRestrictionsResponse, error) {
+	out := new(FindRestrictionsResponse)
+	err := c.cc.Invoke(ctx, "/roommember.RoomMemberService/FindRestrictions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *roomMemberServiceClient) DeleteByRoomIDAndUserID(ctx context.Context, in *DeleteByRoomIDAndUserIDRequest, opts ...grpc.CallOption) (*DeleteByRoomIDAndUserIDResponse, error) {
 	out := new(DeleteByRoomIDAndUserIDResponse)
 	err := c.cc.Invoke(ctx, "/roommember.RoomMemberService/DeleteByRoomIDAndUserID", in, out, opts...)
@@ -109,7 +229,19 @@ type RoomMemberServiceServer interface {
 	CreateRoomMembers(context.Context, *CreateRoomMembersRequest) (*CreateRoomMembersResponse, error)
 	FindAllByRoomID(context.Context, *FindAllByRoomIDRequest) (*FindAllByRoomIDResponse, error)
 	FindAllByUserID(context.Context, *FindAllByUserIDRequest) (*FindAllByUserIDResponse, error)
+	FindInbox(context.Context, *FindInboxRequest) (*FindInboxResponse, error)
 	FindByRoomIDAndUserID(context.Context, *FindByRoomIDAndUserIDRequest) (*FindByRoomIDAndUserIDResponse, error)
+	UpdateMemberRole(context.Context, *UpdateMemberRoleRequest) (*UpdateMemberRoleResponse, error)
+	MuteRoom(context.Context, *MuteRoomRequest) (*RoomPreferencesResponse, error)
+	UnmuteRoom(context.Context, *UnmuteRoomRequest) (*RoomPreferencesResponse, error)
+	ArchiveRoom(context.Context, *ArchiveRoomRequest) (*RoomPreferencesResponse, error)
+	PinRoom(context.Context, *PinRoomRequest) (*RoomPreferencesResponse, error)
+	KickMember(context.Context, *KickMemberRequest) (*KickMemberResponse, error)
+	BanMember(context.Context, *RestrictMemberRequest) (*RestrictMemberResponse, error)
+	UnbanMember(context.Context, *LiftRestrictionRequest) (*LiftRestrictionResponse, error)
+	MuteMember(context.Context, *RestrictMemberRequest) (*RestrictMemberResponse, error)
+	UnmuteMember(context.Context, *LiftRestrictionRequest) (*LiftRestrictionResponse, error)
+	FindRestrictions(context.Context, *FindRestrictionsRequest) (*FindRestrictionsResponse, error)
 	DeleteByRoomIDAndUserID(context.Context, *DeleteByRoomIDAndUserIDRequest) (*DeleteByRoomIDAndUserIDResponse, error)
 	DeleteAllByRoomID(context.Context, *DeleteAllByRoomIDRequest) (*DeleteAllByRoomIDResponse, error)
 	DeleteRoomMember(context.Context, *DeleteRoomMemberRequest) (*DeleteRoomMemberResponse, error)
@@ -129,9 +261,45 @@ func (UnimplementedRoomMemberServiceServer) FindAllByRoomID(context.Context, *Fi
 func (UnimplementedRoomMemberServiceServer) FindAllByUserID(context.Context, *FindAllByUserIDRequest) (*FindAllByUserIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindAllByUserID not implemented")
 }
+func (UnimplementedRoomMemberServiceServer) FindInbox(context.Context, *FindInboxRequest) (*FindInboxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindInbox not implemented")
+}
 func (UnimplementedRoomMemberServiceServer) FindByRoomIDAndUserID(context.Context, *FindByRoomIDAndUserIDRequest) (*FindByRoomIDAndUserIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindByRoomIDAndUserID not implemented")
 }
+func (UnimplementedRoomMemberServiceServer) UpdateMemberRole(context.Context, *UpdateMemberRoleRequest) (*UpdateMemberRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMemberRole not implemented")
+}
+func (UnimplementedRoomMemberServiceServer) MuteRoom(context.Context, *MuteRoomRequest) (*RoomPreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MuteRoom not implemented")
+}
+func (UnimplementedRoomMemberServiceServer) UnmuteRoom(context.Context, *UnmuteRoomRequest) (*RoomPreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnmuteRoom not implemented")
+}
+func (UnimplementedRoomMemberServiceServer) ArchiveRoom(context.Context, *ArchiveRoomRequest) (*RoomPreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveRoom not implemented")
+}
+func (UnimplementedRoomMemberServiceServer) PinRoom(context.Context, *PinRoomRequest) (*RoomPreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinRoom not implemented")
+}
+func (UnimplementedRoomMemberServiceServer) KickMember(context.Context, *KickMemberRequest) (*KickMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KickMember not implemented")
+}
+func (UnimplementedRoomMemberServiceServer) BanMember(context.Context, *RestrictMemberRequest) (*RestrictMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BanMember not implemented")
+}
+func (UnimplementedRoomMemberServiceServer) UnbanMember(context.Context, *LiftRestrictionRequest) (*LiftRestrictionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbanMember not implemented")
+}
+func (UnimplementedRoomMemberServiceServer) MuteMember(context.Context, *RestrictMemberRequest) (*RestrictMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MuteMember not implemented")
+}
+func (UnimplementedRoomMemberServiceServer) UnmuteMember(context.Context, *LiftRestrictionRequest) (*LiftRestrictionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnmuteMember not implemented")
+}
+func (UnimplementedRoomMemberServiceServer) FindRestrictions(context.Context, *FindRestrictionsRequest) (*FindRestrictionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindRestrictions not implemented")
+}
 func (UnimplementedRoomMemberServiceServer) DeleteByRoomIDAndUserID(context.Context, *DeleteByRoomIDAndUserIDRequest) (*DeleteByRoomIDAndUserIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteByRoomIDAndUserID not implemented")
 }
@@ -208,6 +376,24 @@ func _RoomMemberService_FindAllByUserID_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RoomMemberService_FindInbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindInboxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomMemberServiceServer).FindInbox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/roommember.RoomMemberService/FindInbox",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomMemberServiceServer).FindInbox(ctx, req.(*FindInboxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RoomMemberService_FindByRoomIDAndUserID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(FindByRoomIDAndUserIDRequest)
 	if err := dec(in); err != nil {
@@ -226,6 +412,204 @@ func _RoomMemberService_FindByRoomIDAndUserID_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RoomMemberService_UpdateMemberRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMemberRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomMemberServiceServer).UpdateMemberRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/roommember.RoomMemberService/UpdateMemberRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomMemberServiceServer).UpdateMemberRole(ctx, req.(*UpdateMemberRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomMemberService_MuteRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomMemberServiceServer).MuteRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/roommember.RoomMemberService/MuteRoom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomMemberServiceServer).MuteRoom(ctx, req.(*MuteRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomMemberService_UnmuteRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnmuteRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomMemberServiceServer).UnmuteRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/roommember.RoomMemberService/UnmuteRoom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomMemberServiceServer).UnmuteRoom(ctx, req.(*UnmuteRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomMemberService_ArchiveRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomMemberServiceServer).ArchiveRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/roommember.RoomMemberService/ArchiveRoom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomMemberServiceServer).ArchiveRoom(ctx, req.(*ArchiveRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomMemberService_PinRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomMemberServiceServer).PinRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/roommember.RoomMemberService/PinRoom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomMemberServiceServer).PinRoom(ctx, req.(*PinRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomMemberService_KickMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KickMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomMemberServiceServer).KickMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/roommember.RoomMemberService/KickMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomMemberServiceServer).KickMember(ctx, req.(*KickMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomMemberService_BanMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestrictMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomMemberServiceServer).BanMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/roommember.RoomMemberService/BanMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomMemberServiceServer).BanMember(ctx, req.(*RestrictMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomMemberService_UnbanMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LiftRestrictionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomMemberServiceServer).UnbanMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/roommember.RoomMemberService/UnbanMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomMemberServiceServer).UnbanMember(ctx, req.(*LiftRestrictionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomMemberService_MuteMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestrictMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomMemberServiceServer).MuteMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/roommember.RoomMemberService/MuteMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomMemberServiceServer).MuteMember(ctx, req.(*RestrictMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomMemberService_UnmuteMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LiftRestrictionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomMemberServiceServer).UnmuteMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/roommember.RoomMemberService/UnmuteMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomMemberServiceServer).UnmuteMember(ctx, req.(*LiftRestrictionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomMemberService_FindRestrictions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindRestrictionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomMemberServiceServer).FindRestrictions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/roommember.RoomMemberService/FindRestrictions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomMemberServiceServer).FindRestrictions(ctx, req.(*FindRestrictionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RoomMemberService_DeleteByRoomIDAndUserID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteByRoomIDAndUserIDRequest)
 	if err := dec(in); err != nil {
@@ -299,10 +683,58 @@ var RoomMemberService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FindAllByUserID",
 			Handler:    _RoomMemberService_FindAllByUserID_Handler,
 		},
+		{
+			MethodName: "FindInbox",
+			Handler:    _RoomMemberService_FindInbox_Handler,
+		},
 		{
 			MethodName: "FindByRoomIDAndUserID",
 			Handler:    _RoomMemberService_FindByRoomIDAndUserID_Handler,
 		},
+		{
+			MethodName: "UpdateMemberRole",
+			Handler:    _RoomMemberService_UpdateMemberRole_Handler,
+		},
+		{
+			MethodName: "MuteRoom",
+			Handler:    _RoomMemberService_MuteRoom_Handler,
+		},
+		{
+			MethodName: "UnmuteRoom",
+			Handler:    _RoomMemberService_UnmuteRoom_Handler,
+		},
+		{
+			MethodName: "ArchiveRoom",
+			Handler:    _RoomMemberService_ArchiveRoom_Handler,
+		},
+		{
+			MethodName: "PinRoom",
+			Handler:    _RoomMemberService_PinRoom_Handler,
+		},
+		{
+			MethodName: "KickMember",
+			Handler:    _RoomMemberService_KickMember_Handler,
+		},
+		{
+			MethodName: "BanMember",
+			Handler:    _RoomMemberService_BanMember_Handler,
+		},
+		{
+			MethodName: "UnbanMember",
+			Handler:    _RoomMemberService_UnbanMember_Handler,
+		},
+		{
+			MethodName: "MuteMember",
+			Handler:    _RoomMemberService_MuteMember_Handler,
+		},
+		{
+			MethodName: "UnmuteMember",
+			Handler:    _RoomMemberService_UnmuteMember_Handler,
+		},
+		{
+			MethodName: "FindRestrictions",
+			Handler:    _RoomMemberService_FindRestrictions_Handler,
+		},
 		{
 			MethodName: "DeleteByRoomIDAndUserID",
 			Handler:    _RoomMemberService_DeleteByRoomIDAndUserID_Handler,
@@ -0,0 +1,214 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v6.32.0
+// source: proto/scheduled_message/scheduled_message.proto
+
+package scheduled_message
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ScheduledMessageServiceClient is the client API for ScheduledMessageService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ScheduledMessageServiceClient interface {
+	CreateScheduledMessage(ctx context.Context, in *CreateScheduledMessageRequest, opts ...grpc.CallOption) (*CreateScheduledMessageResponse, error)
+	FindAllScheduledMessages(ctx context.Context, in *FindAllScheduledMessagesRequest, opts ...grpc.CallOption) (*FindAllScheduledMessagesResponse, error)
+	CancelScheduledMessage(ctx context.Context, in *CancelScheduledMessageRequest, opts ...grpc.CallOption) (*CancelScheduledMessageResponse, error)
+	RescheduleMessage(ctx context.Context, in *RescheduleMessageRequest, opts ...grpc.CallOption) (*RescheduleMessageResponse, error)
+}
+
+type scheduledMessageServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewScheduledMessageServiceClient(cc grpc.ClientConnInterface) ScheduledMessageServiceClient {
+	return &scheduledMessageServiceClient{cc}
+}
+
+func (c *scheduledMessageServiceClient) CreateScheduledMessage(ctx context.Context, in *CreateScheduledMessageRequest, opts ...grpc.CallOption) (*CreateScheduledMessageResponse, error) {
+	out := new(CreateScheduledMessageResponse)
+	err := c.cc.Invoke(ctx, "/scheduledmessage.ScheduledMessageService/CreateScheduledMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduledMessageServiceClient) FindAllScheduledMessages(ctx context.Context, in *FindAllScheduledMessagesRequest, opts ...grpc.CallOption) (*FindAllScheduledMessagesResponse, error) {
+	out := new(FindAllScheduledMessagesResponse)
+	err := c.cc.Invoke(ctx, "/scheduledmessage.ScheduledMessageService/FindAllScheduledMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduledMessageServiceClient) CancelScheduledMessage(ctx context.Context, in *CancelScheduledMessageRequest, opts ...grpc.CallOption) (*CancelScheduledMessageResponse, error) {
+	out := new(CancelScheduledMessageResponse)
+	err := c.cc.Invoke(ctx, "/scheduledmessage.ScheduledMessageService/CancelScheduledMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduledMessageServiceClient) RescheduleMessage(ctx context.Context, in *RescheduleMessageRequest, opts ...grpc.CallOption) (*RescheduleMessageResponse, error) {
+	out := new(RescheduleMessageResponse)
+	err := c.cc.Invoke(ctx, "/scheduledmessage.ScheduledMessageService/RescheduleMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ScheduledMessageServiceServer is the server API for ScheduledMessageService service.
+// All implementations must embed UnimplementedScheduledMessageServiceServer
+// for forward compatibility
+type ScheduledMessageServiceServer interface {
+	CreateScheduledMessage(context.Context, *CreateScheduledMessageRequest) (*CreateScheduledMessageResponse, error)
+	FindAllScheduledMessages(context.Context, *FindAllScheduledMessagesRequest) (*FindAllScheduledMessagesResponse, error)
+	CancelScheduledMessage(context.Context, *CancelScheduledMessageRequest) (*CancelScheduledMessageResponse, error)
+	RescheduleMessage(context.Context, *RescheduleMessageRequest) (*RescheduleMessageResponse, error)
+	mustEmbedUnimplementedScheduledMessageServiceServer()
+}
+
+// UnimplementedScheduledMessageServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedScheduledMessageServiceServer struct {
+}
+
+func (UnimplementedScheduledMessageServiceServer) CreateScheduledMessage(context.Context, *CreateScheduledMessageRequest) (*CreateScheduledMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateScheduledMessage not implemented")
+}
+func (UnimplementedScheduledMessageServiceServer) FindAllScheduledMessages(context.Context, *FindAllScheduledMessagesRequest) (*FindAllScheduledMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindAllScheduledMessages not implemented")
+}
+func (UnimplementedScheduledMessageServiceServer) CancelScheduledMessage(context.Context, *CancelScheduledMessageRequest) (*CancelScheduledMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledMessage not implemented")
+}
+func (UnimplementedScheduledMessageServiceServer) RescheduleMessage(context.Context, *RescheduleMessageRequest) (*RescheduleMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RescheduleMessage not implemented")
+}
+func (UnimplementedScheduledMessageServiceServer) mustEmbedUnimplementedScheduledMessageServiceServer() {
+}
+
+// UnsafeScheduledMessageServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ScheduledMessageServiceServer will
+// result in compilation errors.
+type UnsafeScheduledMessageServiceServer interface {
+	mustEmbedUnimplementedScheduledMessageServiceServer()
+}
+
+func RegisterScheduledMessageServiceServer(s grpc.ServiceRegistrar, srv ScheduledMessageServiceServer) {
+	s.RegisterService(&ScheduledMessageService_ServiceDesc, srv)
+}
+
+func _ScheduledMessageService_CreateScheduledMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateScheduledMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduledMessageServiceServer).CreateScheduledMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scheduledmessage.ScheduledMessageService/CreateScheduledMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduledMessageServiceServer).CreateScheduledMessage(ctx, req.(*CreateScheduledMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduledMessageService_FindAllScheduledMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindAllScheduledMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduledMessageServiceServer).FindAllScheduledMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scheduledmessage.ScheduledMessageService/FindAllScheduledMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduledMessageServiceServer).FindAllScheduledMessages(ctx, req.(*FindAllScheduledMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduledMessageService_CancelScheduledMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduledMessageServiceServer).CancelScheduledMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scheduledmessage.ScheduledMessageService/CancelScheduledMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduledMessageServiceServer).CancelScheduledMessage(ctx, req.(*CancelScheduledMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduledMessageService_RescheduleMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RescheduleMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduledMessageServiceServer).RescheduleMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scheduledmessage.ScheduledMessageService/RescheduleMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduledMessageServiceServer).RescheduleMessage(ctx, req.(*RescheduleMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ScheduledMessageService_ServiceDesc is the grpc.ServiceDesc for ScheduledMessageService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ScheduledMessageService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "scheduledmessage.ScheduledMessageService",
+	HandlerType: (*ScheduledMessageServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateScheduledMessage",
+			Handler:    _ScheduledMessageService_CreateScheduledMessage_Handler,
+		},
+		{
+			MethodName: "FindAllScheduledMessages",
+			Handler:    _ScheduledMessageService_FindAllScheduledMessages_Handler,
+		},
+		{
+			MethodName: "CancelScheduledMessage",
+			Handler:    _ScheduledMessageService_CancelScheduledMessage_Handler,
+		},
+		{
+			MethodName: "RescheduleMessage",
+			Handler:    _ScheduledMessageService_RescheduleMessage_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/scheduled_message/scheduled_message.proto",
+}